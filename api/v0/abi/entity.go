@@ -0,0 +1,37 @@
+package abi
+
+import "encoding/json"
+
+// RegisterAbiRequest is a request to register the ABI of the
+// contract at an address, so that the logs it emits are
+// delivered to the subscriptions decoded
+type RegisterAbiRequest struct {
+	// Address of the contract
+	Address string `json:"address"`
+
+	// ABI of the contract in its JSON format
+	ABI json.RawMessage `json:"abi"`
+}
+
+// GetAbiRequest is a request to retrieve the ABI
+// registered for an address
+type GetAbiRequest struct {
+	// Address of the contract
+	Address string `json:"address"`
+}
+
+// GetAbiResponse is the response to GetAbiRequest
+type GetAbiResponse struct {
+	// Address of the contract
+	Address string `json:"address"`
+
+	// ABI of the contract in its JSON format
+	ABI json.RawMessage `json:"abi"`
+}
+
+// RemoveAbiRequest is a request to remove the ABI
+// registered for an address
+type RemoveAbiRequest struct {
+	// Address of the contract
+	Address string `json:"address"`
+}
@@ -0,0 +1,121 @@
+package abi
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// Client interface for the underlying operations needed for the API
+// implementation
+type Client interface {
+	RegisterAbi(context.Context, backend.RegisterAbiRequest) errors.Err
+	GetAbi(context.Context, backend.GetAbiRequest) (backend.GetAbiResponse, errors.Err)
+	RemoveAbi(context.Context, backend.RemoveAbiRequest) errors.Err
+}
+
+type Services struct {
+	Logger log.Logger
+	Client Client
+}
+
+// AbiHandler implements the handlers to manage the contract ABIs
+// used to decode the logs delivered to subscriptions
+type AbiHandler struct {
+	logger log.Logger
+	client Client
+}
+
+// RegisterAbi registers the ABI of a contract, replacing the ABI
+// registered before for the same address
+func (h AbiHandler) RegisterAbi(ctx context.Context, v interface{}) (interface{}, error) {
+	req := v.(*RegisterAbiRequest)
+
+	if len(req.Address) == 0 || len(req.ABI) == 0 {
+		err := errors.New(errors.ErrEmptyInput, stderr.New("address and abi must be set"))
+		h.logger.Debug(ctx, "failed to handle request", log.MapFields{
+			"call_type": "RegisterAbiFailure",
+		}, err)
+		return nil, err
+	}
+
+	if err := h.client.RegisterAbi(ctx, backend.RegisterAbiRequest{
+		Address: req.Address,
+		ABI:     string(req.ABI),
+	}); err != nil {
+		h.logger.Debug(ctx, "failed to register abi", log.MapFields{
+			"call_type": "RegisterAbiFailure",
+			"address":   req.Address,
+		}, err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// GetAbi returns the ABI registered for an address
+func (h AbiHandler) GetAbi(ctx context.Context, v interface{}) (interface{}, error) {
+	req := v.(*GetAbiRequest)
+
+	res, err := h.client.GetAbi(ctx, backend.GetAbiRequest{Address: req.Address})
+	if err != nil {
+		h.logger.Debug(ctx, "failed to get abi", log.MapFields{
+			"call_type": "GetAbiFailure",
+			"address":   req.Address,
+		}, err)
+		return nil, err
+	}
+
+	return GetAbiResponse{
+		Address: res.Address,
+		ABI:     json.RawMessage(res.ABI),
+	}, nil
+}
+
+// RemoveAbi removes the ABI registered for an address, so that
+// the logs it emits are no longer decoded
+func (h AbiHandler) RemoveAbi(ctx context.Context, v interface{}) (interface{}, error) {
+	req := v.(*RemoveAbiRequest)
+
+	if err := h.client.RemoveAbi(ctx, backend.RemoveAbiRequest{Address: req.Address}); err != nil {
+		h.logger.Debug(ctx, "failed to remove abi", log.MapFields{
+			"call_type": "RemoveAbiFailure",
+			"address":   req.Address,
+		}, err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func NewAbiHandler(services Services) AbiHandler {
+	if services.Client == nil {
+		panic("Request must be provided as a service")
+	}
+	if services.Logger == nil {
+		panic("Logger must be provided as a service")
+	}
+
+	return AbiHandler{
+		logger: services.Logger.ForClass("abi", "handler"),
+		client: services.Client,
+	}
+}
+
+// BindHandler binds the abi handler to the provided
+// HandlerBinder
+func BindHandler(services Services, binder rpc.HandlerBinder) {
+	handler := NewAbiHandler(services)
+
+	binder.Bind("POST", "/v0/api/abi/register", rpc.HandlerFunc(handler.RegisterAbi),
+		rpc.EntityFactoryFunc(func() interface{} { return &RegisterAbiRequest{} }))
+	binder.Bind("POST", "/v0/api/abi/get", rpc.Describe(rpc.HandlerFunc(handler.GetAbi), GetAbiResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &GetAbiRequest{} }))
+	binder.Bind("POST", "/v0/api/abi/remove", rpc.HandlerFunc(handler.RemoveAbi),
+		rpc.EntityFactoryFunc(func() interface{} { return &RemoveAbiRequest{} }))
+}
@@ -0,0 +1,129 @@
+package abi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var Context = context.TODO()
+
+var Logger = log.NewLogrus(log.LogrusLoggerProperties{
+	Output: ioutil.Discard,
+})
+
+type MockClient struct {
+	mock.Mock
+}
+
+func (c *MockClient) RegisterAbi(
+	ctx context.Context,
+	req backend.RegisterAbiRequest,
+) errors.Err {
+	args := c.Called(ctx, req)
+	if args.Get(0) != nil {
+		return args.Get(0).(errors.Err)
+	}
+
+	return nil
+}
+
+func (c *MockClient) GetAbi(
+	ctx context.Context,
+	req backend.GetAbiRequest,
+) (backend.GetAbiResponse, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.GetAbiResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.GetAbiResponse), nil
+}
+
+func (c *MockClient) RemoveAbi(
+	ctx context.Context,
+	req backend.RemoveAbiRequest,
+) errors.Err {
+	args := c.Called(ctx, req)
+	if args.Get(0) != nil {
+		return args.Get(0).(errors.Err)
+	}
+
+	return nil
+}
+
+func createAbiHandler() AbiHandler {
+	return NewAbiHandler(Services{
+		Logger: Logger,
+		Client: &MockClient{},
+	})
+}
+
+func TestRegisterAbiOK(t *testing.T) {
+	handler := createAbiHandler()
+
+	handler.client.(*MockClient).On("RegisterAbi", mock.Anything, mock.Anything).Return(nil)
+
+	res, err := handler.RegisterAbi(Context, &RegisterAbiRequest{
+		Address: "0x0000000000000000000000000000000000000001",
+		ABI:     json.RawMessage(`[]`),
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, res)
+	handler.client.(*MockClient).AssertCalled(t, "RegisterAbi", mock.Anything, backend.RegisterAbiRequest{
+		Address: "0x0000000000000000000000000000000000000001",
+		ABI:     "[]",
+	})
+}
+
+func TestRegisterAbiErrEmptyInput(t *testing.T) {
+	handler := createAbiHandler()
+
+	_, err := handler.RegisterAbi(Context, &RegisterAbiRequest{
+		Address: "0x0000000000000000000000000000000000000001",
+	})
+
+	assert.Equal(t, errors.ErrEmptyInput, err.(errors.Err).ErrorCode())
+}
+
+func TestGetAbiOK(t *testing.T) {
+	handler := createAbiHandler()
+
+	handler.client.(*MockClient).On("GetAbi", mock.Anything, backend.GetAbiRequest{
+		Address: "0x0000000000000000000000000000000000000001",
+	}).Return(backend.GetAbiResponse{
+		Address: "0x0000000000000000000000000000000000000001",
+		ABI:     "[]",
+	}, nil)
+
+	res, err := handler.GetAbi(Context, &GetAbiRequest{
+		Address: "0x0000000000000000000000000000000000000001",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, GetAbiResponse{
+		Address: "0x0000000000000000000000000000000000000001",
+		ABI:     json.RawMessage(`[]`),
+	}, res)
+}
+
+func TestRemoveAbiErrNotFound(t *testing.T) {
+	handler := createAbiHandler()
+
+	handler.client.(*MockClient).On("RemoveAbi", mock.Anything, mock.Anything).
+		Return(errors.New(errors.ErrAbiNotFound, nil))
+
+	_, err := handler.RemoveAbi(Context, &RemoveAbiRequest{
+		Address: "0x0000000000000000000000000000000000000001",
+	})
+
+	assert.Equal(t, errors.ErrAbiNotFound, err.(errors.Err).ErrorCode())
+}
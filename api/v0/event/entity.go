@@ -1,6 +1,10 @@
 package event
 
-import "github.com/oasislabs/oasis-gateway/rpc"
+import (
+	"net/url"
+
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
 
 // AsyncResponse is the response returned by APIs that are asynchronous
 // that return an ID that can be used by the user to receive and identify
@@ -22,18 +26,90 @@ type UnsubscribeRequest struct {
 // topics on the gateway.
 type SubscribeRequest struct {
 	// Events is the the list of event types the subscription intends
-	// to be created for
+	// to be created for. The supported types are logs, newHeads and
+	// transactions, and the events of all of them are delivered
+	// through the same subscription
 	Events []string `json:"events"`
 
 	// Filter is a url encoded list of query parameters that specify
-	// filters to be applied to the subscribed topic
+	// filters to be applied to the subscribed topic. It only supports
+	// a single topic per position, LogFilter should be used instead
 	Filter string `json:"filter"`
+
+	// LogFilter is the filter applied to the logs of the subscription.
+	// It cannot be set along with Filter
+	LogFilter *LogFilter `json:"logFilter,omitempty"`
+
+	// FromBlock is the block from which the past logs that match the
+	// filter are delivered, before any new logs. If not set, only the
+	// logs emitted after the subscription is created are delivered
+	FromBlock uint64 `json:"fromBlock,omitempty"`
+
+	// Confirmations is the number of blocks that need to be added on
+	// top of the block of a log before the log is delivered, so that
+	// logs are only delivered once they are unlikely to be removed by
+	// a reorg. If not set, logs are delivered as soon as they are emitted
+	Confirmations uint64 `json:"confirmations,omitempty"`
+}
+
+// LogFilter is the filter applied to the logs of a subscription. It
+// follows the same semantics as the filters of eth_getLogs
+type LogFilter struct {
+	// Addresses restricts the logs to the ones emitted by any of the
+	// addresses. If empty, the logs of all addresses match
+	Addresses []string `json:"addresses"`
+
+	// Topics restricts the logs by the topics at each position. A log
+	// matches if each of its topics is one of the topics at the same
+	// position. A null or empty position matches any topic
+	Topics [][]string `json:"topics"`
 }
 
 // SubscribeResponse returns an AsyncResponse which contains the ID
 // that can be used to poll for notifications on the subscription
 type SubscribeResponse AsyncResponse
 
+// ListSubscriptionsRequest is used by the user to list the
+// subscriptions owned by the session
+type ListSubscriptionsRequest struct{}
+
+// ListSubscriptionsResponse is the list of subscriptions
+// owned by the session
+type ListSubscriptionsResponse struct {
+	// Subscriptions owned by the session ordered by their ID
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+// Subscription describes a subscription owned by the session
+type Subscription struct {
+	// ID of the subscription returned in SubscribeResponse
+	ID uint64 `json:"id"`
+
+	// Events is the list of event types of the subscription
+	Events []string `json:"events"`
+
+	// LogFilter is the filter applied to the logs of the subscription,
+	// regardless of whether it was set as a Filter or as a LogFilter
+	LogFilter LogFilter `json:"logFilter"`
+
+	// FromBlock is the block from which the past logs
+	// that match the filter were delivered
+	FromBlock uint64 `json:"fromBlock,omitempty"`
+
+	// CreatedAt is the unix timestamp in milliseconds
+	// at which the subscription was created
+	CreatedAt uint64 `json:"createdAt"`
+
+	// LastBlockNumber is the number of the block of the last event
+	// delivered by the subscription as of its last checkpoint. It is
+	// 0 if the subscription has not stored a checkpoint yet
+	LastBlockNumber uint64 `json:"lastBlockNumber"`
+
+	// QueueDepth is the number of events delivered by the
+	// subscription that have not been discarded yet
+	QueueDepth uint64 `json:"queueDepth"`
+}
+
 // PollEventRequest is a request that allows the user to
 // poll for events either from asynchronous requests or from
 // subscriptions
@@ -53,6 +129,31 @@ type PollEventRequest struct {
 	// DiscardPrevious allows the client to define whether the server should
 	// discard all the events that have a sequence number lower than the offer
 	DiscardPrevious bool `json:"discardPrevious"`
+
+	// WaitMs is the maximum time in milliseconds the server holds the
+	// request waiting for events if there are none available at the
+	// offset. If not set the server responds immediately
+	WaitMs uint64 `json:"waitMs"`
+}
+
+// DecodeQuery is the implementation of rpc.HttpQueryDecoder for
+// PollEventRequest, so that a stream can be opened with a GET request
+func (r *PollEventRequest) DecodeQuery(query url.Values) error {
+	if err := rpc.ParseQueryUint(query, "id", &r.ID); err != nil {
+		return err
+	}
+
+	if err := rpc.ParseQueryUint(query, "offset", &r.Offset); err != nil {
+		return err
+	}
+
+	count := uint64(r.Count)
+	if err := rpc.ParseQueryUint(query, "count", &count); err != nil {
+		return err
+	}
+	r.Count = uint(count)
+
+	return rpc.ParseQueryBool(query, "discardPrevious", &r.DiscardPrevious)
 }
 
 // PollEventResponse is the list of events that are returned for
@@ -82,11 +183,79 @@ type DataEvent struct {
 	// ID to identify the event itself within the sequence of events.
 	ID uint64 `json:"id"`
 
-	// Data is the blob of data related to this event
+	// Type is the event type that generated the event, so that the
+	// events of a subscription to multiple types can be told apart
+	Type string `json:"type"`
+
+	// Data is the blob of data related to this event. For logs it is
+	// the data of the log, for newHeads the hash of the block and for
+	// transactions the hash of the transaction
 	Data string `json:"data"`
 
 	// Topics is the list of topics to which the event refers
 	Topics []string `json:"topics"`
+
+	// BlockNumber is the number of the block to which the event refers
+	BlockNumber uint64 `json:"blockNumber"`
+
+	// BlockHash is the hash of the block to which the event refers. It
+	// is set for logs and newHeads
+	BlockHash string `json:"blockHash,omitempty"`
+
+	// TransactionHash is the hash of the transaction to which the event
+	// refers. It is set for logs and transactions
+	TransactionHash string `json:"transactionHash,omitempty"`
+
+	// TransactionIndex is the index in its block of the transaction
+	// that emitted a log
+	TransactionIndex uint64 `json:"transactionIndex"`
+
+	// LogIndex is the index of a log in its block. Along with the
+	// BlockHash it uniquely identifies a log
+	LogIndex uint64 `json:"logIndex"`
+
+	// Address is the address of the service that emitted a log
+	Address string `json:"address,omitempty"`
+
+	// Event is the name of the event of a log decoded with the
+	// ABI registered for the address that emitted it
+	Event string `json:"event,omitempty"`
+
+	// Args are the arguments of the event of a decoded log
+	Args []EventArg `json:"args,omitempty"`
+}
+
+// EventArg is an argument of the event of a decoded log
+type EventArg struct {
+	// Name of the argument
+	Name string `json:"name"`
+
+	// Type of the argument as defined in the ABI
+	Type string `json:"type"`
+
+	// Indexed is true if the argument is logged as a topic. Indexed
+	// arguments of dynamic types, like strings or arrays, are only
+	// logged as the hash of their value
+	Indexed bool `json:"indexed"`
+
+	// Value of the argument. Numbers are formatted in decimal,
+	// addresses and byte values are hex encoded and arrays are
+	// formatted as a JSON array of their formatted elements
+	Value string `json:"value"`
+}
+
+// RetractedEvent is the event that can be polled by the user when a
+// log for which a DataEvent was delivered is removed from the chain by
+// a reorg, so that the DataEvent can be rolled back
+type RetractedEvent struct {
+	// ID to identify the event itself within the sequence of events.
+	ID uint64 `json:"id"`
+
+	// RetractedID is the ID of the DataEvent of the removed log
+	RetractedID uint64 `json:"retractedId"`
+
+	// BlockNumber is the number of the block of the removed log
+	BlockNumber uint64 `json:"blockNumber"`
 }
 
 // ErrorEvent is the event that can be polled by the user
@@ -105,6 +274,11 @@ func (e DataEvent) EventID() uint64 {
 	return e.ID
 }
 
+// EventID is the implementation of Event for RetractedEvent
+func (e RetractedEvent) EventID() uint64 {
+	return e.ID
+}
+
 // EventID is the implementation of Event for ErrorEvent
 func (e ErrorEvent) EventID() uint64 {
 	return e.ID
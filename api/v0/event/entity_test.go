@@ -1,6 +1,7 @@
 package event
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,3 +14,20 @@ func TestDataEventEventID(t *testing.T) {
 func TestErrorEventEventID(t *testing.T) {
 	assert.Equal(t, uint64(1), ErrorEvent{ID: 1}.EventID())
 }
+
+func TestPollEventRequestDecodeQuery(t *testing.T) {
+	query, err := url.ParseQuery("id=2&offset=3&count=4&discardPrevious=true")
+	assert.Nil(t, err)
+
+	var req PollEventRequest
+	assert.Nil(t, req.DecodeQuery(query))
+	assert.Equal(t, PollEventRequest{ID: 2, Offset: 3, Count: 4, DiscardPrevious: true}, req)
+}
+
+func TestPollEventRequestDecodeQueryErr(t *testing.T) {
+	query, err := url.ParseQuery("offset=abc")
+	assert.Nil(t, err)
+
+	var req PollEventRequest
+	assert.Equal(t, "query parameter offset must be an unsigned integer", req.DecodeQuery(query).Error())
+}
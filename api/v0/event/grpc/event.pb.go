@@ -0,0 +1,1406 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api/v0/event/grpc/event.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Error struct {
+	// Unique identifier of the type of error.
+	ErrorCode int32 `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Human readable description of the error.
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{0}
+}
+
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Error.Unmarshal(m, b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+}
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
+}
+func (m *Error) XXX_Size() int {
+	return xxx_messageInfo_Error.Size(m)
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetErrorCode() int32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *Error) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SubscribeRequest struct {
+	// Event types the subscription is created for: logs, newHeads
+	// and transactions.
+	Events []string `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Url encoded filters applied to the subscribed topic.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Filter applied to the logs of the subscription. It cannot be
+	// set along with filter.
+	LogFilter *LogFilter `protobuf:"bytes,3,opt,name=log_filter,json=logFilter,proto3" json:"log_filter,omitempty"`
+	// Block from which the past logs are delivered before the new
+	// ones. If not set, only new logs are delivered.
+	FromBlock uint64 `protobuf:"varint,4,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// Number of blocks added on top of the block of a log before the
+	// log is delivered. If not set, logs are delivered immediately.
+	Confirmations        uint64   `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{1}
+}
+
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetLogFilter() *LogFilter {
+	if m != nil {
+		return m.LogFilter
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type LogFilter struct {
+	// Addresses that emitted the logs. If empty, the logs of all
+	// addresses match.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Topics of the logs by position. An empty position matches
+	// any topic.
+	Topics               []*TopicSet `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LogFilter) Reset()         { *m = LogFilter{} }
+func (m *LogFilter) String() string { return proto.CompactTextString(m) }
+func (*LogFilter) ProtoMessage()    {}
+func (*LogFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{2}
+}
+
+func (m *LogFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogFilter.Unmarshal(m, b)
+}
+func (m *LogFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogFilter.Marshal(b, m, deterministic)
+}
+func (m *LogFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogFilter.Merge(m, src)
+}
+func (m *LogFilter) XXX_Size() int {
+	return xxx_messageInfo_LogFilter.Size(m)
+}
+func (m *LogFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogFilter proto.InternalMessageInfo
+
+func (m *LogFilter) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *LogFilter) GetTopics() []*TopicSet {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type TopicSet struct {
+	// Topics any of which matches the topic at the position.
+	Topics               []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopicSet) Reset()         { *m = TopicSet{} }
+func (m *TopicSet) String() string { return proto.CompactTextString(m) }
+func (*TopicSet) ProtoMessage()    {}
+func (*TopicSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{3}
+}
+
+func (m *TopicSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopicSet.Unmarshal(m, b)
+}
+func (m *TopicSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopicSet.Marshal(b, m, deterministic)
+}
+func (m *TopicSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicSet.Merge(m, src)
+}
+func (m *TopicSet) XXX_Size() int {
+	return xxx_messageInfo_TopicSet.Size(m)
+}
+func (m *TopicSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicSet proto.InternalMessageInfo
+
+func (m *TopicSet) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	// ID of the subscription.
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{4}
+}
+
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(m, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeResponse.Size(m)
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+func (m *SubscribeResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type UnsubscribeRequest struct {
+	// ID of the subscription to destroy.
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeRequest) Reset()         { *m = UnsubscribeRequest{} }
+func (m *UnsubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeRequest) ProtoMessage()    {}
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{5}
+}
+
+func (m *UnsubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeRequest.Unmarshal(m, b)
+}
+func (m *UnsubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeRequest.Merge(m, src)
+}
+func (m *UnsubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeRequest.Size(m)
+}
+func (m *UnsubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeRequest proto.InternalMessageInfo
+
+func (m *UnsubscribeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type UnsubscribeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeResponse) Reset()         { *m = UnsubscribeResponse{} }
+func (m *UnsubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeResponse) ProtoMessage()    {}
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{6}
+}
+
+func (m *UnsubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeResponse.Unmarshal(m, b)
+}
+func (m *UnsubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeResponse.Merge(m, src)
+}
+func (m *UnsubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeResponse.Size(m)
+}
+func (m *UnsubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeResponse proto.InternalMessageInfo
+
+type ListSubscriptionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSubscriptionsRequest) Reset()         { *m = ListSubscriptionsRequest{} }
+func (m *ListSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsRequest) ProtoMessage()    {}
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{7}
+}
+
+func (m *ListSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsRequest.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsRequest.Merge(m, src)
+}
+func (m *ListSubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsRequest.Size(m)
+}
+func (m *ListSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsRequest proto.InternalMessageInfo
+
+type ListSubscriptionsResponse struct {
+	// Subscriptions owned by the session ordered by their ID.
+	Subscriptions        []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListSubscriptionsResponse) Reset()         { *m = ListSubscriptionsResponse{} }
+func (m *ListSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsResponse) ProtoMessage()    {}
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{8}
+}
+
+func (m *ListSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsResponse.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsResponse.Merge(m, src)
+}
+func (m *ListSubscriptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsResponse.Size(m)
+}
+func (m *ListSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+type Subscription struct {
+	// ID of the subscription.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Event types of the subscription.
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Filter applied to the logs of the subscription.
+	LogFilter *LogFilter `protobuf:"bytes,3,opt,name=log_filter,json=logFilter,proto3" json:"log_filter,omitempty"`
+	// Block from which the past logs were delivered.
+	FromBlock uint64 `protobuf:"varint,4,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// Unix timestamp in milliseconds at which the subscription
+	// was created.
+	CreatedAt uint64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Number of the block of the last event delivered by the
+	// subscription. It is 0 if no event has been delivered yet.
+	LastBlockNumber uint64 `protobuf:"varint,6,opt,name=last_block_number,json=lastBlockNumber,proto3" json:"last_block_number,omitempty"`
+	// Number of events delivered by the subscription that have
+	// not been discarded yet.
+	QueueDepth           uint64   `protobuf:"varint,7,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{9}
+}
+
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Subscription) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Subscription) GetLogFilter() *LogFilter {
+	if m != nil {
+		return m.LogFilter
+	}
+	return nil
+}
+
+func (m *Subscription) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *Subscription) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Subscription) GetLastBlockNumber() uint64 {
+	if m != nil {
+		return m.LastBlockNumber
+	}
+	return 0
+}
+
+func (m *Subscription) GetQueueDepth() uint64 {
+	if m != nil {
+		return m.QueueDepth
+	}
+	return 0
+}
+
+type PollEventRequest struct {
+	// ID of the subscription.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Offset at which events need to be provided.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of events to return.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Whether events before the offset can be discarded.
+	DiscardPrevious bool `protobuf:"varint,4,opt,name=discard_previous,json=discardPrevious,proto3" json:"discard_previous,omitempty"`
+	// Maximum time in milliseconds to wait for events.
+	WaitMs               uint64   `protobuf:"varint,5,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollEventRequest) Reset()         { *m = PollEventRequest{} }
+func (m *PollEventRequest) String() string { return proto.CompactTextString(m) }
+func (*PollEventRequest) ProtoMessage()    {}
+func (*PollEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{10}
+}
+
+func (m *PollEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollEventRequest.Unmarshal(m, b)
+}
+func (m *PollEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollEventRequest.Marshal(b, m, deterministic)
+}
+func (m *PollEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollEventRequest.Merge(m, src)
+}
+func (m *PollEventRequest) XXX_Size() int {
+	return xxx_messageInfo_PollEventRequest.Size(m)
+}
+func (m *PollEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollEventRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollEventRequest proto.InternalMessageInfo
+
+func (m *PollEventRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PollEventRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PollEventRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PollEventRequest) GetDiscardPrevious() bool {
+	if m != nil {
+		return m.DiscardPrevious
+	}
+	return false
+}
+
+func (m *PollEventRequest) GetWaitMs() uint64 {
+	if m != nil {
+		return m.WaitMs
+	}
+	return 0
+}
+
+type PollEventResponse struct {
+	// Offset the events were retrieved from.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Events available from the offset.
+	Events               []*SubscriptionEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PollEventResponse) Reset()         { *m = PollEventResponse{} }
+func (m *PollEventResponse) String() string { return proto.CompactTextString(m) }
+func (*PollEventResponse) ProtoMessage()    {}
+func (*PollEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{11}
+}
+
+func (m *PollEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollEventResponse.Unmarshal(m, b)
+}
+func (m *PollEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollEventResponse.Marshal(b, m, deterministic)
+}
+func (m *PollEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollEventResponse.Merge(m, src)
+}
+func (m *PollEventResponse) XXX_Size() int {
+	return xxx_messageInfo_PollEventResponse.Size(m)
+}
+func (m *PollEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollEventResponse proto.InternalMessageInfo
+
+func (m *PollEventResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PollEventResponse) GetEvents() []*SubscriptionEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type SubscriptionEvent struct {
+	// Types that are valid to be assigned to Event:
+	//	*SubscriptionEvent_Data
+	//	*SubscriptionEvent_Error
+	//	*SubscriptionEvent_Retracted
+	Event                isSubscriptionEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SubscriptionEvent) Reset()         { *m = SubscriptionEvent{} }
+func (m *SubscriptionEvent) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionEvent) ProtoMessage()    {}
+func (*SubscriptionEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{12}
+}
+
+func (m *SubscriptionEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionEvent.Unmarshal(m, b)
+}
+func (m *SubscriptionEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionEvent.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionEvent.Merge(m, src)
+}
+func (m *SubscriptionEvent) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionEvent.Size(m)
+}
+func (m *SubscriptionEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionEvent proto.InternalMessageInfo
+
+type isSubscriptionEvent_Event interface {
+	isSubscriptionEvent_Event()
+}
+
+type SubscriptionEvent_Data struct {
+	Data *DataEvent `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+type SubscriptionEvent_Error struct {
+	Error *ErrorEvent `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+type SubscriptionEvent_Retracted struct {
+	Retracted *RetractedEvent `protobuf:"bytes,3,opt,name=retracted,proto3,oneof"`
+}
+
+func (*SubscriptionEvent_Data) isSubscriptionEvent_Event() {}
+
+func (*SubscriptionEvent_Error) isSubscriptionEvent_Event() {}
+
+func (*SubscriptionEvent_Retracted) isSubscriptionEvent_Event() {}
+
+func (m *SubscriptionEvent) GetEvent() isSubscriptionEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SubscriptionEvent) GetData() *DataEvent {
+	if x, ok := m.GetEvent().(*SubscriptionEvent_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (m *SubscriptionEvent) GetError() *ErrorEvent {
+	if x, ok := m.GetEvent().(*SubscriptionEvent_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *SubscriptionEvent) GetRetracted() *RetractedEvent {
+	if x, ok := m.GetEvent().(*SubscriptionEvent_Retracted); ok {
+		return x.Retracted
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubscriptionEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubscriptionEvent_Data)(nil),
+		(*SubscriptionEvent_Error)(nil),
+		(*SubscriptionEvent_Retracted)(nil),
+	}
+}
+
+type DataEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Data of the event.
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Topics to which the event refers.
+	Topics []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Event type that generated the event.
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Number of the block to which the event refers.
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Name of the event of a log decoded with a registered ABI.
+	Event string `protobuf:"bytes,6,opt,name=event,proto3" json:"event,omitempty"`
+	// Arguments of the event of a decoded log.
+	Args []*EventArg `protobuf:"bytes,7,rep,name=args,proto3" json:"args,omitempty"`
+	// Hash of the block to which the event refers.
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hash of the transaction to which the event refers.
+	TransactionHash string `protobuf:"bytes,9,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// Index in its block of the transaction that emitted a log.
+	TransactionIndex uint64 `protobuf:"varint,10,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	// Index of a log in its block.
+	LogIndex uint64 `protobuf:"varint,11,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// Address that emitted a log.
+	Address              string   `protobuf:"bytes,12,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataEvent) Reset()         { *m = DataEvent{} }
+func (m *DataEvent) String() string { return proto.CompactTextString(m) }
+func (*DataEvent) ProtoMessage()    {}
+func (*DataEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{13}
+}
+
+func (m *DataEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataEvent.Unmarshal(m, b)
+}
+func (m *DataEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataEvent.Marshal(b, m, deterministic)
+}
+func (m *DataEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataEvent.Merge(m, src)
+}
+func (m *DataEvent) XXX_Size() int {
+	return xxx_messageInfo_DataEvent.Size(m)
+}
+func (m *DataEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataEvent proto.InternalMessageInfo
+
+func (m *DataEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DataEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *DataEvent) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *DataEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DataEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *DataEvent) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *DataEvent) GetArgs() []*EventArg {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *DataEvent) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *DataEvent) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *DataEvent) GetTransactionIndex() uint64 {
+	if m != nil {
+		return m.TransactionIndex
+	}
+	return 0
+}
+
+func (m *DataEvent) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *DataEvent) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type EventArg struct {
+	// Name of the argument.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the argument as defined in the ABI.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Whether the argument is logged as a topic.
+	Indexed bool `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	// Value of the argument formatted as a string.
+	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventArg) Reset()         { *m = EventArg{} }
+func (m *EventArg) String() string { return proto.CompactTextString(m) }
+func (*EventArg) ProtoMessage()    {}
+func (*EventArg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{14}
+}
+
+func (m *EventArg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventArg.Unmarshal(m, b)
+}
+func (m *EventArg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventArg.Marshal(b, m, deterministic)
+}
+func (m *EventArg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventArg.Merge(m, src)
+}
+func (m *EventArg) XXX_Size() int {
+	return xxx_messageInfo_EventArg.Size(m)
+}
+func (m *EventArg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventArg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventArg proto.InternalMessageInfo
+
+func (m *EventArg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventArg) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventArg) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
+	}
+	return false
+}
+
+func (m *EventArg) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type RetractedEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the event of the log removed by a reorg.
+	RetractedId uint64 `protobuf:"varint,2,opt,name=retracted_id,json=retractedId,proto3" json:"retracted_id,omitempty"`
+	// Number of the block of the removed log.
+	BlockNumber          uint64   `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetractedEvent) Reset()         { *m = RetractedEvent{} }
+func (m *RetractedEvent) String() string { return proto.CompactTextString(m) }
+func (*RetractedEvent) ProtoMessage()    {}
+func (*RetractedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{15}
+}
+
+func (m *RetractedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetractedEvent.Unmarshal(m, b)
+}
+func (m *RetractedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetractedEvent.Marshal(b, m, deterministic)
+}
+func (m *RetractedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetractedEvent.Merge(m, src)
+}
+func (m *RetractedEvent) XXX_Size() int {
+	return xxx_messageInfo_RetractedEvent.Size(m)
+}
+func (m *RetractedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetractedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetractedEvent proto.InternalMessageInfo
+
+func (m *RetractedEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RetractedEvent) GetRetractedId() uint64 {
+	if m != nil {
+		return m.RetractedId
+	}
+	return 0
+}
+
+func (m *RetractedEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+type ErrorEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Error that caused the event.
+	Cause                *Error   `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrorEvent) Reset()         { *m = ErrorEvent{} }
+func (m *ErrorEvent) String() string { return proto.CompactTextString(m) }
+func (*ErrorEvent) ProtoMessage()    {}
+func (*ErrorEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b35960c18fd6d40, []int{16}
+}
+
+func (m *ErrorEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorEvent.Unmarshal(m, b)
+}
+func (m *ErrorEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorEvent.Marshal(b, m, deterministic)
+}
+func (m *ErrorEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorEvent.Merge(m, src)
+}
+func (m *ErrorEvent) XXX_Size() int {
+	return xxx_messageInfo_ErrorEvent.Size(m)
+}
+func (m *ErrorEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorEvent proto.InternalMessageInfo
+
+func (m *ErrorEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ErrorEvent) GetCause() *Error {
+	if m != nil {
+		return m.Cause
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Error)(nil), "event.Error")
+	proto.RegisterType((*SubscribeRequest)(nil), "event.SubscribeRequest")
+	proto.RegisterType((*LogFilter)(nil), "event.LogFilter")
+	proto.RegisterType((*TopicSet)(nil), "event.TopicSet")
+	proto.RegisterType((*SubscribeResponse)(nil), "event.SubscribeResponse")
+	proto.RegisterType((*UnsubscribeRequest)(nil), "event.UnsubscribeRequest")
+	proto.RegisterType((*UnsubscribeResponse)(nil), "event.UnsubscribeResponse")
+	proto.RegisterType((*ListSubscriptionsRequest)(nil), "event.ListSubscriptionsRequest")
+	proto.RegisterType((*ListSubscriptionsResponse)(nil), "event.ListSubscriptionsResponse")
+	proto.RegisterType((*Subscription)(nil), "event.Subscription")
+	proto.RegisterType((*PollEventRequest)(nil), "event.PollEventRequest")
+	proto.RegisterType((*PollEventResponse)(nil), "event.PollEventResponse")
+	proto.RegisterType((*SubscriptionEvent)(nil), "event.SubscriptionEvent")
+	proto.RegisterType((*DataEvent)(nil), "event.DataEvent")
+	proto.RegisterType((*EventArg)(nil), "event.EventArg")
+	proto.RegisterType((*RetractedEvent)(nil), "event.RetractedEvent")
+	proto.RegisterType((*ErrorEvent)(nil), "event.ErrorEvent")
+}
+
+func init() { proto.RegisterFile("api/v0/event/grpc/event.proto", fileDescriptor_8b35960c18fd6d40) }
+
+var fileDescriptor_8b35960c18fd6d40 = []byte{
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x8e, 0x1c, 0x3b, 0xb6, 0x8e, 0x9c, 0x26, 0x66, 0xdb, 0x95, 0xf5, 0x16, 0xd4, 0x55, 0x8b,
+	0xcd, 0xdd, 0xb0, 0x38, 0xf0, 0x7e, 0x80, 0x61, 0x37, 0x6d, 0xfa, 0x83, 0x14, 0xeb, 0x86, 0x82,
+	0xd9, 0x76, 0x31, 0x60, 0x30, 0x68, 0x89, 0xb6, 0x85, 0xc9, 0xa2, 0x4b, 0x52, 0xe9, 0xfa, 0x1e,
+	0x7b, 0x86, 0x3d, 0xc3, 0x1e, 0x67, 0x77, 0xbb, 0xdf, 0x13, 0x0c, 0xfc, 0x91, 0x2c, 0x45, 0x09,
+	0x76, 0xd3, 0x3b, 0x9e, 0xef, 0x7c, 0x3c, 0xfc, 0x78, 0xce, 0xe1, 0x91, 0xe0, 0x88, 0x6e, 0x92,
+	0xc9, 0xc5, 0xc9, 0x84, 0x5d, 0xb0, 0x4c, 0x4d, 0x96, 0x62, 0x13, 0xd9, 0xe5, 0xf1, 0x46, 0x70,
+	0xc5, 0x51, 0xc7, 0x18, 0xe1, 0x19, 0x74, 0x9e, 0x0b, 0xc1, 0x05, 0x3a, 0x02, 0x60, 0x7a, 0x31,
+	0x8b, 0x78, 0xcc, 0xb0, 0x37, 0xf2, 0xc6, 0x1d, 0xe2, 0x1b, 0xe4, 0x29, 0x8f, 0x19, 0x1a, 0x41,
+	0x10, 0x33, 0x19, 0x89, 0x64, 0xa3, 0x12, 0x9e, 0xe1, 0xd6, 0xc8, 0x1b, 0xfb, 0xa4, 0x0a, 0x85,
+	0x7f, 0x79, 0x70, 0x78, 0x9e, 0xcf, 0x35, 0x30, 0x67, 0x84, 0xbd, 0xc9, 0x99, 0x54, 0xe8, 0x03,
+	0xd8, 0x33, 0xe7, 0x48, 0xec, 0x8d, 0x76, 0xc7, 0x3e, 0x71, 0x96, 0xc6, 0x17, 0x49, 0xaa, 0x98,
+	0x70, 0x91, 0x9c, 0x85, 0x26, 0x00, 0x29, 0x5f, 0xce, 0x9c, 0x6f, 0x77, 0xe4, 0x8d, 0x83, 0xe9,
+	0xe1, 0xb1, 0xd5, 0xfd, 0x8a, 0x2f, 0x5f, 0x18, 0x9c, 0xf8, 0x69, 0xb1, 0xd4, 0xb2, 0x17, 0x82,
+	0xaf, 0x67, 0xf3, 0x94, 0x47, 0xbf, 0xe1, 0xf6, 0xc8, 0x1b, 0xb7, 0x89, 0xaf, 0x91, 0x53, 0x0d,
+	0xa0, 0x87, 0xb0, 0x1f, 0xf1, 0x6c, 0x91, 0x88, 0x35, 0xd5, 0x22, 0x25, 0xee, 0x18, 0x46, 0x1d,
+	0x0c, 0x09, 0xf8, 0x65, 0x70, 0xf4, 0x11, 0xf8, 0x34, 0x8e, 0x05, 0x93, 0x92, 0x15, 0xaa, 0xb7,
+	0x00, 0xfa, 0x04, 0xf6, 0x14, 0xdf, 0x24, 0x91, 0xc4, 0xad, 0xd1, 0xee, 0x38, 0x98, 0x1e, 0x38,
+	0x71, 0x3f, 0x6a, 0xf0, 0x9c, 0x29, 0xe2, 0xdc, 0x61, 0x08, 0xbd, 0x02, 0xd3, 0xb7, 0x75, 0x9b,
+	0x5c, 0x16, 0x1c, 0xe7, 0x01, 0x0c, 0x2a, 0x19, 0x93, 0x1b, 0x9e, 0x49, 0x86, 0x6e, 0x40, 0x2b,
+	0x89, 0x4d, 0x01, 0xda, 0xa4, 0x95, 0xc4, 0xe1, 0x43, 0x40, 0x3f, 0x65, 0xf2, 0x72, 0x62, 0x2f,
+	0xb3, 0x6e, 0xc3, 0xcd, 0x1a, 0xcb, 0x06, 0x0b, 0x87, 0x80, 0x5f, 0x25, 0x52, 0xb9, 0x53, 0x4c,
+	0xa1, 0xa4, 0x0b, 0x11, 0xfe, 0x0c, 0x77, 0xaf, 0xf0, 0x39, 0x15, 0xdf, 0xc0, 0xbe, 0xac, 0x3a,
+	0x8c, 0xf2, 0x60, 0x7a, 0xd3, 0x5d, 0xb7, 0xba, 0x89, 0xd4, 0x99, 0xe1, 0xbf, 0x1e, 0xf4, 0xab,
+	0xfe, 0xcb, 0x5a, 0x2b, 0x4d, 0xd1, 0xaa, 0x35, 0xc5, 0xfb, 0x2e, 0xfe, 0x11, 0x40, 0x24, 0x18,
+	0x55, 0x2c, 0x9e, 0x51, 0xe5, 0x2a, 0xef, 0x3b, 0xe4, 0x89, 0x42, 0x9f, 0xc2, 0x20, 0xa5, 0x52,
+	0xd9, 0xdd, 0xb3, 0x2c, 0x5f, 0xcf, 0x99, 0xc0, 0x7b, 0x86, 0x75, 0xa0, 0x1d, 0x26, 0xc8, 0x0f,
+	0x06, 0x46, 0xf7, 0x20, 0x78, 0x93, 0xb3, 0x9c, 0xcd, 0x62, 0xb6, 0x51, 0x2b, 0xdc, 0x35, 0x2c,
+	0x30, 0xd0, 0x33, 0x8d, 0x84, 0x7f, 0x78, 0x70, 0xf8, 0x9a, 0xa7, 0xe9, 0x73, 0xad, 0xf6, 0x9a,
+	0x22, 0xe9, 0x8b, 0xf3, 0xc5, 0x42, 0x32, 0x65, 0xba, 0xbe, 0x4d, 0x9c, 0x85, 0x6e, 0x41, 0x27,
+	0xe2, 0x79, 0xa6, 0xcc, 0x9d, 0xf7, 0x89, 0x35, 0xd0, 0x23, 0x38, 0x8c, 0x13, 0x19, 0x51, 0x11,
+	0xcf, 0x36, 0x82, 0x5d, 0x24, 0x3c, 0x97, 0xe6, 0x8e, 0x3d, 0x72, 0xe0, 0xf0, 0xd7, 0x0e, 0x46,
+	0x77, 0xa0, 0xfb, 0x96, 0x26, 0x6a, 0xb6, 0x2e, 0x1a, 0x7c, 0x4f, 0x9b, 0xdf, 0xcb, 0xf0, 0x57,
+	0x18, 0x54, 0x54, 0xb9, 0xda, 0x6e, 0x65, 0x78, 0x35, 0x19, 0x27, 0xb5, 0xba, 0x04, 0x53, 0x7c,
+	0x45, 0xb1, 0x6d, 0x24, 0xc7, 0x0b, 0xff, 0xf4, 0x60, 0xd0, 0xf0, 0xa2, 0x8f, 0xa1, 0x1d, 0x53,
+	0x45, 0xb1, 0x57, 0xab, 0xe0, 0x33, 0xaa, 0xa8, 0xf1, 0x9f, 0xed, 0x10, 0xe3, 0x47, 0x8f, 0xa0,
+	0x63, 0x06, 0x8c, 0xc9, 0x46, 0x30, 0x1d, 0x38, 0xa2, 0x99, 0x47, 0x05, 0xd3, 0x32, 0xd0, 0x57,
+	0xe0, 0x0b, 0xa6, 0x04, 0x8d, 0x14, 0x8b, 0x5d, 0x67, 0xdc, 0x76, 0x74, 0x52, 0xe0, 0xc5, 0x96,
+	0x2d, 0xf3, 0xb4, 0x0b, 0x6e, 0xcc, 0xfd, 0xd3, 0x02, 0xbf, 0x14, 0xd0, 0xa8, 0x0b, 0x72, 0x82,
+	0xed, 0x2c, 0xb2, 0xe2, 0xb6, 0x6f, 0x76, 0xb7, 0xfa, 0x66, 0x35, 0x57, 0xbd, 0xdb, 0x30, 0x53,
+	0x09, 0x9f, 0x98, 0x35, 0xba, 0x0f, 0xfd, 0x5a, 0x13, 0xd9, 0x1a, 0x04, 0xf3, 0x4a, 0x03, 0xdd,
+	0x72, 0x4a, 0x4c, 0x83, 0xf9, 0xc4, 0x1a, 0xe8, 0x01, 0xb4, 0xa9, 0x58, 0x4a, 0xdc, 0xad, 0xcd,
+	0x12, 0x23, 0xf2, 0x89, 0x58, 0x12, 0xe3, 0xd4, 0x6d, 0x6c, 0xa3, 0xaf, 0xa8, 0x5c, 0xe1, 0x9e,
+	0xd9, 0xef, 0x1b, 0xe4, 0x8c, 0xca, 0x95, 0x6e, 0x13, 0x25, 0x68, 0x26, 0x69, 0xa4, 0x2b, 0x60,
+	0x49, 0xbe, 0x21, 0x1d, 0x54, 0x70, 0x43, 0xfd, 0x0c, 0x06, 0x55, 0x6a, 0x92, 0xc5, 0xec, 0x77,
+	0x0c, 0x46, 0x6c, 0x35, 0xc6, 0x4b, 0x8d, 0xa3, 0x0f, 0x41, 0xbf, 0x34, 0x47, 0x0a, 0x0c, 0xa9,
+	0x97, 0xf2, 0xa5, 0x75, 0x62, 0xe8, 0xba, 0x99, 0x88, 0xfb, 0xe6, 0xac, 0xc2, 0x0c, 0xe7, 0xd0,
+	0x2b, 0xf4, 0xeb, 0x5c, 0x65, 0x74, 0x6d, 0xbf, 0x26, 0x3e, 0x31, 0xeb, 0x32, 0x7f, 0xad, 0x4a,
+	0xfe, 0x30, 0x74, 0xcd, 0x31, 0xae, 0xb6, 0x3d, 0x52, 0x98, 0x3a, 0x6d, 0x17, 0x34, 0xcd, 0x8b,
+	0x74, 0x5b, 0x23, 0x5c, 0xc0, 0x8d, 0x7a, 0xd5, 0x1b, 0x15, 0xbd, 0x0f, 0xfd, 0xb2, 0x0b, 0x66,
+	0x49, 0xec, 0xde, 0x5b, 0x50, 0x62, 0x2f, 0xe3, 0x46, 0xd1, 0x76, 0x1b, 0x45, 0x0b, 0x1f, 0x03,
+	0x6c, 0x9b, 0xb1, 0x71, 0x46, 0x08, 0x9d, 0x88, 0xe6, 0x92, 0xb9, 0xf6, 0xed, 0x57, 0xdb, 0x97,
+	0x58, 0xd7, 0xf4, 0xef, 0x16, 0x74, 0xec, 0xee, 0xc7, 0xe0, 0x97, 0xb3, 0x1e, 0xdd, 0xa9, 0xbf,
+	0xac, 0x72, 0xac, 0x0f, 0x71, 0xd3, 0xe1, 0x26, 0xf9, 0x0e, 0x7a, 0x01, 0x41, 0x65, 0xc4, 0xa3,
+	0xbb, 0x8e, 0xda, 0xfc, 0x38, 0x0c, 0x87, 0x57, 0xb9, 0xca, 0x38, 0xdf, 0x41, 0x5b, 0xcf, 0x7d,
+	0x74, 0xaf, 0x18, 0xad, 0xd7, 0x7c, 0x20, 0x86, 0xa3, 0xeb, 0x09, 0x65, 0xb0, 0x6f, 0xa1, 0xad,
+	0x07, 0x4c, 0x79, 0xa3, 0xcb, 0x33, 0x70, 0x88, 0x9b, 0x8e, 0x72, 0xf3, 0x53, 0x00, 0x0d, 0x9f,
+	0x2b, 0xc1, 0xe8, 0xfa, 0xff, 0x43, 0x34, 0x26, 0x4d, 0xb8, 0x73, 0xe2, 0x9d, 0x7e, 0xfd, 0xcb,
+	0x97, 0xcb, 0x44, 0xad, 0xf2, 0xf9, 0x71, 0xc4, 0xd7, 0x13, 0x4e, 0x65, 0x22, 0x53, 0x3a, 0x97,
+	0x76, 0xf5, 0xf9, 0x92, 0x2a, 0xf6, 0x96, 0xbe, 0x9b, 0x34, 0x7e, 0x86, 0xe6, 0x7b, 0xe6, 0x3f,
+	0xe8, 0x8b, 0xff, 0x06, 0x00, 0x56, 0x42, 0xb0, 0xfe, 0x28, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventClient is the client API for Event service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventClient interface {
+	// Subscribe to a topic.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	// Destroy a subscription.
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	// List the subscriptions owned by the session.
+	List(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// Poll for the events of a subscription.
+	Poll(ctx context.Context, in *PollEventRequest, opts ...grpc.CallOption) (*PollEventResponse, error)
+	// Stream the events of a subscription as they become available.
+	PollStream(ctx context.Context, in *PollEventRequest, opts ...grpc.CallOption) (Event_PollStreamClient, error)
+}
+
+type eventClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventClient(cc *grpc.ClientConn) EventClient {
+	return &eventClient{cc}
+}
+
+func (c *eventClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, "/event.Event/Subscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, "/event.Event/Unsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) List(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/event.Event/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) Poll(ctx context.Context, in *PollEventRequest, opts ...grpc.CallOption) (*PollEventResponse, error) {
+	out := new(PollEventResponse)
+	err := c.cc.Invoke(ctx, "/event.Event/Poll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) PollStream(ctx context.Context, in *PollEventRequest, opts ...grpc.CallOption) (Event_PollStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/event.Event/PollStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventPollStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Event_PollStreamClient interface {
+	Recv() (*SubscriptionEvent, error)
+	grpc.ClientStream
+}
+
+type eventPollStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventPollStreamClient) Recv() (*SubscriptionEvent, error) {
+	m := new(SubscriptionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventServer is the server API for Event service.
+type EventServer interface {
+	// Subscribe to a topic.
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	// Destroy a subscription.
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	// List the subscriptions owned by the session.
+	List(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// Poll for the events of a subscription.
+	Poll(context.Context, *PollEventRequest) (*PollEventResponse, error)
+	// Stream the events of a subscription as they become available.
+	PollStream(*PollEventRequest, Event_PollStreamServer) error
+}
+
+// UnimplementedEventServer can be embedded to have forward compatible implementations.
+type UnimplementedEventServer struct {
+}
+
+func (*UnimplementedEventServer) Subscribe(ctx context.Context, req *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedEventServer) Unsubscribe(ctx context.Context, req *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (*UnimplementedEventServer) List(ctx context.Context, req *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedEventServer) Poll(ctx context.Context, req *PollEventRequest) (*PollEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
+}
+func (*UnimplementedEventServer) PollStream(req *PollEventRequest, srv Event_PollStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PollStream not implemented")
+}
+
+func RegisterEventServer(s *grpc.Server, srv EventServer) {
+	s.RegisterService(&_Event_serviceDesc, srv)
+}
+
+func _Event_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.Event/Subscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.Event/Unsubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.Event/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).List(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).Poll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.Event/Poll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Poll(ctx, req.(*PollEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_PollStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PollEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServer).PollStream(m, &eventPollStreamServer{stream})
+}
+
+type Event_PollStreamServer interface {
+	Send(*SubscriptionEvent) error
+	grpc.ServerStream
+}
+
+type eventPollStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventPollStreamServer) Send(m *SubscriptionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Event_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "event.Event",
+	HandlerType: (*EventServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Subscribe",
+			Handler:    _Event_Subscribe_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _Event_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Event_List_Handler,
+		},
+		{
+			MethodName: "Poll",
+			Handler:    _Event_Poll_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PollStream",
+			Handler:       _Event_PollStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/v0/event/grpc/event.proto",
+}
@@ -0,0 +1,185 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/oasislabs/oasis-gateway/api/v0/event"
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// Server is the implementation of EventServer. It converts the
+// protobuf messages to the entities of the event API and serves
+// the calls with the handlers bound by event.BindHandler
+type Server struct {
+	handler *rpc.GrpcHandler
+}
+
+// NewServer creates a new instance of the Server. It will panic
+// in case there are errors in its construction
+func NewServer(handler *rpc.GrpcHandler) *Server {
+	if handler == nil {
+		panic("handler must be set")
+	}
+
+	return &Server{handler: handler}
+}
+
+// Subscribe is the implementation of EventServer for Server
+func (s *Server) Subscribe(ctx context.Context, req *SubscribeRequest) (*SubscribeResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/event/subscribe", &event.SubscribeRequest{
+		Events:        req.Events,
+		Filter:        req.Filter,
+		LogFilter:     mapLogFilter(req.LogFilter),
+		FromBlock:     req.FromBlock,
+		Confirmations: req.Confirmations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubscribeResponse{Id: v.(event.SubscribeResponse).ID}, nil
+}
+
+// mapLogFilter maps the log filter of a request to the filter of
+// the event API. Empty topic sets are wildcards
+func mapLogFilter(filter *LogFilter) *event.LogFilter {
+	if filter == nil {
+		return nil
+	}
+
+	topics := make([][]string, 0, len(filter.Topics))
+	for _, set := range filter.Topics {
+		topics = append(topics, set.GetTopics())
+	}
+
+	return &event.LogFilter{Addresses: filter.Addresses, Topics: topics}
+}
+
+// Unsubscribe is the implementation of EventServer for Server
+func (s *Server) Unsubscribe(ctx context.Context, req *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	if _, err := s.handler.Handle(ctx, "/v0/api/event/unsubscribe", &event.UnsubscribeRequest{
+		ID: req.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &UnsubscribeResponse{}, nil
+}
+
+// List is the implementation of EventServer for Server
+func (s *Server) List(ctx context.Context, req *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/event/list", &event.ListSubscriptionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(event.ListSubscriptionsResponse)
+	subs := make([]*Subscription, 0, len(res.Subscriptions))
+	for _, sub := range res.Subscriptions {
+		topics := make([]*TopicSet, 0, len(sub.LogFilter.Topics))
+		for _, set := range sub.LogFilter.Topics {
+			topics = append(topics, &TopicSet{Topics: set})
+		}
+
+		subs = append(subs, &Subscription{
+			Id:     sub.ID,
+			Events: sub.Events,
+			LogFilter: &LogFilter{
+				Addresses: sub.LogFilter.Addresses,
+				Topics:    topics,
+			},
+			FromBlock:       sub.FromBlock,
+			CreatedAt:       sub.CreatedAt,
+			LastBlockNumber: sub.LastBlockNumber,
+			QueueDepth:      sub.QueueDepth,
+		})
+	}
+
+	return &ListSubscriptionsResponse{Subscriptions: subs}, nil
+}
+
+// Poll is the implementation of EventServer for Server
+func (s *Server) Poll(ctx context.Context, req *PollEventRequest) (*PollEventResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/event/poll", mapPollEventRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(event.PollEventResponse)
+	events := make([]*SubscriptionEvent, 0, len(res.Events))
+	for _, ev := range res.Events {
+		events = append(events, mapEvent(ev))
+	}
+
+	return &PollEventResponse{Offset: res.Offset, Events: events}, nil
+}
+
+// PollStream is the implementation of EventServer for Server
+func (s *Server) PollStream(req *PollEventRequest, srv Event_PollStreamServer) error {
+	return s.handler.Stream(srv.Context(), "/v0/api/event/poll/stream", mapPollEventRequest(req),
+		func(v interface{}) error {
+			return srv.Send(mapEvent(v.(event.Event)))
+		})
+}
+
+func mapPollEventRequest(req *PollEventRequest) *event.PollEventRequest {
+	return &event.PollEventRequest{
+		ID:              req.Id,
+		Offset:          req.Offset,
+		Count:           uint(req.Count),
+		DiscardPrevious: req.DiscardPrevious,
+		WaitMs:          req.WaitMs,
+	}
+}
+
+// mapEventArgs maps the arguments of a decoded log to their protobuf messages
+func mapEventArgs(args []event.EventArg) []*EventArg {
+	var res []*EventArg
+	for _, arg := range args {
+		res = append(res, &EventArg{
+			Name:    arg.Name,
+			Type:    arg.Type,
+			Indexed: arg.Indexed,
+			Value:   arg.Value,
+		})
+	}
+
+	return res
+}
+
+// mapEvent maps an event of the event API to its protobuf message
+func mapEvent(ev event.Event) *SubscriptionEvent {
+	switch ev := ev.(type) {
+	case event.DataEvent:
+		return &SubscriptionEvent{Event: &SubscriptionEvent_Data{Data: &DataEvent{
+			Id:               ev.ID,
+			Data:             ev.Data,
+			Topics:           ev.Topics,
+			Type:             ev.Type,
+			BlockNumber:      ev.BlockNumber,
+			Event:            ev.Event,
+			Args:             mapEventArgs(ev.Args),
+			BlockHash:        ev.BlockHash,
+			TransactionHash:  ev.TransactionHash,
+			TransactionIndex: ev.TransactionIndex,
+			LogIndex:         ev.LogIndex,
+			Address:          ev.Address,
+		}}}
+	case event.RetractedEvent:
+		return &SubscriptionEvent{Event: &SubscriptionEvent_Retracted{Retracted: &RetractedEvent{
+			Id:          ev.ID,
+			RetractedId: ev.RetractedID,
+			BlockNumber: ev.BlockNumber,
+		}}}
+	case event.ErrorEvent:
+		return &SubscriptionEvent{Event: &SubscriptionEvent_Error{Error: &ErrorEvent{
+			Id: ev.ID,
+			Cause: &Error{
+				ErrorCode:   int32(ev.Cause.ErrorCode),
+				Description: ev.Cause.Description,
+			},
+		}}}
+	default:
+		panic("received unexpected event type from event handler")
+	}
+}
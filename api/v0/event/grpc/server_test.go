@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/oasislabs/oasis-gateway/api/v0/event"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+var Logger = log.NewLogrus(log.LogrusLoggerProperties{
+	Output: ioutil.Discard,
+})
+
+func newServer(path string, handler rpc.HandlerFunc) *Server {
+	binder := rpc.NewGrpcBinder(rpc.GrpcBinderProperties{Logger: Logger})
+	binder.Bind("POST", path, handler, nil)
+	return NewServer(binder.Build())
+}
+
+func TestServerSubscribe(t *testing.T) {
+	server := newServer("/v0/api/event/subscribe", func(ctx context.Context, v interface{}) (interface{}, error) {
+		assert.Equal(t, &event.SubscribeRequest{Events: []string{"logs"}, Filter: "address=0x00", Confirmations: 2}, v)
+		return event.SubscribeResponse{ID: 1}, nil
+	})
+
+	res, err := server.Subscribe(context.Background(), &SubscribeRequest{
+		Events:        []string{"logs"},
+		Filter:        "address=0x00",
+		Confirmations: 2,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &SubscribeResponse{Id: 1}, res)
+}
+
+func TestServerSubscribeLogFilter(t *testing.T) {
+	server := newServer("/v0/api/event/subscribe", func(ctx context.Context, v interface{}) (interface{}, error) {
+		assert.Equal(t, &event.SubscribeRequest{
+			Events: []string{"logs"},
+			LogFilter: &event.LogFilter{
+				Addresses: []string{"0x00", "0x01"},
+				Topics:    [][]string{nil, {"0x02", "0x03"}},
+			},
+		}, v)
+		return event.SubscribeResponse{ID: 1}, nil
+	})
+
+	res, err := server.Subscribe(context.Background(), &SubscribeRequest{
+		Events: []string{"logs"},
+		LogFilter: &LogFilter{
+			Addresses: []string{"0x00", "0x01"},
+			Topics:    []*TopicSet{{}, {Topics: []string{"0x02", "0x03"}}},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &SubscribeResponse{Id: 1}, res)
+}
+
+func TestServerList(t *testing.T) {
+	server := newServer("/v0/api/event/list", func(ctx context.Context, v interface{}) (interface{}, error) {
+		assert.Equal(t, &event.ListSubscriptionsRequest{}, v)
+		return event.ListSubscriptionsResponse{Subscriptions: []event.Subscription{
+			{
+				ID:              1,
+				Events:          []string{"logs"},
+				LogFilter:       event.LogFilter{Addresses: []string{"0x00"}, Topics: [][]string{nil, {"0x01"}}},
+				CreatedAt:       2,
+				LastBlockNumber: 3,
+				QueueDepth:      4,
+			},
+		}}, nil
+	})
+
+	res, err := server.List(context.Background(), &ListSubscriptionsRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &ListSubscriptionsResponse{Subscriptions: []*Subscription{
+		{
+			Id:     1,
+			Events: []string{"logs"},
+			LogFilter: &LogFilter{
+				Addresses: []string{"0x00"},
+				Topics:    []*TopicSet{{}, {Topics: []string{"0x01"}}},
+			},
+			CreatedAt:       2,
+			LastBlockNumber: 3,
+			QueueDepth:      4,
+		},
+	}}, res)
+}
+
+func TestServerPoll(t *testing.T) {
+	server := newServer("/v0/api/event/poll", func(ctx context.Context, v interface{}) (interface{}, error) {
+		assert.Equal(t, &event.PollEventRequest{ID: 1, Offset: 2, Count: 3}, v)
+		return event.PollEventResponse{Offset: 2, Events: []event.Event{
+			event.DataEvent{ID: 2, Data: "0x00", Topics: []string{"0x01"}, Event: "Transfer",
+				Args:      []event.EventArg{{Name: "value", Type: "uint256", Value: "1"}},
+				BlockHash: "0x02", TransactionHash: "0x03", TransactionIndex: 4, LogIndex: 5, Address: "0x06"},
+			event.ErrorEvent{ID: 3, Cause: rpc.Error{ErrorCode: 1000, Description: "error"}},
+			event.RetractedEvent{ID: 4, RetractedID: 2, BlockNumber: 5},
+		}}, nil
+	})
+
+	res, err := server.Poll(context.Background(), &PollEventRequest{Id: 1, Offset: 2, Count: 3})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PollEventResponse{Offset: 2, Events: []*SubscriptionEvent{
+		{Event: &SubscriptionEvent_Data{Data: &DataEvent{Id: 2, Data: "0x00", Topics: []string{"0x01"}, Event: "Transfer",
+			Args:      []*EventArg{{Name: "value", Type: "uint256", Value: "1"}},
+			BlockHash: "0x02", TransactionHash: "0x03", TransactionIndex: 4, LogIndex: 5, Address: "0x06"}}},
+		{Event: &SubscriptionEvent_Error{Error: &ErrorEvent{Id: 3, Cause: &Error{ErrorCode: 1000, Description: "error"}}}},
+		{Event: &SubscriptionEvent_Retracted{Retracted: &RetractedEvent{Id: 4, RetractedId: 2, BlockNumber: 5}}},
+	}}, res)
+}
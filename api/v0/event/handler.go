@@ -3,8 +3,11 @@ package event
 import (
 	"context"
 	stderr "errors"
+	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/oasislabs/oasis-gateway/api/v0/stream"
 	auth "github.com/oasislabs/oasis-gateway/auth/core"
 	backend "github.com/oasislabs/oasis-gateway/backend/core"
 	"github.com/oasislabs/oasis-gateway/errors"
@@ -18,18 +21,24 @@ type Client interface {
 	Subscribe(context.Context, backend.SubscribeRequest) (uint64, errors.Err)
 	Unsubscribe(context.Context, backend.UnsubscribeRequest) errors.Err
 	PollEvent(context.Context, backend.PollEventRequest) (backend.Events, errors.Err)
+	ListSubscriptions(context.Context, backend.ListSubscriptionsRequest) (backend.ListSubscriptionsResponse, errors.Err)
 }
 
 type Services struct {
 	Logger log.Logger
 	Client Client
+
+	// WriteTimeout is the write timeout of the http server that
+	// serves the handler, which bounds the time requests can wait
+	WriteTimeout time.Duration
 }
 
 // EventHandler implements the handlers associated with subscriptions and
 // event polling
 type EventHandler struct {
-	logger log.Logger
-	client Client
+	logger  log.Logger
+	client  Client
+	maxWait time.Duration
 }
 
 // Subscribe creates a new subscription for the client on the required
@@ -46,17 +55,8 @@ func (h EventHandler) Subscribe(ctx context.Context, v interface{}) (interface{}
 		return nil, err
 	}
 
-	if len(req.Events) > 1 {
-		err := errors.New(errors.ErrEmptyInput, stderr.New("only one event supported"))
-		h.logger.Debug(ctx, "failed to handle request", log.MapFields{
-			"call_type": "SubscribeFailure",
-		}, err)
-		return nil, err
-	}
-
-	query, derr := url.ParseQuery(req.Filter)
-	if derr != nil {
-		err := errors.New(errors.ErrParseQueryParams, derr)
+	filter, err := parseLogFilter(req)
+	if err != nil {
 		h.logger.Debug(ctx, "failed to handle request", log.MapFields{
 			"call_type": "SubscribeFailure",
 		}, err)
@@ -64,10 +64,12 @@ func (h EventHandler) Subscribe(ctx context.Context, v interface{}) (interface{}
 	}
 
 	id, err := h.client.Subscribe(ctx, backend.SubscribeRequest{
-		Event:      req.Events[0],
-		Address:    query.Get("address"),
-		SessionKey: session,
-		Topics:     query["topic"],
+		Events:        req.Events,
+		Addresses:     filter.Addresses,
+		SessionKey:    session,
+		Topics:        filter.Topics,
+		FromBlock:     req.FromBlock,
+		Confirmations: req.Confirmations,
 	})
 	if err != nil {
 		h.logger.Debug(ctx, "failed to subscribe", log.MapFields{
@@ -81,6 +83,32 @@ func (h EventHandler) Subscribe(ctx context.Context, v interface{}) (interface{}
 	}, nil
 }
 
+// parseLogFilter returns the filter of the subscription, which is either
+// the structured LogFilter or the query parameters of the Filter, with
+// its address parameters and a single topic for each topic parameter
+func parseLogFilter(req *SubscribeRequest) (LogFilter, errors.Err) {
+	if req.LogFilter != nil {
+		if len(req.Filter) > 0 {
+			return LogFilter{}, errors.New(errors.ErrInvalidSubscriptionFilter,
+				stderr.New("filter and logFilter cannot be set together"))
+		}
+
+		return *req.LogFilter, nil
+	}
+
+	query, err := url.ParseQuery(req.Filter)
+	if err != nil {
+		return LogFilter{}, errors.New(errors.ErrParseQueryParams, err)
+	}
+
+	var topics [][]string
+	for _, topic := range query["topic"] {
+		topics = append(topics, []string{topic})
+	}
+
+	return LogFilter{Addresses: query["address"], Topics: topics}, nil
+}
+
 // Unsubscribe destroys an existing client subscription and all the
 // resources associated with it
 func (h EventHandler) Unsubscribe(ctx context.Context, v interface{}) (interface{}, error) {
@@ -102,6 +130,40 @@ func (h EventHandler) Unsubscribe(ctx context.Context, v interface{}) (interface
 	return nil, nil
 }
 
+// ListSubscriptions returns the subscriptions owned by the session, so
+// that clients that lost track of them can poll them or unsubscribe
+func (h EventHandler) ListSubscriptions(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+
+	res, err := h.client.ListSubscriptions(ctx, backend.ListSubscriptionsRequest{
+		SessionKey: session,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "failed to list subscriptions", log.MapFields{
+			"call_type": "ListSubscriptionsFailure",
+		}, err)
+		return nil, err
+	}
+
+	subs := make([]Subscription, 0, len(res.Subscriptions))
+	for _, sub := range res.Subscriptions {
+		subs = append(subs, Subscription{
+			ID:     sub.ID,
+			Events: sub.Events,
+			LogFilter: LogFilter{
+				Addresses: sub.Addresses,
+				Topics:    sub.Topics,
+			},
+			FromBlock:       sub.FromBlock,
+			CreatedAt:       uint64(sub.CreatedAt.UnixNano() / int64(time.Millisecond)),
+			LastBlockNumber: sub.LastBlockNumber,
+			QueueDepth:      sub.QueueDepth,
+		})
+	}
+
+	return ListSubscriptionsResponse{Subscriptions: subs}, nil
+}
+
 // EventPoll allows the user to query for new events associated
 // with a specific subscription
 func (h EventHandler) PollEvent(ctx context.Context, v interface{}) (interface{}, error) {
@@ -116,6 +178,7 @@ func (h EventHandler) PollEvent(ctx context.Context, v interface{}) (interface{}
 		Count:           req.Count,
 		Offset:          req.Offset,
 		ID:              req.ID,
+		Wait:            h.pollWait(req.WaitMs),
 		SessionKey:      session,
 	})
 	if err != nil {
@@ -128,21 +191,7 @@ func (h EventHandler) PollEvent(ctx context.Context, v interface{}) (interface{}
 
 	events := make([]Event, 0, len(res.Events))
 	for _, r := range res.Events {
-		switch r := r.(type) {
-		case backend.ErrorEvent:
-			events = append(events, ErrorEvent{
-				ID:    r.ID,
-				Cause: r.Cause,
-			})
-		case backend.DataEvent:
-			events = append(events, DataEvent{
-				ID:     r.ID,
-				Data:   r.Data,
-				Topics: r.Topics,
-			})
-		default:
-			panic("received unexpected event type from polling service")
-		}
+		events = append(events, MapEvent(r))
 	}
 
 	return PollEventResponse{
@@ -151,6 +200,122 @@ func (h EventHandler) PollEvent(ctx context.Context, v interface{}) (interface{}
 	}, nil
 }
 
+// pollWait returns the time a poll request should wait for
+// events to be available
+func (h EventHandler) pollWait(waitMs uint64) time.Duration {
+	wait := time.Duration(waitMs) * time.Millisecond
+	if wait > h.maxWait {
+		return h.maxWait
+	}
+
+	return wait
+}
+
+// PollEventStream streams the events of a subscription to the client
+// as server-sent events as they become available
+func (h EventHandler) PollEventStream(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*PollEventRequest)
+	if req.Count == 0 {
+		req.Count = stream.PollCount
+	}
+
+	return pollEventStream{
+		logger:  h.logger,
+		client:  h.client,
+		session: session,
+		req:     *req,
+		maxWait: h.maxWait,
+	}, nil
+}
+
+// pollEventStream implements rpc.HttpStream and rpc.GrpcStream to
+// serve the events of a subscription as they become available
+type pollEventStream struct {
+	logger  log.Logger
+	client  Client
+	session string
+	req     PollEventRequest
+	maxWait time.Duration
+}
+
+// ServeStream is the implementation of rpc.HttpStream for pollEventStream
+func (s pollEventStream) ServeStream(res http.ResponseWriter, req *http.Request) error {
+	return stream.ServeEventStream(res, req, s.props())
+}
+
+// ServeGrpcStream is the implementation of rpc.GrpcStream for pollEventStream
+func (s pollEventStream) ServeGrpcStream(ctx context.Context, send func(interface{}) error) error {
+	return stream.ServeGrpcEventStream(ctx, s.props(), send)
+}
+
+// props returns the properties of the stream, which are the same
+// for the server-sent event streams and the gRPC streams
+func (s pollEventStream) props() stream.EventStreamProps {
+	return stream.EventStreamProps{
+		Logger: s.logger,
+		Offset: s.req.Offset,
+		Poll: func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+			return s.client.PollEvent(ctx, backend.PollEventRequest{
+				DiscardPrevious: s.req.DiscardPrevious,
+				Count:           s.req.Count,
+				Offset:          offset,
+				ID:              s.req.ID,
+				SessionKey:      s.session,
+			})
+		},
+		Map: func(ev backend.Event) interface{} {
+			return MapEvent(ev)
+		},
+		MaxDuration: s.maxWait,
+	}
+}
+
+// MapEvent maps an event generated by the backend to the event
+// exposed through the API
+func MapEvent(event backend.Event) Event {
+	switch r := event.(type) {
+	case backend.ErrorEvent:
+		return ErrorEvent{
+			ID:    r.ID,
+			Cause: r.Cause,
+		}
+	case backend.DataEvent:
+		var args []EventArg
+		for _, arg := range r.Args {
+			args = append(args, EventArg{
+				Name:    arg.Name,
+				Type:    arg.Type,
+				Indexed: arg.Indexed,
+				Value:   arg.Value,
+			})
+		}
+
+		return DataEvent{
+			ID:               r.ID,
+			Type:             r.Type,
+			Data:             r.Data,
+			Topics:           r.Topics,
+			BlockNumber:      r.BlockNumber,
+			BlockHash:        r.BlockHash,
+			TransactionHash:  r.TransactionHash,
+			TransactionIndex: uint64(r.TransactionIndex),
+			LogIndex:         uint64(r.LogIndex),
+			Address:          r.Address,
+			Event:            r.Event,
+			Args:             args,
+		}
+	case backend.RetractedEvent:
+		return RetractedEvent{
+			ID:          r.ID,
+			RetractedID: r.RetractedID,
+			BlockNumber: r.BlockNumber,
+		}
+	default:
+		panic("received unexpected event type from polling service")
+	}
+}
+
 func NewEventHandler(services Services) EventHandler {
 	if services.Client == nil {
 		panic("Request must be provided as a service")
@@ -160,8 +325,9 @@ func NewEventHandler(services Services) EventHandler {
 	}
 
 	return EventHandler{
-		logger: services.Logger.ForClass("event", "handler"),
-		client: services.Client,
+		logger:  services.Logger.ForClass("event", "handler"),
+		client:  services.Client,
+		maxWait: stream.MaxWait(services.WriteTimeout),
 	}
 }
 
@@ -170,10 +336,16 @@ func NewEventHandler(services Services) EventHandler {
 func BindHandler(services Services, binder rpc.HandlerBinder) {
 	handler := NewEventHandler(services)
 
-	binder.Bind("POST", "/v0/api/event/subscribe", rpc.HandlerFunc(handler.Subscribe),
+	binder.Bind("POST", "/v0/api/event/subscribe", rpc.Describe(rpc.HandlerFunc(handler.Subscribe), SubscribeResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &SubscribeRequest{} }))
 	binder.Bind("POST", "/v0/api/event/unsubscribe", rpc.HandlerFunc(handler.Unsubscribe),
 		rpc.EntityFactoryFunc(func() interface{} { return &UnsubscribeRequest{} }))
-	binder.Bind("POST", "/v0/api/event/poll", rpc.HandlerFunc(handler.PollEvent),
+	binder.Bind("POST", "/v0/api/event/list", rpc.Describe(rpc.HandlerFunc(handler.ListSubscriptions), ListSubscriptionsResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &ListSubscriptionsRequest{} }))
+	binder.Bind("POST", "/v0/api/event/poll", rpc.Describe(rpc.HandlerFunc(handler.PollEvent), PollEventResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &PollEventRequest{} }))
+	binder.Bind("POST", "/v0/api/event/poll/stream", rpc.HandlerFunc(handler.PollEventStream),
+		rpc.EntityFactoryFunc(func() interface{} { return &PollEventRequest{} }))
+	binder.Bind("GET", "/v0/api/event/poll/stream", rpc.HandlerFunc(handler.PollEventStream),
 		rpc.EntityFactoryFunc(func() interface{} { return &PollEventRequest{} }))
 }
@@ -3,7 +3,9 @@ package event
 import (
 	"context"
 	"io/ioutil"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	auth "github.com/oasislabs/oasis-gateway/auth/core"
 	backend "github.com/oasislabs/oasis-gateway/backend/core"
@@ -60,6 +62,18 @@ func (c *MockClient) PollEvent(
 	return args.Get(0).(backend.Events), nil
 }
 
+func (c *MockClient) ListSubscriptions(
+	ctx context.Context,
+	req backend.ListSubscriptionsRequest,
+) (backend.ListSubscriptionsResponse, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.ListSubscriptionsResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.ListSubscriptionsResponse), nil
+}
+
 type InvalidEvent struct{}
 
 func (e InvalidEvent) EventID() uint64 {
@@ -91,18 +105,26 @@ func TestSubscribeErrNoEvents(t *testing.T) {
 	assert.Equal(t, "[2007] error code InputError with desc Input cannot be empty. with cause no events set on request", err.Error())
 }
 
-func TestSubscribeErrTooManyEvents(t *testing.T) {
+func TestSubscribeOKMultipleEvents(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
 
 	handler := createEventHandler()
 
-	_, err := handler.Subscribe(ctx, &SubscribeRequest{
-		Events: []string{"event1", "event2"},
+	handler.client.(*MockClient).On("Subscribe", mock.Anything, mock.Anything).
+		Return(uint64(1), nil)
+
+	res, err := handler.Subscribe(ctx, &SubscribeRequest{
+		Events: []string{"logs", "newHeads"},
 		Filter: "",
 	})
 
-	assert.Equal(t, "[2007] error code InputError with desc Input cannot be empty. with cause only one event supported", err.Error())
+	assert.Nil(t, err)
+	assert.Equal(t, SubscribeResponse{ID: 1}, res)
+	handler.client.(*MockClient).AssertCalled(t, "Subscribe", ctx, backend.SubscribeRequest{
+		Events:     []string{"logs", "newHeads"},
+		SessionKey: "sessionKey",
+	})
 }
 
 func TestSubscribeErrInvalidQueryParams(t *testing.T) {
@@ -157,10 +179,10 @@ func TestSubscribeOKWithTopics(t *testing.T) {
 		ID: 1,
 	}, res)
 	handler.client.(*MockClient).AssertCalled(t, "Subscribe", ctx, backend.SubscribeRequest{
-		Event:      "event",
-		Address:    "myaddress",
+		Events:     []string{"event"},
+		Addresses:  []string{"myaddress"},
 		SessionKey: "sessionKey",
-		Topics:     []string{"topic1", "topic2"},
+		Topics:     [][]string{{"topic1"}, {"topic2"}},
 	})
 }
 
@@ -183,13 +205,58 @@ func TestSubscribeOKNoTopic(t *testing.T) {
 		ID: 1,
 	}, res)
 	handler.client.(*MockClient).AssertCalled(t, "Subscribe", ctx, backend.SubscribeRequest{
-		Event:      "event",
-		Address:    "myaddress",
+		Events:     []string{"event"},
+		Addresses:  []string{"myaddress"},
 		SessionKey: "sessionKey",
 		Topics:     nil,
 	})
 }
 
+func TestSubscribeOKLogFilter(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createEventHandler()
+
+	handler.client.(*MockClient).On("Subscribe", mock.Anything, mock.Anything).
+		Return(uint64(1), nil)
+
+	_, err := handler.Subscribe(ctx, &SubscribeRequest{
+		Events: []string{"logs"},
+		LogFilter: &LogFilter{
+			Addresses: []string{"address1", "address2"},
+			Topics:    [][]string{nil, {"topic1", "topic2"}},
+		},
+		FromBlock:     10,
+		Confirmations: 2,
+	})
+
+	assert.Nil(t, err)
+	handler.client.(*MockClient).AssertCalled(t, "Subscribe", ctx, backend.SubscribeRequest{
+		Events:        []string{"logs"},
+		Addresses:     []string{"address1", "address2"},
+		SessionKey:    "sessionKey",
+		Topics:        [][]string{nil, {"topic1", "topic2"}},
+		FromBlock:     10,
+		Confirmations: 2,
+	})
+}
+
+func TestSubscribeErrFilterAndLogFilter(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createEventHandler()
+
+	_, err := handler.Subscribe(ctx, &SubscribeRequest{
+		Events:    []string{"logs"},
+		Filter:    "address=address",
+		LogFilter: &LogFilter{Addresses: []string{"address"}},
+	})
+
+	assert.Equal(t, "[2020] error code InputError with desc Provided invalid subscription filter. with cause filter and logFilter cannot be set together", err.Error())
+}
+
 func TestUnsubscribeOK(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
@@ -223,6 +290,58 @@ func TestUnsubscribeErrReturn(t *testing.T) {
 	assert.Equal(t, "[1000] error code InternalError with desc Internal Error. Please check the status of the service.", err.Error())
 }
 
+func TestListSubscriptionsOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createEventHandler()
+
+	handler.client.(*MockClient).On("ListSubscriptions", mock.Anything, mock.Anything).
+		Return(backend.ListSubscriptionsResponse{Subscriptions: []backend.SubscriptionInfo{
+			{
+				ID:              1,
+				Events:          []string{"logs"},
+				Addresses:       []string{"0x01"},
+				Topics:          [][]string{nil, {"0x02"}},
+				FromBlock:       3,
+				CreatedAt:       time.Unix(4, 0),
+				LastBlockNumber: 5,
+				QueueDepth:      6,
+			},
+		}}, nil)
+
+	res, err := handler.ListSubscriptions(ctx, &ListSubscriptionsRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, ListSubscriptionsResponse{Subscriptions: []Subscription{
+		{
+			ID:              1,
+			Events:          []string{"logs"},
+			LogFilter:       LogFilter{Addresses: []string{"0x01"}, Topics: [][]string{nil, {"0x02"}}},
+			FromBlock:       3,
+			CreatedAt:       4000,
+			LastBlockNumber: 5,
+			QueueDepth:      6,
+		},
+	}}, res)
+	handler.client.(*MockClient).AssertCalled(t, "ListSubscriptions", ctx,
+		backend.ListSubscriptionsRequest{SessionKey: "sessionKey"})
+}
+
+func TestListSubscriptionsErrReturn(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createEventHandler()
+
+	handler.client.(*MockClient).On("ListSubscriptions", mock.Anything, mock.Anything).
+		Return(backend.ListSubscriptionsResponse{}, errors.New(errors.ErrInternalError, nil))
+
+	_, err := handler.ListSubscriptions(ctx, &ListSubscriptionsRequest{})
+
+	assert.Equal(t, "[1000] error code InternalError with desc Internal Error. Please check the status of the service.", err.Error())
+}
+
 func TestPollEventOKEmpty(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
@@ -254,13 +373,26 @@ func TestPollEventOKMultiple(t *testing.T) {
 			Offset: 0,
 			Events: []backend.Event{
 				backend.DataEvent{
-					ID:   0,
-					Data: "0x000000",
+					ID:               0,
+					Type:             "logs",
+					Data:             "0x000000",
+					Topics:           []string{"0x01"},
+					BlockNumber:      2,
+					BlockHash:        "0x02",
+					TransactionHash:  "0x03",
+					TransactionIndex: 4,
+					LogIndex:         5,
+					Address:          "0x06",
 				},
 				backend.ErrorEvent{
 					ID:    1,
 					Cause: rpc.Error{},
 				},
+				backend.RetractedEvent{
+					ID:          2,
+					RetractedID: 0,
+					BlockNumber: 2,
+				},
 			}}, nil)
 
 	res, err := handler.PollEvent(ctx, &PollEventRequest{
@@ -272,15 +404,52 @@ func TestPollEventOKMultiple(t *testing.T) {
 		Offset: 0,
 		Events: []Event{
 			DataEvent{
-				ID:   0,
-				Data: "0x000000"},
+				ID:               0,
+				Type:             "logs",
+				Data:             "0x000000",
+				Topics:           []string{"0x01"},
+				BlockNumber:      2,
+				BlockHash:        "0x02",
+				TransactionHash:  "0x03",
+				TransactionIndex: 4,
+				LogIndex:         5,
+				Address:          "0x06",
+			},
 			ErrorEvent{
 				ID:    1,
 				Cause: rpc.Error{},
 			},
+			RetractedEvent{
+				ID:          2,
+				RetractedID: 0,
+				BlockNumber: 2,
+			},
 		}}, res)
 }
 
+func TestPollEventOKWait(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createEventHandler()
+
+	handler.client.(*MockClient).On("PollEvent", mock.Anything, backend.PollEventRequest{
+		Offset:     0,
+		Count:      10,
+		ID:         0,
+		Wait:       8 * time.Second,
+		SessionKey: "sessionKey",
+	}).Return(backend.Events{Offset: 0}, nil)
+
+	res, err := handler.PollEvent(ctx, &PollEventRequest{
+		Offset: 0,
+		WaitMs: 3600000,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, PollEventResponse{Offset: 0, Events: []Event{}}, res)
+}
+
 func TestPollEventErrUnknown(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
@@ -318,6 +487,49 @@ func TestPollEventErrReturn(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPollEventStreamOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createEventHandler()
+
+	handler.client.(*MockClient).On("PollEvent", mock.Anything, backend.PollEventRequest{
+		Offset:          1,
+		Count:           16,
+		DiscardPrevious: true,
+		ID:              2,
+		SessionKey:      "sessionKey",
+	}).Return(backend.Events{
+		Offset: 1,
+		Events: []backend.Event{backend.DataEvent{ID: 1, Data: "0x00", Topics: []string{"0x01"}}},
+	}, nil)
+	handler.client.(*MockClient).On("PollEvent", mock.Anything, backend.PollEventRequest{
+		Offset:          2,
+		Count:           16,
+		DiscardPrevious: true,
+		ID:              2,
+		SessionKey:      "sessionKey",
+	}).Return(backend.Events{}, errors.New(errors.ErrInternalError, nil))
+
+	v, err := handler.PollEventStream(ctx, &PollEventRequest{
+		ID:              2,
+		Offset:          1,
+		DiscardPrevious: true,
+	})
+	assert.Nil(t, err)
+
+	req := httptest.NewRequest("POST", "/v0/api/event/poll/stream", nil)
+	res := httptest.NewRecorder()
+
+	assert.Nil(t, v.(rpc.HttpStream).ServeStream(res, req))
+	assert.Equal(t, "retry: 50\n\n"+
+		"id: 2\ndata: {\"id\":1,\"type\":\"\",\"data\":\"0x00\",\"topics\":[\"0x01\"],\"blockNumber\":0,"+
+		"\"transactionIndex\":0,\"logIndex\":0}\n\n"+
+		"event: error\ndata: {\"errorCode\":1000,"+
+		"\"description\":\"Internal Error. Please check the status of the service.\"}\n\n",
+		res.Body.String())
+}
+
 func TestNewEventHandlerNoClient(t *testing.T) {
 	assert.Panics(t, func() {
 		NewEventHandler(Services{
@@ -368,5 +580,6 @@ func TestBindHandlerOK(t *testing.T) {
 
 	assert.True(t, router.HasHandler("/v0/api/event/subscribe", "POST"))
 	assert.True(t, router.HasHandler("/v0/api/event/unsubscribe", "POST"))
+	assert.True(t, router.HasHandler("/v0/api/event/list", "POST"))
 	assert.True(t, router.HasHandler("/v0/api/event/poll", "POST"))
 }
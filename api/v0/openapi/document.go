@@ -0,0 +1,270 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+const (
+	// Version is the version of the OpenAPI specification the
+	// generated documents follow
+	Version = "3.0.3"
+
+	// mediaTypeJSON is the media type of the bodies of the API
+	mediaTypeJSON = "application/json"
+
+	// schemaRefPrefix is the prefix of the references to the schemas
+	// defined in the components of the document
+	schemaRefPrefix = "#/components/schemas/"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	errorType         = reflect.TypeOf(rpc.Error{})
+)
+
+// Variants maps an interface type to the types that implement it and
+// that can be returned as part of a response. The reflection of the
+// interface alone cannot tell which types those are
+type Variants map[reflect.Type][]reflect.Type
+
+// DocumentProps are the properties required to generate a Document
+type DocumentProps struct {
+	// Title of the API
+	Title string
+
+	// Version of the API
+	Version string
+
+	// Bindings are the handlers bound to the API
+	Bindings []rpc.HttpBinding
+
+	// Variants are the implementations of the interfaces
+	// that are part of the entities of the API
+	Variants Variants
+}
+
+// NewDocument generates the OpenAPI document of the API by reflecting
+// over the request and response entities of the bindings. Named structs
+// are described once in the components of the document and referenced
+// by the operations
+func NewDocument(props DocumentProps) *Document {
+	g := generator{
+		variants: props.Variants,
+		schemas:  make(map[string]*Schema),
+	}
+
+	paths := make(map[string]PathItem)
+	for _, binding := range props.Bindings {
+		item, ok := paths[binding.URI]
+		if !ok {
+			item = make(PathItem)
+			paths[binding.URI] = item
+		}
+
+		item[strings.ToLower(binding.Method)] = g.operation(binding)
+	}
+
+	return &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   props.Title,
+			Version: props.Version,
+		},
+		Paths:      paths,
+		Components: Components{Schemas: g.schemas},
+	}
+}
+
+// generator builds the schemas of the types of the entities
+type generator struct {
+	variants Variants
+	schemas  map[string]*Schema
+}
+
+// operation describes a binding as an Operation
+func (g *generator) operation(binding rpc.HttpBinding) *Operation {
+	op := &Operation{
+		OperationID: strings.ToLower(binding.Method) + "_" + rpc.JsonRpcMethodName(binding.URI),
+		Responses: map[string]Response{
+			"default": {
+				Description: "Error response",
+				Content:     jsonContent(g.schema(errorType)),
+			},
+		},
+	}
+
+	if binding.Request != nil {
+		op.RequestBody = &RequestBody{Content: jsonContent(g.schema(binding.Request))}
+	}
+
+	if binding.Response != nil {
+		op.Responses["200"] = Response{
+			Description: "Successful response",
+			Content:     jsonContent(g.schema(binding.Response)),
+		}
+	} else {
+		op.Responses["200"] = Response{Description: "Successful response"}
+	}
+
+	return op
+}
+
+// schema returns the Schema for a type. Named structs are added to the
+// components and a reference to them is returned instead
+func (g *generator) schema(t reflect.Type) *Schema {
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		// the encoding of the type cannot be derived from its fields
+		return &Schema{}
+	}
+
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32", Minimum: new(int)}
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64", Minimum: new(int)}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes byte slices as base64 strings
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	case reflect.Interface:
+		return g.interfaceSchema(t)
+	default:
+		return &Schema{}
+	}
+}
+
+// structSchema returns the schema of a struct, which for named structs
+// is a reference to the schema in the components
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return g.objectSchema(t)
+	}
+
+	name := schemaName(t)
+	if _, ok := g.schemas[name]; !ok {
+		// register the schema before describing the fields so that
+		// recursive types reference it instead of looping
+		g.schemas[name] = &Schema{}
+		*g.schemas[name] = *g.objectSchema(t)
+	}
+
+	return &Schema{Ref: schemaRefPrefix + name}
+}
+
+// interfaceSchema returns the schema of an interface, which is one of
+// the schemas of its variants if they are known
+func (g *generator) interfaceSchema(t reflect.Type) *Schema {
+	variants, ok := g.variants[t]
+	if !ok {
+		return &Schema{}
+	}
+
+	schema := &Schema{}
+	for _, variant := range variants {
+		schema.OneOf = append(schema.OneOf, g.schema(variant))
+	}
+
+	return schema
+}
+
+// objectSchema describes the fields of a struct following the
+// same rules as encoding/json
+func (g *generator) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	g.addFields(schema, t)
+	return schema
+}
+
+// addFields adds the fields of the struct to the properties of the
+// schema. The fields of embedded structs without a json name are
+// promoted as encoding/json does
+func (g *generator) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts := parseTag(tag)
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.addFields(schema, embedded)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties[name] = g.schema(field.Type)
+		if !strings.Contains(opts, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// schemaName is the name of the schema of a named type in the
+// components. The package is part of it, since different packages
+// define entities with the same name
+func schemaName(t reflect.Type) string {
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
+
+// parseTag splits a json tag into its name and options
+func parseTag(tag string) (string, string) {
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+
+	return tag, ""
+}
+
+// jsonContent is the content of a json body with the provided schema
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{mediaTypeJSON: {Schema: schema}}
+}
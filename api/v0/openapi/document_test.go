@@ -0,0 +1,143 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/oasislabs/oasis-gateway/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent interface {
+	EventID() uint64
+}
+
+type testDataEvent struct {
+	ID   uint64 `json:"id"`
+	Data []byte `json:"data"`
+}
+
+func (e testDataEvent) EventID() uint64 { return e.ID }
+
+type testEmbedded struct {
+	Offset uint64 `json:"offset"`
+}
+
+type testRequest struct {
+	testEmbedded
+	Address string            `json:"address"`
+	Count   int32             `json:"count,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Ignored string            `json:"-"`
+	private string
+}
+
+type testResponse struct {
+	Events []testEvent      `json:"events"`
+	Raw    json.RawMessage  `json:"raw,omitempty"`
+	Next   *testResponse    `json:"next,omitempty"`
+	Nested struct{ A bool } `json:"nested"`
+}
+
+func newTestDocument() *Document {
+	return NewDocument(DocumentProps{
+		Title:   "API",
+		Version: "v0",
+		Bindings: []rpc.HttpBinding{
+			{
+				Method:   "POST",
+				URI:      "/v0/api/test/poll",
+				Request:  reflect.TypeOf(testRequest{}),
+				Response: reflect.TypeOf(testResponse{}),
+			},
+			{Method: "GET", URI: "/v0/api/test/poll"},
+		},
+		Variants: Variants{
+			reflect.TypeOf((*testEvent)(nil)).Elem(): {reflect.TypeOf(testDataEvent{})},
+		},
+	})
+}
+
+func TestNewDocumentPaths(t *testing.T) {
+	doc := newTestDocument()
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.Equal(t, Info{Title: "API", Version: "v0"}, doc.Info)
+	assert.Equal(t, PathItem{
+		"post": {
+			OperationID: "post_test_poll",
+			RequestBody: &RequestBody{Content: map[string]MediaType{
+				"application/json": {Schema: &Schema{Ref: "#/components/schemas/openapi.testRequest"}},
+			}},
+			Responses: map[string]Response{
+				"200": {
+					Description: "Successful response",
+					Content: map[string]MediaType{
+						"application/json": {Schema: &Schema{Ref: "#/components/schemas/openapi.testResponse"}},
+					},
+				},
+				"default": {
+					Description: "Error response",
+					Content: map[string]MediaType{
+						"application/json": {Schema: &Schema{Ref: "#/components/schemas/rpc.Error"}},
+					},
+				},
+			},
+		},
+		"get": {
+			OperationID: "get_test_poll",
+			Responses: map[string]Response{
+				"200": {Description: "Successful response"},
+				"default": {
+					Description: "Error response",
+					Content: map[string]MediaType{
+						"application/json": {Schema: &Schema{Ref: "#/components/schemas/rpc.Error"}},
+					},
+				},
+			},
+		},
+	}, doc.Paths["/v0/api/test/poll"])
+}
+
+func TestNewDocumentSchemas(t *testing.T) {
+	doc := newTestDocument()
+	zero := 0
+
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"offset":  {Type: "integer", Format: "int64", Minimum: &zero},
+			"address": {Type: "string"},
+			"count":   {Type: "integer", Format: "int32"},
+			"labels":  {Type: "object", AdditionalProperties: &Schema{Type: "string"}},
+		},
+		Required: []string{"offset", "address"},
+	}, doc.Components.Schemas["openapi.testRequest"])
+
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"events": {Type: "array", Items: &Schema{OneOf: []*Schema{
+				{Ref: "#/components/schemas/openapi.testDataEvent"},
+			}}},
+			"raw":  {},
+			"next": {Ref: "#/components/schemas/openapi.testResponse"},
+			"nested": {
+				Type:       "object",
+				Properties: map[string]*Schema{"A": {Type: "boolean"}},
+				Required:   []string{"A"},
+			},
+		},
+		Required: []string{"events", "nested"},
+	}, doc.Components.Schemas["openapi.testResponse"])
+
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"id":   {Type: "integer", Format: "int64", Minimum: &zero},
+			"data": {Type: "string", Format: "byte"},
+		},
+		Required: []string{"id", "data"},
+	}, doc.Components.Schemas["openapi.testDataEvent"])
+}
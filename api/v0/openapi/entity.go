@@ -0,0 +1,102 @@
+package openapi
+
+// Document is the root object of an OpenAPI 3.0 document
+type Document struct {
+	// OpenAPI is the version of the OpenAPI specification
+	// the document follows
+	OpenAPI string `json:"openapi"`
+
+	// Info is the metadata about the API
+	Info Info `json:"info"`
+
+	// Paths holds the operations of the API for each path
+	Paths map[string]PathItem `json:"paths"`
+
+	// Components holds the schemas referenced by the operations
+	Components Components `json:"components"`
+}
+
+// Info is the metadata about the API
+type Info struct {
+	// Title of the API
+	Title string `json:"title"`
+
+	// Version of the API
+	Version string `json:"version"`
+}
+
+// PathItem holds the operations available on a path keyed
+// by the lower case http method
+type PathItem map[string]*Operation
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	// OperationID uniquely identifies the operation within the API
+	OperationID string `json:"operationId"`
+
+	// RequestBody is the body expected by the operation, if any
+	RequestBody *RequestBody `json:"requestBody,omitempty"`
+
+	// Responses keyed by http status code
+	Responses map[string]Response `json:"responses"`
+}
+
+// RequestBody describes the body of a request
+type RequestBody struct {
+	// Required is true if the body is required
+	Required bool `json:"required,omitempty"`
+
+	// Content of the body keyed by media type
+	Content map[string]MediaType `json:"content"`
+}
+
+// Response describes a single response of an operation
+type Response struct {
+	// Description of the response
+	Description string `json:"description"`
+
+	// Content of the response keyed by media type
+	Content map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body for a media type
+type MediaType struct {
+	// Schema of the body
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable schemas of the document
+type Components struct {
+	// Schemas keyed by their name
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema describes a data type
+type Schema struct {
+	// Ref is a reference to a schema in the components of the document
+	Ref string `json:"$ref,omitempty"`
+
+	// Type of the value
+	Type string `json:"type,omitempty"`
+
+	// Format refines the type of the value
+	Format string `json:"format,omitempty"`
+
+	// Minimum value of a number
+	Minimum *int `json:"minimum,omitempty"`
+
+	// Items is the schema of the elements of an array
+	Items *Schema `json:"items,omitempty"`
+
+	// Properties of an object
+	Properties map[string]*Schema `json:"properties,omitempty"`
+
+	// Required properties of an object
+	Required []string `json:"required,omitempty"`
+
+	// AdditionalProperties is the schema of the values of a map
+	AdditionalProperties *Schema `json:"additionalProperties,omitempty"`
+
+	// OneOf is the list of schemas one of which the value matches
+	OneOf []*Schema `json:"oneOf,omitempty"`
+}
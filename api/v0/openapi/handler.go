@@ -0,0 +1,40 @@
+package openapi
+
+import (
+	"context"
+
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// Deps are the dependencies expected by the openapi Handler
+type Deps struct {
+	// Document is the OpenAPI document served by the handler
+	Document *Document
+}
+
+// Handler is the handler that serves the OpenAPI document of the API
+type Handler struct {
+	document *Document
+}
+
+// NewHandler creates a new instance of an openapi handler
+func NewHandler(deps *Deps) Handler {
+	if deps.Document == nil {
+		panic("Document must be provided as a dependency")
+	}
+
+	return Handler{document: deps.Document}
+}
+
+// GetOpenAPI returns the OpenAPI document of the API
+func (h Handler) GetOpenAPI(ctx context.Context, v interface{}) (interface{}, error) {
+	return h.document, nil
+}
+
+// BindHandler binds the openapi handler to the handler binder
+func BindHandler(deps *Deps, binder rpc.HandlerBinder) {
+	handler := NewHandler(deps)
+
+	binder.Bind("GET", "/v0/api/openapi.json", rpc.Describe(rpc.HandlerFunc(handler.GetOpenAPI), Document{}),
+		rpc.EntityFactoryFunc(func() interface{} { return nil }))
+}
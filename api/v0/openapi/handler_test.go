@@ -0,0 +1,24 @@
+package openapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOpenAPI(t *testing.T) {
+	doc := newTestDocument()
+	h := NewHandler(&Deps{Document: doc})
+
+	res, err := h.GetOpenAPI(context.TODO(), nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, doc, res)
+}
+
+func TestNewHandlerNoDocument(t *testing.T) {
+	assert.Panics(t, func() {
+		NewHandler(&Deps{})
+	})
+}
@@ -1,6 +1,10 @@
 package service
 
-import "github.com/oasislabs/oasis-gateway/rpc"
+import (
+	"net/url"
+
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
 
 // RequestType defines the type of the request. May be
 // useful for serialization and deserialization
@@ -13,6 +17,14 @@ const (
 	GetCode      RequestType = 3
 	GetExpiry    RequestType = 4
 	GetPublicKey RequestType = 5
+	DeploySync   RequestType = 6
+	ExecuteSync  RequestType = 7
+	Status       RequestType = 8
+	Cancel       RequestType = 9
+	ExecuteBatch RequestType = 10
+	Call         RequestType = 11
+	EstimateGas  RequestType = 12
+	GetReceipt   RequestType = 13
 )
 
 // Request is the type implemented by requests expected
@@ -42,6 +54,11 @@ type ExecuteServiceRequest struct {
 
 	// Address where the service can be found
 	Address string `json:"address"`
+
+	// TimeoutMs is the maximum time in milliseconds the gateway waits for
+	// the execution to complete before it provides an ErrorEvent as its outcome.
+	// If not set the default timeout of the gateway is used
+	TimeoutMs uint64 `json:"timeoutMs"`
 }
 
 // Type implementation of Request for ExecuteServiceRequest
@@ -61,6 +78,11 @@ type DeployServiceRequest struct {
 	// Data is a blob of data that the user wants to pass as argument for
 	// the deployment of a service
 	Data string `json:"data"`
+
+	// TimeoutMs is the maximum time in milliseconds the gateway waits for
+	// the deployment to complete before it provides an ErrorEvent as its outcome.
+	// If not set the default timeout of the gateway is used
+	TimeoutMs uint64 `json:"timeoutMs"`
 }
 
 // Type implementation of Request for DeployServiceRequest
@@ -72,6 +94,222 @@ func (r DeployServiceRequest) Type() RequestType {
 // using the polling mechanism
 type DeployServiceResponse AsyncResponse
 
+// ExecuteServiceCall is a single service execution that is part
+// of an ExecuteServiceBatchRequest
+type ExecuteServiceCall struct {
+	// Address where the service can be found
+	Address string `json:"address"`
+
+	// Data is a blob of data that the user wants to pass to the service
+	// as argument
+	Data string `json:"data"`
+}
+
+// ExecuteServiceBatchRequest is used by the user to trigger multiple
+// service executions with a single request
+type ExecuteServiceBatchRequest struct {
+	// Calls are the service executions to trigger. An ID is reserved
+	// for each of them in the same order
+	Calls []ExecuteServiceCall `json:"calls"`
+
+	// AtomicAuth defines whether all the calls need to be verified
+	// before any of them is submitted. If set and any call fails the
+	// verification, the whole batch is rejected. Otherwise, the calls
+	// that fail the verification produce an ErrorEvent
+	AtomicAuth bool `json:"atomicAuth"`
+
+	// TimeoutMs is the maximum time in milliseconds the gateway waits for
+	// the execution of each call to complete before it provides an ErrorEvent as its outcome.
+	// If not set the default timeout of the gateway is used
+	TimeoutMs uint64 `json:"timeoutMs"`
+}
+
+// Type implementation of Request for ExecuteServiceBatchRequest
+func (r ExecuteServiceBatchRequest) Type() RequestType {
+	return ExecuteBatch
+}
+
+// ExecuteServiceBatchResponse is the response to an
+// ExecuteServiceBatchRequest
+type ExecuteServiceBatchResponse struct {
+	// IDs to identify the asynchronous responses of the calls, in
+	// the same order as the calls
+	IDs []uint64 `json:"ids"`
+}
+
+// ExecuteServiceSyncRequest is used by the user to trigger a service
+// execution and wait for its outcome in the response, instead of
+// polling for it
+type ExecuteServiceSyncRequest struct {
+	// Data is a blob of data that the user wants to pass to the service
+	// as argument
+	Data string `json:"data"`
+
+	// Address where the service can be found
+	Address string `json:"address"`
+
+	// WaitMs is the maximum time in milliseconds the client is willing
+	// to wait for the outcome of the execution. If not set a default
+	// wait time is used
+	WaitMs uint64 `json:"waitMs"`
+
+	// TimeoutMs is the maximum time in milliseconds the gateway waits for
+	// the execution to complete before it provides an ErrorEvent as its outcome.
+	// If not set the default timeout of the gateway is used
+	TimeoutMs uint64 `json:"timeoutMs"`
+}
+
+// Type implementation of Request for ExecuteServiceSyncRequest
+func (r ExecuteServiceSyncRequest) Type() RequestType {
+	return ExecuteSync
+}
+
+// DeployServiceSyncRequest is used by the user to trigger a service
+// deployment and wait for its outcome in the response, instead of
+// polling for it
+type DeployServiceSyncRequest struct {
+	// Data is a blob of data that the user wants to pass as argument for
+	// the deployment of a service
+	Data string `json:"data"`
+
+	// WaitMs is the maximum time in milliseconds the client is willing
+	// to wait for the outcome of the deployment. If not set a default
+	// wait time is used
+	WaitMs uint64 `json:"waitMs"`
+
+	// TimeoutMs is the maximum time in milliseconds the gateway waits for
+	// the deployment to complete before it provides an ErrorEvent as its outcome.
+	// If not set the default timeout of the gateway is used
+	TimeoutMs uint64 `json:"timeoutMs"`
+}
+
+// Type implementation of Request for DeployServiceSyncRequest
+func (r DeployServiceSyncRequest) Type() RequestType {
+	return DeploySync
+}
+
+// SyncResponse is the response returned by APIs that wait for the
+// outcome of a request. If the request did not complete within the
+// wait time, only the ID is returned and the event can be polled
+// for later on
+type SyncResponse struct {
+	// ID to identify an asynchronous response. It uniquely identifies the
+	// event and orders it in the sequence of events expected by the user
+	ID uint64 `json:"id"`
+
+	// Event is the outcome of the request, or nil if the request did
+	// not complete within the wait time
+	Event Event `json:"event,omitempty"`
+}
+
+// CallServiceRequest is a request to run a read-only call against a
+// service. The call is not submitted as a transaction, so it does not
+// spend any gas
+type CallServiceRequest struct {
+	// Address where the service can be found
+	Address string `json:"address"`
+
+	// Data is a blob of data that the user wants to pass to the service
+	// as argument
+	Data string `json:"data"`
+}
+
+// Type implementation of Request for CallServiceRequest
+func (r CallServiceRequest) Type() RequestType {
+	return Call
+}
+
+// CallServiceResponse is the outcome of a CallServiceRequest
+type CallServiceResponse struct {
+	// Address where the service can be found
+	Address string `json:"address"`
+
+	// Output returned by the service
+	Output string `json:"output"`
+}
+
+// EstimateGasRequest is a request to estimate the gas and fee the
+// gateway would use for a deploy or execute request
+type EstimateGasRequest struct {
+	// Address where the service can be found. It must be left
+	// empty to estimate a deployment
+	Address string `json:"address"`
+
+	// Data is the data of the deploy or execute request
+	Data string `json:"data"`
+}
+
+// Type implementation of Request for EstimateGasRequest
+func (r EstimateGasRequest) Type() RequestType {
+	return EstimateGas
+}
+
+// EstimateGasResponse is the gas and fee the gateway would use
+// for a deploy or execute request
+type EstimateGasResponse struct {
+	// GasLimit is the gas limit of the transaction
+	GasLimit uint64 `json:"gasLimit"`
+
+	// GasPrice is the price for each unit of gas encoded as hex
+	GasPrice string `json:"gasPrice"`
+
+	// Fee is the maximum cost of the transaction encoded as hex
+	Fee string `json:"fee"`
+
+	// ConfidentialFallback is true if the gas could not be estimated
+	// and the estimate the gateway uses for confidential services
+	// is returned instead
+	ConfidentialFallback bool `json:"confidentialFallback"`
+}
+
+// GetReceiptRequest is a request to retrieve the receipt of a
+// transaction submitted by the gateway for the caller
+type GetReceiptRequest struct {
+	// Hash of the transaction encoded as hex
+	Hash string `json:"hash"`
+}
+
+// Type implementation of Request for GetReceiptRequest
+func (r GetReceiptRequest) Type() RequestType {
+	return GetReceipt
+}
+
+// ReceiptLog is a log emitted by a transaction
+type ReceiptLog struct {
+	// Address of the service that emitted the log
+	Address string `json:"address"`
+
+	// Topics of the log encoded as hex
+	Topics []string `json:"topics"`
+
+	// Data of the log encoded as hex
+	Data string `json:"data"`
+}
+
+// GetReceiptResponse is the receipt of a transaction
+type GetReceiptResponse struct {
+	// Hash of the transaction encoded as hex
+	Hash string `json:"hash"`
+
+	// Status of the transaction. 1 if the transaction succeeded
+	// and 0 if it failed
+	Status uint64 `json:"status"`
+
+	// GasUsed by the transaction
+	GasUsed uint64 `json:"gasUsed"`
+
+	// BlockNumber is the number of the block that includes
+	// the transaction
+	BlockNumber uint64 `json:"blockNumber"`
+
+	// ContractAddress is the address of the service created by the
+	// transaction, if the transaction was a deployment
+	ContractAddress string `json:"contractAddress,omitempty"`
+
+	// Logs emitted by the transaction
+	Logs []ReceiptLog `json:"logs"`
+}
+
 // GetCodeRequest is a request to retrieve the code
 // associated with a specific service
 type GetCodeRequest struct {
@@ -171,6 +409,11 @@ type PollServiceRequest struct {
 	// DiscardPrevious allows the client to define whether the server should
 	// discard all the events that have a sequence number lower than the offer
 	DiscardPrevious bool `json:"discardPrevious"`
+
+	// WaitMs is the maximum time in milliseconds the server holds the
+	// request waiting for events if there are none available at the
+	// offset. If not set the server responds immediately
+	WaitMs uint64 `json:"waitMs"`
 }
 
 // Type implementation of Request for PollServiceRequest
@@ -178,6 +421,62 @@ func (r PollServiceRequest) Type() RequestType {
 	return Poll
 }
 
+// DecodeQuery is the implementation of rpc.HttpQueryDecoder for
+// PollServiceRequest, so that a stream can be opened with a GET request
+func (r *PollServiceRequest) DecodeQuery(query url.Values) error {
+	if err := rpc.ParseQueryUint(query, "offset", &r.Offset); err != nil {
+		return err
+	}
+
+	count := uint64(r.Count)
+	if err := rpc.ParseQueryUint(query, "count", &count); err != nil {
+		return err
+	}
+	r.Count = uint(count)
+
+	return rpc.ParseQueryBool(query, "discardPrevious", &r.DiscardPrevious)
+}
+
+// GetRequestStatusRequest is a request to retrieve the status of an
+// asynchronous request that may still be in progress
+type GetRequestStatusRequest struct {
+	// ID of the asynchronous request as returned by a deploy or
+	// execute request
+	ID uint64 `json:"id"`
+}
+
+// Type implementation of Request for GetRequestStatusRequest
+func (r GetRequestStatusRequest) Type() RequestType {
+	return Status
+}
+
+// CancelServiceRequest is a request to cancel an asynchronous request
+// whose transaction has not been submitted yet
+type CancelServiceRequest struct {
+	// ID of the asynchronous request as returned by a deploy or
+	// execute request
+	ID uint64 `json:"id"`
+}
+
+// Type implementation of Request for CancelServiceRequest
+func (r CancelServiceRequest) Type() RequestType {
+	return Cancel
+}
+
+// RequestStatusResponse is the status of an asynchronous request
+type RequestStatusResponse struct {
+	// ID of the asynchronous request
+	ID uint64 `json:"id"`
+
+	// State of the request. It is one of queued, estimating_gas,
+	// submitted, committed or failed
+	State string `json:"state"`
+
+	// TransactionHash is the hash of the transaction submitted for
+	// the request, once it is known
+	TransactionHash string `json:"transactionHash,omitempty"`
+}
+
 // Event is an interface for types that can be fetched by polling on
 // a service
 type Event interface {
@@ -211,6 +510,16 @@ type ExecuteServiceEvent struct {
 
 	// Output generated by the service at the end of its execution
 	Output string `json:"output"`
+
+	// TransactionHash is the hash of the transaction that executed
+	// the service
+	TransactionHash string `json:"transactionHash,omitempty"`
+
+	// BlockNumber is the number of the block that includes the transaction
+	BlockNumber uint64 `json:"blockNumber,omitempty"`
+
+	// GasUsed is the amount of gas used by the transaction
+	GasUsed uint64 `json:"gasUsed,omitempty"`
 }
 
 // DeployServiceEvent is the event that can be polled by the user
@@ -224,6 +533,19 @@ type DeployServiceEvent struct {
 	// is generated when a service is deployed and it can be used
 	// for service execution
 	Address string `json:"address"`
+
+	// TransactionHash is the hash of the transaction that deployed
+	// the service
+	TransactionHash string `json:"transactionHash,omitempty"`
+
+	// BlockNumber is the number of the block that includes the transaction
+	BlockNumber uint64 `json:"blockNumber,omitempty"`
+
+	// GasUsed is the amount of gas used by the transaction
+	GasUsed uint64 `json:"gasUsed,omitempty"`
+
+	// CodeHash is the hash of the code deployed for the service
+	CodeHash string `json:"codeHash,omitempty"`
 }
 
 // ErrorEvent is the event that can be polled by the user
@@ -237,6 +559,24 @@ type ErrorEvent struct {
 	Cause rpc.Error `json:"cause"`
 }
 
+// LateResultEvent is the event that can be polled by the user when the
+// transaction of a request commits after the request timed out, and an
+// ErrorEvent was already provided as its outcome
+type LateResultEvent struct {
+	// ID to identify an asynchronous response. It uniquely identifies the
+	// event and orders it in the sequence of events expected by the user
+	ID uint64 `json:"id"`
+
+	// RequestID is the ID of the request that timed out
+	RequestID uint64 `json:"requestId"`
+
+	// Execute is the outcome of the request if it was an execution
+	Execute *ExecuteServiceEvent `json:"execute,omitempty"`
+
+	// Deploy is the outcome of the request if it was a deployment
+	Deploy *DeployServiceEvent `json:"deploy,omitempty"`
+}
+
 // EventID is the implementation of rpc.Event for ExecuteServiceEvent
 func (e ExecuteServiceEvent) EventID() uint64 {
 	return e.ID
@@ -251,3 +591,8 @@ func (e DeployServiceEvent) EventID() uint64 {
 func (e ErrorEvent) EventID() uint64 {
 	return e.ID
 }
+
+// EventID is the implementation of rpc.Event for LateResultEvent
+func (e LateResultEvent) EventID() uint64 {
+	return e.ID
+}
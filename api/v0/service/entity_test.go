@@ -1,6 +1,7 @@
 package service
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,3 +30,20 @@ func TestDeployServiceRequestType(t *testing.T) {
 func TestExecuteServiceRequestType(t *testing.T) {
 	assert.Equal(t, Execute, ExecuteServiceRequest{}.Type())
 }
+
+func TestPollServiceRequestDecodeQuery(t *testing.T) {
+	query, err := url.ParseQuery("offset=3&count=4&discardPrevious=true")
+	assert.Nil(t, err)
+
+	var req PollServiceRequest
+	assert.Nil(t, req.DecodeQuery(query))
+	assert.Equal(t, PollServiceRequest{Offset: 3, Count: 4, DiscardPrevious: true}, req)
+}
+
+func TestPollServiceRequestDecodeQueryErr(t *testing.T) {
+	query, err := url.ParseQuery("offset=abc")
+	assert.Nil(t, err)
+
+	var req PollServiceRequest
+	assert.Equal(t, "query parameter offset must be an unsigned integer", req.DecodeQuery(query).Error())
+}
@@ -0,0 +1,329 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/oasislabs/oasis-gateway/api/v0/service"
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// Server is the implementation of ServiceServer. It converts the
+// protobuf messages to the entities of the service API and serves
+// the calls with the handlers bound by service.BindHandler
+type Server struct {
+	handler *rpc.GrpcHandler
+}
+
+// NewServer creates a new instance of the Server. It will panic
+// in case there are errors in its construction
+func NewServer(handler *rpc.GrpcHandler) *Server {
+	if handler == nil {
+		panic("handler must be set")
+	}
+
+	return &Server{handler: handler}
+}
+
+// Deploy is the implementation of ServiceServer for Server
+func (s *Server) Deploy(ctx context.Context, req *DeployServiceRequest) (*AsyncResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/deploy", &service.DeployServiceRequest{
+		Data:      req.Data,
+		TimeoutMs: req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AsyncResponse{Id: v.(service.AsyncResponse).ID}, nil
+}
+
+// DeploySync is the implementation of ServiceServer for Server
+func (s *Server) DeploySync(ctx context.Context, req *DeployServiceSyncRequest) (*SyncResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/deploySync", &service.DeployServiceSyncRequest{
+		Data:      req.Data,
+		WaitMs:    req.WaitMs,
+		TimeoutMs: req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSyncResponse(v.(service.SyncResponse)), nil
+}
+
+// Execute is the implementation of ServiceServer for Server
+func (s *Server) Execute(ctx context.Context, req *ExecuteServiceRequest) (*AsyncResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/execute", &service.ExecuteServiceRequest{
+		Data:      req.Data,
+		Address:   req.Address,
+		TimeoutMs: req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AsyncResponse{Id: v.(service.AsyncResponse).ID}, nil
+}
+
+// ExecuteBatch is the implementation of ServiceServer for Server
+func (s *Server) ExecuteBatch(ctx context.Context, req *ExecuteServiceBatchRequest) (*ExecuteServiceBatchResponse, error) {
+	calls := make([]service.ExecuteServiceCall, 0, len(req.Calls))
+	for _, call := range req.Calls {
+		calls = append(calls, service.ExecuteServiceCall{
+			Address: call.Address,
+			Data:    call.Data,
+		})
+	}
+
+	v, err := s.handler.Handle(ctx, "/v0/api/service/executeBatch", &service.ExecuteServiceBatchRequest{
+		Calls:      calls,
+		AtomicAuth: req.AtomicAuth,
+		TimeoutMs:  req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecuteServiceBatchResponse{Ids: v.(service.ExecuteServiceBatchResponse).IDs}, nil
+}
+
+// ExecuteSync is the implementation of ServiceServer for Server
+func (s *Server) ExecuteSync(ctx context.Context, req *ExecuteServiceSyncRequest) (*SyncResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/executeSync", &service.ExecuteServiceSyncRequest{
+		Data:      req.Data,
+		Address:   req.Address,
+		WaitMs:    req.WaitMs,
+		TimeoutMs: req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSyncResponse(v.(service.SyncResponse)), nil
+}
+
+// Poll is the implementation of ServiceServer for Server
+func (s *Server) Poll(ctx context.Context, req *PollServiceRequest) (*PollServiceResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/poll", mapPollServiceRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.PollServiceResponse)
+	events := make([]*Event, 0, len(res.Events))
+	for _, ev := range res.Events {
+		events = append(events, mapEvent(ev))
+	}
+
+	return &PollServiceResponse{Offset: res.Offset, Events: events}, nil
+}
+
+// PollStream is the implementation of ServiceServer for Server
+func (s *Server) PollStream(req *PollServiceRequest, srv Service_PollStreamServer) error {
+	return s.handler.Stream(srv.Context(), "/v0/api/service/poll/stream", mapPollServiceRequest(req),
+		func(v interface{}) error {
+			return srv.Send(mapEvent(v.(service.Event)))
+		})
+}
+
+// Call is the implementation of ServiceServer for Server
+func (s *Server) Call(ctx context.Context, req *CallServiceRequest) (*CallServiceResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/call", &service.CallServiceRequest{
+		Address: req.Address,
+		Data:    req.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.CallServiceResponse)
+	return &CallServiceResponse{Address: res.Address, Output: res.Output}, nil
+}
+
+// EstimateGas is the implementation of ServiceServer for Server
+func (s *Server) EstimateGas(ctx context.Context, req *EstimateGasRequest) (*EstimateGasResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/estimateGas", &service.EstimateGasRequest{
+		Address: req.Address,
+		Data:    req.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.EstimateGasResponse)
+	return &EstimateGasResponse{
+		GasLimit:             res.GasLimit,
+		GasPrice:             res.GasPrice,
+		Fee:                  res.Fee,
+		ConfidentialFallback: res.ConfidentialFallback,
+	}, nil
+}
+
+// GetRequestStatus is the implementation of ServiceServer for Server
+func (s *Server) GetRequestStatus(ctx context.Context, req *GetRequestStatusRequest) (*RequestStatusResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/status", &service.GetRequestStatusRequest{
+		ID: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.RequestStatusResponse)
+	return &RequestStatusResponse{
+		Id:              res.ID,
+		State:           res.State,
+		TransactionHash: res.TransactionHash,
+	}, nil
+}
+
+// Cancel is the implementation of ServiceServer for Server
+func (s *Server) Cancel(ctx context.Context, req *CancelServiceRequest) (*CancelServiceResponse, error) {
+	if _, err := s.handler.Handle(ctx, "/v0/api/service/cancel", &service.CancelServiceRequest{
+		ID: req.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CancelServiceResponse{}, nil
+}
+
+// GetCode is the implementation of ServiceServer for Server
+func (s *Server) GetCode(ctx context.Context, req *GetCodeRequest) (*GetCodeResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/getCode", &service.GetCodeRequest{
+		Address: req.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.GetCodeResponse)
+	return &GetCodeResponse{Address: res.Address, Code: res.Code}, nil
+}
+
+// GetExpiry is the implementation of ServiceServer for Server
+func (s *Server) GetExpiry(ctx context.Context, req *GetExpiryRequest) (*GetExpiryResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/getExpiry", &service.GetExpiryRequest{
+		Address: req.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.GetExpiryResponse)
+	return &GetExpiryResponse{Address: res.Address, Expiry: res.Expiry}, nil
+}
+
+// GetPublicKey is the implementation of ServiceServer for Server
+func (s *Server) GetPublicKey(ctx context.Context, req *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/getPublicKey", &service.GetPublicKeyRequest{
+		Address: req.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.GetPublicKeyResponse)
+	return &GetPublicKeyResponse{
+		Timestamp: res.Timestamp,
+		Address:   res.Address,
+		PublicKey: res.PublicKey,
+		Signature: res.Signature,
+	}, nil
+}
+
+// GetReceipt is the implementation of ServiceServer for Server
+func (s *Server) GetReceipt(ctx context.Context, req *GetReceiptRequest) (*GetReceiptResponse, error) {
+	v, err := s.handler.Handle(ctx, "/v0/api/service/getReceipt", &service.GetReceiptRequest{
+		Hash: req.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(service.GetReceiptResponse)
+	logs := make([]*ReceiptLog, 0, len(res.Logs))
+	for _, log := range res.Logs {
+		logs = append(logs, &ReceiptLog{
+			Address: log.Address,
+			Topics:  log.Topics,
+			Data:    log.Data,
+		})
+	}
+
+	return &GetReceiptResponse{
+		Hash:            res.Hash,
+		Status:          res.Status,
+		GasUsed:         res.GasUsed,
+		BlockNumber:     res.BlockNumber,
+		ContractAddress: res.ContractAddress,
+		Logs:            logs,
+	}, nil
+}
+
+func mapPollServiceRequest(req *PollServiceRequest) *service.PollServiceRequest {
+	return &service.PollServiceRequest{
+		Offset:          req.Offset,
+		Count:           uint(req.Count),
+		DiscardPrevious: req.DiscardPrevious,
+		WaitMs:          req.WaitMs,
+	}
+}
+
+func mapSyncResponse(res service.SyncResponse) *SyncResponse {
+	if res.Event == nil {
+		return &SyncResponse{Id: res.ID}
+	}
+
+	return &SyncResponse{Id: res.ID, Event: mapEvent(res.Event)}
+}
+
+// mapEvent maps an event of the service API to its protobuf message
+func mapEvent(ev service.Event) *Event {
+	switch ev := ev.(type) {
+	case service.ExecuteServiceEvent:
+		return &Event{Event: &Event_Execute{Execute: mapExecuteEvent(ev)}}
+	case service.DeployServiceEvent:
+		return &Event{Event: &Event_Deploy{Deploy: mapDeployEvent(ev)}}
+	case service.ErrorEvent:
+		return &Event{Event: &Event_Error{Error: &ErrorEvent{
+			Id: ev.ID,
+			Cause: &Error{
+				ErrorCode:   int32(ev.Cause.ErrorCode),
+				Description: ev.Cause.Description,
+			},
+		}}}
+	case service.LateResultEvent:
+		late := &LateResultEvent{Id: ev.ID, RequestId: ev.RequestID}
+		if ev.Execute != nil {
+			late.Execute = mapExecuteEvent(*ev.Execute)
+		}
+		if ev.Deploy != nil {
+			late.Deploy = mapDeployEvent(*ev.Deploy)
+		}
+		return &Event{Event: &Event_LateResult{LateResult: late}}
+	default:
+		panic("received unexpected event type from service handler")
+	}
+}
+
+func mapExecuteEvent(ev service.ExecuteServiceEvent) *ExecuteServiceEvent {
+	return &ExecuteServiceEvent{
+		Id:              ev.ID,
+		Address:         ev.Address,
+		Output:          ev.Output,
+		TransactionHash: ev.TransactionHash,
+		BlockNumber:     ev.BlockNumber,
+		GasUsed:         ev.GasUsed,
+	}
+}
+
+func mapDeployEvent(ev service.DeployServiceEvent) *DeployServiceEvent {
+	return &DeployServiceEvent{
+		Id:              ev.ID,
+		Address:         ev.Address,
+		TransactionHash: ev.TransactionHash,
+		BlockNumber:     ev.BlockNumber,
+		GasUsed:         ev.GasUsed,
+		CodeHash:        ev.CodeHash,
+	}
+}
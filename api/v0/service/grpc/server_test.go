@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/oasislabs/oasis-gateway/api/v0/service"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var Logger = log.NewLogrus(log.LogrusLoggerProperties{
+	Output: ioutil.Discard,
+})
+
+type pollStreamServer struct {
+	grpc.ServerStream
+	events []*Event
+}
+
+func (s *pollStreamServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *pollStreamServer) Send(ev *Event) error {
+	s.events = append(s.events, ev)
+	return nil
+}
+
+type eventStream []service.Event
+
+func (s eventStream) ServeGrpcStream(ctx context.Context, send func(interface{}) error) error {
+	for _, ev := range s {
+		if err := send(ev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var events = []service.Event{
+	service.ExecuteServiceEvent{ID: 0, Address: "0x01", Output: "0x02"},
+	service.ErrorEvent{ID: 1, Cause: rpc.Error{ErrorCode: 1000, Description: "error"}},
+}
+
+var mappedEvents = []*Event{
+	{Event: &Event_Execute{Execute: &ExecuteServiceEvent{Id: 0, Address: "0x01", Output: "0x02"}}},
+	{Event: &Event_Error{Error: &ErrorEvent{Id: 1, Cause: &Error{ErrorCode: 1000, Description: "error"}}}},
+}
+
+func newServer(path string, handler rpc.HandlerFunc) *Server {
+	binder := rpc.NewGrpcBinder(rpc.GrpcBinderProperties{Logger: Logger})
+	binder.Bind("POST", path, handler, nil)
+	return NewServer(binder.Build())
+}
+
+func TestServerDeploy(t *testing.T) {
+	server := newServer("/v0/api/service/deploy", func(ctx context.Context, v interface{}) (interface{}, error) {
+		assert.Equal(t, &service.DeployServiceRequest{Data: "0x00"}, v)
+		return service.AsyncResponse{ID: 1}, nil
+	})
+
+	res, err := server.Deploy(context.Background(), &DeployServiceRequest{Data: "0x00"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &AsyncResponse{Id: 1}, res)
+}
+
+func TestServerDeployErr(t *testing.T) {
+	server := newServer("/v0/api/service/deploy", func(ctx context.Context, v interface{}) (interface{}, error) {
+		return nil, errors.New(errors.ErrInvalidAddress, nil)
+	})
+
+	_, err := server.Deploy(context.Background(), &DeployServiceRequest{Data: "0x00"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServerPoll(t *testing.T) {
+	server := newServer("/v0/api/service/poll", func(ctx context.Context, v interface{}) (interface{}, error) {
+		assert.Equal(t, &service.PollServiceRequest{Offset: 1, Count: 2}, v)
+		return service.PollServiceResponse{Offset: 1, Events: events}, nil
+	})
+
+	res, err := server.Poll(context.Background(), &PollServiceRequest{Offset: 1, Count: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &PollServiceResponse{Offset: 1, Events: mappedEvents}, res)
+}
+
+func TestServerPollStream(t *testing.T) {
+	server := newServer("/v0/api/service/poll/stream", func(ctx context.Context, v interface{}) (interface{}, error) {
+		return eventStream(events), nil
+	})
+	srv := &pollStreamServer{}
+
+	err := server.PollStream(&PollServiceRequest{}, srv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, mappedEvents, srv.events)
+}
+
+func TestServerExecuteSyncNoEvent(t *testing.T) {
+	server := newServer("/v0/api/service/executeSync", func(ctx context.Context, v interface{}) (interface{}, error) {
+		return service.SyncResponse{ID: 1}, nil
+	})
+
+	res, err := server.ExecuteSync(context.Background(), &ExecuteServiceSyncRequest{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &SyncResponse{Id: 1}, res)
+}
+
+func TestNewServerNoHandler(t *testing.T) {
+	assert.Panics(t, func() {
+		NewServer(nil)
+	})
+}
@@ -0,0 +1,2680 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api/v0/service/grpc/service.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Error struct {
+	// Unique identifier of the type of error.
+	ErrorCode int32 `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Human readable description of the error.
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{0}
+}
+
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Error.Unmarshal(m, b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+}
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
+}
+func (m *Error) XXX_Size() int {
+	return xxx_messageInfo_Error.Size(m)
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetErrorCode() int32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *Error) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type AsyncResponse struct {
+	// ID to identify the asynchronous response.
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AsyncResponse) Reset()         { *m = AsyncResponse{} }
+func (m *AsyncResponse) String() string { return proto.CompactTextString(m) }
+func (*AsyncResponse) ProtoMessage()    {}
+func (*AsyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{1}
+}
+
+func (m *AsyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AsyncResponse.Unmarshal(m, b)
+}
+func (m *AsyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AsyncResponse.Marshal(b, m, deterministic)
+}
+func (m *AsyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AsyncResponse.Merge(m, src)
+}
+func (m *AsyncResponse) XXX_Size() int {
+	return xxx_messageInfo_AsyncResponse.Size(m)
+}
+func (m *AsyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AsyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AsyncResponse proto.InternalMessageInfo
+
+func (m *AsyncResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeployServiceRequest struct {
+	// Data passed as argument for the deployment of the service.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Maximum time in milliseconds to wait for the request to complete.
+	TimeoutMs            uint64   `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployServiceRequest) Reset()         { *m = DeployServiceRequest{} }
+func (m *DeployServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeployServiceRequest) ProtoMessage()    {}
+func (*DeployServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{2}
+}
+
+func (m *DeployServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployServiceRequest.Unmarshal(m, b)
+}
+func (m *DeployServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeployServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployServiceRequest.Merge(m, src)
+}
+func (m *DeployServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeployServiceRequest.Size(m)
+}
+func (m *DeployServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployServiceRequest proto.InternalMessageInfo
+
+func (m *DeployServiceRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *DeployServiceRequest) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+type DeployServiceSyncRequest struct {
+	// Data passed as argument for the deployment of the service.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Maximum time in milliseconds to wait for the outcome.
+	WaitMs uint64 `protobuf:"varint,2,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+	// Maximum time in milliseconds to wait for the request to complete.
+	TimeoutMs            uint64   `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployServiceSyncRequest) Reset()         { *m = DeployServiceSyncRequest{} }
+func (m *DeployServiceSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*DeployServiceSyncRequest) ProtoMessage()    {}
+func (*DeployServiceSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{3}
+}
+
+func (m *DeployServiceSyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployServiceSyncRequest.Unmarshal(m, b)
+}
+func (m *DeployServiceSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployServiceSyncRequest.Marshal(b, m, deterministic)
+}
+func (m *DeployServiceSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployServiceSyncRequest.Merge(m, src)
+}
+func (m *DeployServiceSyncRequest) XXX_Size() int {
+	return xxx_messageInfo_DeployServiceSyncRequest.Size(m)
+}
+func (m *DeployServiceSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployServiceSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployServiceSyncRequest proto.InternalMessageInfo
+
+func (m *DeployServiceSyncRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *DeployServiceSyncRequest) GetWaitMs() uint64 {
+	if m != nil {
+		return m.WaitMs
+	}
+	return 0
+}
+
+func (m *DeployServiceSyncRequest) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+type ExecuteServiceRequest struct {
+	// Data passed to the service as argument.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Address where the service can be found.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Maximum time in milliseconds to wait for the request to complete.
+	TimeoutMs            uint64   `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteServiceRequest) Reset()         { *m = ExecuteServiceRequest{} }
+func (m *ExecuteServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceRequest) ProtoMessage()    {}
+func (*ExecuteServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{4}
+}
+
+func (m *ExecuteServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceRequest.Unmarshal(m, b)
+}
+func (m *ExecuteServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceRequest.Merge(m, src)
+}
+func (m *ExecuteServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceRequest.Size(m)
+}
+func (m *ExecuteServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceRequest proto.InternalMessageInfo
+
+func (m *ExecuteServiceRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ExecuteServiceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExecuteServiceRequest) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+type ExecuteServiceCall struct {
+	// Address where the service can be found.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Data passed to the service as argument.
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteServiceCall) Reset()         { *m = ExecuteServiceCall{} }
+func (m *ExecuteServiceCall) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceCall) ProtoMessage()    {}
+func (*ExecuteServiceCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{5}
+}
+
+func (m *ExecuteServiceCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceCall.Unmarshal(m, b)
+}
+func (m *ExecuteServiceCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceCall.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceCall.Merge(m, src)
+}
+func (m *ExecuteServiceCall) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceCall.Size(m)
+}
+func (m *ExecuteServiceCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceCall proto.InternalMessageInfo
+
+func (m *ExecuteServiceCall) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExecuteServiceCall) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type ExecuteServiceBatchRequest struct {
+	// Service executions to trigger.
+	Calls []*ExecuteServiceCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	// Whether all the calls need to be verified before any is submitted.
+	AtomicAuth bool `protobuf:"varint,2,opt,name=atomic_auth,json=atomicAuth,proto3" json:"atomic_auth,omitempty"`
+	// Maximum time in milliseconds to wait for the request to complete.
+	TimeoutMs            uint64   `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteServiceBatchRequest) Reset()         { *m = ExecuteServiceBatchRequest{} }
+func (m *ExecuteServiceBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceBatchRequest) ProtoMessage()    {}
+func (*ExecuteServiceBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{6}
+}
+
+func (m *ExecuteServiceBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceBatchRequest.Unmarshal(m, b)
+}
+func (m *ExecuteServiceBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceBatchRequest.Merge(m, src)
+}
+func (m *ExecuteServiceBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceBatchRequest.Size(m)
+}
+func (m *ExecuteServiceBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceBatchRequest proto.InternalMessageInfo
+
+func (m *ExecuteServiceBatchRequest) GetCalls() []*ExecuteServiceCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *ExecuteServiceBatchRequest) GetAtomicAuth() bool {
+	if m != nil {
+		return m.AtomicAuth
+	}
+	return false
+}
+
+func (m *ExecuteServiceBatchRequest) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+type ExecuteServiceBatchResponse struct {
+	// IDs to identify the asynchronous responses of the calls.
+	Ids                  []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteServiceBatchResponse) Reset()         { *m = ExecuteServiceBatchResponse{} }
+func (m *ExecuteServiceBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceBatchResponse) ProtoMessage()    {}
+func (*ExecuteServiceBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{7}
+}
+
+func (m *ExecuteServiceBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceBatchResponse.Unmarshal(m, b)
+}
+func (m *ExecuteServiceBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceBatchResponse.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceBatchResponse.Merge(m, src)
+}
+func (m *ExecuteServiceBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceBatchResponse.Size(m)
+}
+func (m *ExecuteServiceBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceBatchResponse proto.InternalMessageInfo
+
+func (m *ExecuteServiceBatchResponse) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type ExecuteServiceSyncRequest struct {
+	// Data passed to the service as argument.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Address where the service can be found.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Maximum time in milliseconds to wait for the outcome.
+	WaitMs uint64 `protobuf:"varint,3,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+	// Maximum time in milliseconds to wait for the request to complete.
+	TimeoutMs            uint64   `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteServiceSyncRequest) Reset()         { *m = ExecuteServiceSyncRequest{} }
+func (m *ExecuteServiceSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceSyncRequest) ProtoMessage()    {}
+func (*ExecuteServiceSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{8}
+}
+
+func (m *ExecuteServiceSyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceSyncRequest.Unmarshal(m, b)
+}
+func (m *ExecuteServiceSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceSyncRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceSyncRequest.Merge(m, src)
+}
+func (m *ExecuteServiceSyncRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceSyncRequest.Size(m)
+}
+func (m *ExecuteServiceSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceSyncRequest proto.InternalMessageInfo
+
+func (m *ExecuteServiceSyncRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ExecuteServiceSyncRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExecuteServiceSyncRequest) GetWaitMs() uint64 {
+	if m != nil {
+		return m.WaitMs
+	}
+	return 0
+}
+
+func (m *ExecuteServiceSyncRequest) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+type SyncResponse struct {
+	// ID to identify the asynchronous response.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Outcome of the request, unset if it did not complete in time.
+	Event                *Event   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
+func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncResponse) ProtoMessage()    {}
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{9}
+}
+
+func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncResponse.Unmarshal(m, b)
+}
+func (m *SyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncResponse.Merge(m, src)
+}
+func (m *SyncResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncResponse.Size(m)
+}
+func (m *SyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncResponse proto.InternalMessageInfo
+
+func (m *SyncResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SyncResponse) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type PollServiceRequest struct {
+	// Offset at which events need to be provided.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of events to return.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Whether events before the offset can be discarded.
+	DiscardPrevious bool `protobuf:"varint,3,opt,name=discard_previous,json=discardPrevious,proto3" json:"discard_previous,omitempty"`
+	// Maximum time in milliseconds to wait for events.
+	WaitMs               uint64   `protobuf:"varint,4,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollServiceRequest) Reset()         { *m = PollServiceRequest{} }
+func (m *PollServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*PollServiceRequest) ProtoMessage()    {}
+func (*PollServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{10}
+}
+
+func (m *PollServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollServiceRequest.Unmarshal(m, b)
+}
+func (m *PollServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *PollServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollServiceRequest.Merge(m, src)
+}
+func (m *PollServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_PollServiceRequest.Size(m)
+}
+func (m *PollServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollServiceRequest proto.InternalMessageInfo
+
+func (m *PollServiceRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PollServiceRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PollServiceRequest) GetDiscardPrevious() bool {
+	if m != nil {
+		return m.DiscardPrevious
+	}
+	return false
+}
+
+func (m *PollServiceRequest) GetWaitMs() uint64 {
+	if m != nil {
+		return m.WaitMs
+	}
+	return 0
+}
+
+type PollServiceResponse struct {
+	// Offset the events were retrieved from.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Events available from the offset.
+	Events               []*Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollServiceResponse) Reset()         { *m = PollServiceResponse{} }
+func (m *PollServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*PollServiceResponse) ProtoMessage()    {}
+func (*PollServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{11}
+}
+
+func (m *PollServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollServiceResponse.Unmarshal(m, b)
+}
+func (m *PollServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *PollServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollServiceResponse.Merge(m, src)
+}
+func (m *PollServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_PollServiceResponse.Size(m)
+}
+func (m *PollServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollServiceResponse proto.InternalMessageInfo
+
+func (m *PollServiceResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PollServiceResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type Event struct {
+	// Types that are valid to be assigned to Event:
+	//	*Event_Execute
+	//	*Event_Deploy
+	//	*Event_Error
+	//	*Event_LateResult
+	Event                isEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{12}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_Execute struct {
+	Execute *ExecuteServiceEvent `protobuf:"bytes,1,opt,name=execute,proto3,oneof"`
+}
+
+type Event_Deploy struct {
+	Deploy *DeployServiceEvent `protobuf:"bytes,2,opt,name=deploy,proto3,oneof"`
+}
+
+type Event_Error struct {
+	Error *ErrorEvent `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+type Event_LateResult struct {
+	LateResult *LateResultEvent `protobuf:"bytes,4,opt,name=late_result,json=lateResult,proto3,oneof"`
+}
+
+func (*Event_Execute) isEvent_Event() {}
+
+func (*Event_Deploy) isEvent_Event() {}
+
+func (*Event_Error) isEvent_Event() {}
+
+func (*Event_LateResult) isEvent_Event() {}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *Event) GetExecute() *ExecuteServiceEvent {
+	if x, ok := m.GetEvent().(*Event_Execute); ok {
+		return x.Execute
+	}
+	return nil
+}
+
+func (m *Event) GetDeploy() *DeployServiceEvent {
+	if x, ok := m.GetEvent().(*Event_Deploy); ok {
+		return x.Deploy
+	}
+	return nil
+}
+
+func (m *Event) GetError() *ErrorEvent {
+	if x, ok := m.GetEvent().(*Event_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *Event) GetLateResult() *LateResultEvent {
+	if x, ok := m.GetEvent().(*Event_LateResult); ok {
+		return x.LateResult
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_Execute)(nil),
+		(*Event_Deploy)(nil),
+		(*Event_Error)(nil),
+		(*Event_LateResult)(nil),
+	}
+}
+
+type ExecuteServiceEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of the executed service.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Output generated by the service.
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// Hash of the transaction that executed the service.
+	TransactionHash string `protobuf:"bytes,4,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// Number of the block that includes the transaction.
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Amount of gas used by the transaction.
+	GasUsed              uint64   `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteServiceEvent) Reset()         { *m = ExecuteServiceEvent{} }
+func (m *ExecuteServiceEvent) String() string { return proto.CompactTextString(m) }
+func (*ExecuteServiceEvent) ProtoMessage()    {}
+func (*ExecuteServiceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{13}
+}
+
+func (m *ExecuteServiceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteServiceEvent.Unmarshal(m, b)
+}
+func (m *ExecuteServiceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteServiceEvent.Marshal(b, m, deterministic)
+}
+func (m *ExecuteServiceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteServiceEvent.Merge(m, src)
+}
+func (m *ExecuteServiceEvent) XXX_Size() int {
+	return xxx_messageInfo_ExecuteServiceEvent.Size(m)
+}
+func (m *ExecuteServiceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteServiceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteServiceEvent proto.InternalMessageInfo
+
+func (m *ExecuteServiceEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExecuteServiceEvent) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExecuteServiceEvent) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *ExecuteServiceEvent) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *ExecuteServiceEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *ExecuteServiceEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+type DeployServiceEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of the deployed service.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Hash of the transaction that deployed the service.
+	TransactionHash string `protobuf:"bytes,3,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	// Number of the block that includes the transaction.
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Amount of gas used by the transaction.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Hash of the code deployed for the service.
+	CodeHash             string   `protobuf:"bytes,6,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployServiceEvent) Reset()         { *m = DeployServiceEvent{} }
+func (m *DeployServiceEvent) String() string { return proto.CompactTextString(m) }
+func (*DeployServiceEvent) ProtoMessage()    {}
+func (*DeployServiceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{14}
+}
+
+func (m *DeployServiceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployServiceEvent.Unmarshal(m, b)
+}
+func (m *DeployServiceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployServiceEvent.Marshal(b, m, deterministic)
+}
+func (m *DeployServiceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployServiceEvent.Merge(m, src)
+}
+func (m *DeployServiceEvent) XXX_Size() int {
+	return xxx_messageInfo_DeployServiceEvent.Size(m)
+}
+func (m *DeployServiceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployServiceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployServiceEvent proto.InternalMessageInfo
+
+func (m *DeployServiceEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeployServiceEvent) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DeployServiceEvent) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *DeployServiceEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *DeployServiceEvent) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *DeployServiceEvent) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+type ErrorEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Error that caused the request to fail.
+	Cause                *Error   `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrorEvent) Reset()         { *m = ErrorEvent{} }
+func (m *ErrorEvent) String() string { return proto.CompactTextString(m) }
+func (*ErrorEvent) ProtoMessage()    {}
+func (*ErrorEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{15}
+}
+
+func (m *ErrorEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorEvent.Unmarshal(m, b)
+}
+func (m *ErrorEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorEvent.Marshal(b, m, deterministic)
+}
+func (m *ErrorEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorEvent.Merge(m, src)
+}
+func (m *ErrorEvent) XXX_Size() int {
+	return xxx_messageInfo_ErrorEvent.Size(m)
+}
+func (m *ErrorEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorEvent proto.InternalMessageInfo
+
+func (m *ErrorEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ErrorEvent) GetCause() *Error {
+	if m != nil {
+		return m.Cause
+	}
+	return nil
+}
+
+type LateResultEvent struct {
+	// ID of the event in the sequence of events.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the request that timed out.
+	RequestId uint64 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Outcome of the request if it was an execution.
+	Execute *ExecuteServiceEvent `protobuf:"bytes,3,opt,name=execute,proto3" json:"execute,omitempty"`
+	// Outcome of the request if it was a deployment.
+	Deploy               *DeployServiceEvent `protobuf:"bytes,4,opt,name=deploy,proto3" json:"deploy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *LateResultEvent) Reset()         { *m = LateResultEvent{} }
+func (m *LateResultEvent) String() string { return proto.CompactTextString(m) }
+func (*LateResultEvent) ProtoMessage()    {}
+func (*LateResultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{16}
+}
+
+func (m *LateResultEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LateResultEvent.Unmarshal(m, b)
+}
+func (m *LateResultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LateResultEvent.Marshal(b, m, deterministic)
+}
+func (m *LateResultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LateResultEvent.Merge(m, src)
+}
+func (m *LateResultEvent) XXX_Size() int {
+	return xxx_messageInfo_LateResultEvent.Size(m)
+}
+func (m *LateResultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LateResultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LateResultEvent proto.InternalMessageInfo
+
+func (m *LateResultEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LateResultEvent) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *LateResultEvent) GetExecute() *ExecuteServiceEvent {
+	if m != nil {
+		return m.Execute
+	}
+	return nil
+}
+
+func (m *LateResultEvent) GetDeploy() *DeployServiceEvent {
+	if m != nil {
+		return m.Deploy
+	}
+	return nil
+}
+
+type CallServiceRequest struct {
+	// Address where the service can be found.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Data passed to the service as argument.
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallServiceRequest) Reset()         { *m = CallServiceRequest{} }
+func (m *CallServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*CallServiceRequest) ProtoMessage()    {}
+func (*CallServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{17}
+}
+
+func (m *CallServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallServiceRequest.Unmarshal(m, b)
+}
+func (m *CallServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *CallServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallServiceRequest.Merge(m, src)
+}
+func (m *CallServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_CallServiceRequest.Size(m)
+}
+func (m *CallServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallServiceRequest proto.InternalMessageInfo
+
+func (m *CallServiceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CallServiceRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type CallServiceResponse struct {
+	// Address where the service can be found.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Output returned by the service.
+	Output               string   `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallServiceResponse) Reset()         { *m = CallServiceResponse{} }
+func (m *CallServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*CallServiceResponse) ProtoMessage()    {}
+func (*CallServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{18}
+}
+
+func (m *CallServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallServiceResponse.Unmarshal(m, b)
+}
+func (m *CallServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *CallServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallServiceResponse.Merge(m, src)
+}
+func (m *CallServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_CallServiceResponse.Size(m)
+}
+func (m *CallServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallServiceResponse proto.InternalMessageInfo
+
+func (m *CallServiceResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CallServiceResponse) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+type EstimateGasRequest struct {
+	// Address of the service, empty to estimate a deployment.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Data of the deploy or execute request.
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateGasRequest) Reset()         { *m = EstimateGasRequest{} }
+func (m *EstimateGasRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasRequest) ProtoMessage()    {}
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{19}
+}
+
+func (m *EstimateGasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateGasRequest.Unmarshal(m, b)
+}
+func (m *EstimateGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateGasRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasRequest.Merge(m, src)
+}
+func (m *EstimateGasRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateGasRequest.Size(m)
+}
+func (m *EstimateGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasRequest proto.InternalMessageInfo
+
+func (m *EstimateGasRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EstimateGasRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type EstimateGasResponse struct {
+	// Gas limit of the transaction.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Price for each unit of gas encoded as hex.
+	GasPrice string `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// Maximum cost of the transaction encoded as hex.
+	Fee string `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Whether the estimate for confidential services was returned.
+	ConfidentialFallback bool     `protobuf:"varint,4,opt,name=confidential_fallback,json=confidentialFallback,proto3" json:"confidential_fallback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
+func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasResponse) ProtoMessage()    {}
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{20}
+}
+
+func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateGasResponse.Unmarshal(m, b)
+}
+func (m *EstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateGasResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasResponse.Merge(m, src)
+}
+func (m *EstimateGasResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateGasResponse.Size(m)
+}
+func (m *EstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasResponse proto.InternalMessageInfo
+
+func (m *EstimateGasResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EstimateGasResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *EstimateGasResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EstimateGasResponse) GetConfidentialFallback() bool {
+	if m != nil {
+		return m.ConfidentialFallback
+	}
+	return false
+}
+
+type GetRequestStatusRequest struct {
+	// ID of the asynchronous request.
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRequestStatusRequest) Reset()         { *m = GetRequestStatusRequest{} }
+func (m *GetRequestStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequestStatusRequest) ProtoMessage()    {}
+func (*GetRequestStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{21}
+}
+
+func (m *GetRequestStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequestStatusRequest.Unmarshal(m, b)
+}
+func (m *GetRequestStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequestStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRequestStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequestStatusRequest.Merge(m, src)
+}
+func (m *GetRequestStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequestStatusRequest.Size(m)
+}
+func (m *GetRequestStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequestStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequestStatusRequest proto.InternalMessageInfo
+
+func (m *GetRequestStatusRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RequestStatusResponse struct {
+	// ID of the asynchronous request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// State of the request.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Hash of the transaction submitted for the request.
+	TransactionHash      string   `protobuf:"bytes,3,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestStatusResponse) Reset()         { *m = RequestStatusResponse{} }
+func (m *RequestStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestStatusResponse) ProtoMessage()    {}
+func (*RequestStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{22}
+}
+
+func (m *RequestStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestStatusResponse.Unmarshal(m, b)
+}
+func (m *RequestStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestStatusResponse.Merge(m, src)
+}
+func (m *RequestStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestStatusResponse.Size(m)
+}
+func (m *RequestStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestStatusResponse proto.InternalMessageInfo
+
+func (m *RequestStatusResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RequestStatusResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *RequestStatusResponse) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+type CancelServiceRequest struct {
+	// ID of the asynchronous request.
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelServiceRequest) Reset()         { *m = CancelServiceRequest{} }
+func (m *CancelServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelServiceRequest) ProtoMessage()    {}
+func (*CancelServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{23}
+}
+
+func (m *CancelServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelServiceRequest.Unmarshal(m, b)
+}
+func (m *CancelServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelServiceRequest.Merge(m, src)
+}
+func (m *CancelServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelServiceRequest.Size(m)
+}
+func (m *CancelServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelServiceRequest proto.InternalMessageInfo
+
+func (m *CancelServiceRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type CancelServiceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelServiceResponse) Reset()         { *m = CancelServiceResponse{} }
+func (m *CancelServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelServiceResponse) ProtoMessage()    {}
+func (*CancelServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{24}
+}
+
+func (m *CancelServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelServiceResponse.Unmarshal(m, b)
+}
+func (m *CancelServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelServiceResponse.Merge(m, src)
+}
+func (m *CancelServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelServiceResponse.Size(m)
+}
+func (m *CancelServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelServiceResponse proto.InternalMessageInfo
+
+type GetCodeRequest struct {
+	// Address of the service.
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodeRequest) Reset()         { *m = GetCodeRequest{} }
+func (m *GetCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCodeRequest) ProtoMessage()    {}
+func (*GetCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{25}
+}
+
+func (m *GetCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodeRequest.Unmarshal(m, b)
+}
+func (m *GetCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodeRequest.Merge(m, src)
+}
+func (m *GetCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCodeRequest.Size(m)
+}
+func (m *GetCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodeRequest proto.InternalMessageInfo
+
+func (m *GetCodeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetCodeResponse struct {
+	// Address of the service.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Code associated with the service.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodeResponse) Reset()         { *m = GetCodeResponse{} }
+func (m *GetCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCodeResponse) ProtoMessage()    {}
+func (*GetCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{26}
+}
+
+func (m *GetCodeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodeResponse.Unmarshal(m, b)
+}
+func (m *GetCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodeResponse.Merge(m, src)
+}
+func (m *GetCodeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCodeResponse.Size(m)
+}
+func (m *GetCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodeResponse proto.InternalMessageInfo
+
+func (m *GetCodeResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetCodeResponse) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type GetExpiryRequest struct {
+	// Address of the service.
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExpiryRequest) Reset()         { *m = GetExpiryRequest{} }
+func (m *GetExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExpiryRequest) ProtoMessage()    {}
+func (*GetExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{27}
+}
+
+func (m *GetExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExpiryRequest.Unmarshal(m, b)
+}
+func (m *GetExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExpiryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExpiryRequest.Merge(m, src)
+}
+func (m *GetExpiryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExpiryRequest.Size(m)
+}
+func (m *GetExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExpiryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExpiryRequest proto.InternalMessageInfo
+
+func (m *GetExpiryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetExpiryResponse struct {
+	// Address of the service.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Expiration timestamp of the service.
+	Expiry               uint64   `protobuf:"varint,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExpiryResponse) Reset()         { *m = GetExpiryResponse{} }
+func (m *GetExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExpiryResponse) ProtoMessage()    {}
+func (*GetExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{28}
+}
+
+func (m *GetExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExpiryResponse.Unmarshal(m, b)
+}
+func (m *GetExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExpiryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExpiryResponse.Merge(m, src)
+}
+func (m *GetExpiryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExpiryResponse.Size(m)
+}
+func (m *GetExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExpiryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExpiryResponse proto.InternalMessageInfo
+
+func (m *GetExpiryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetExpiryResponse) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type GetPublicKeyRequest struct {
+	// Address of the service.
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPublicKeyRequest) Reset()         { *m = GetPublicKeyRequest{} }
+func (m *GetPublicKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPublicKeyRequest) ProtoMessage()    {}
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{29}
+}
+
+func (m *GetPublicKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPublicKeyRequest.Unmarshal(m, b)
+}
+func (m *GetPublicKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPublicKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPublicKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPublicKeyRequest.Merge(m, src)
+}
+func (m *GetPublicKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPublicKeyRequest.Size(m)
+}
+func (m *GetPublicKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPublicKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPublicKeyRequest proto.InternalMessageInfo
+
+func (m *GetPublicKeyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetPublicKeyResponse struct {
+	// Timestamp at which the public key expires.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Address of the service.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Public key associated with the service.
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Signature generated by the key manager for the public key.
+	Signature            string   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPublicKeyResponse) Reset()         { *m = GetPublicKeyResponse{} }
+func (m *GetPublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPublicKeyResponse) ProtoMessage()    {}
+func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{30}
+}
+
+func (m *GetPublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPublicKeyResponse.Unmarshal(m, b)
+}
+func (m *GetPublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPublicKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPublicKeyResponse.Merge(m, src)
+}
+func (m *GetPublicKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPublicKeyResponse.Size(m)
+}
+func (m *GetPublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPublicKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPublicKeyResponse proto.InternalMessageInfo
+
+func (m *GetPublicKeyResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetPublicKeyResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetPublicKeyResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *GetPublicKeyResponse) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type GetReceiptRequest struct {
+	// Hash of the transaction encoded as hex.
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReceiptRequest) Reset()         { *m = GetReceiptRequest{} }
+func (m *GetReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptRequest) ProtoMessage()    {}
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{31}
+}
+
+func (m *GetReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptRequest.Unmarshal(m, b)
+}
+func (m *GetReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptRequest.Merge(m, src)
+}
+func (m *GetReceiptRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptRequest.Size(m)
+}
+func (m *GetReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptRequest proto.InternalMessageInfo
+
+func (m *GetReceiptRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type ReceiptLog struct {
+	// Address of the service that emitted the log.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Topics of the log encoded as hex.
+	Topics []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Data of the log encoded as hex.
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptLog) Reset()         { *m = ReceiptLog{} }
+func (m *ReceiptLog) String() string { return proto.CompactTextString(m) }
+func (*ReceiptLog) ProtoMessage()    {}
+func (*ReceiptLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{32}
+}
+
+func (m *ReceiptLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptLog.Unmarshal(m, b)
+}
+func (m *ReceiptLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptLog.Marshal(b, m, deterministic)
+}
+func (m *ReceiptLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptLog.Merge(m, src)
+}
+func (m *ReceiptLog) XXX_Size() int {
+	return xxx_messageInfo_ReceiptLog.Size(m)
+}
+func (m *ReceiptLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptLog proto.InternalMessageInfo
+
+func (m *ReceiptLog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReceiptLog) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *ReceiptLog) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GetReceiptResponse struct {
+	// Hash of the transaction encoded as hex.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Status of the transaction, 1 if it succeeded.
+	Status uint64 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Gas used by the transaction.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Number of the block that includes the transaction.
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Address of the service created by the transaction.
+	ContractAddress string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Logs emitted by the transaction.
+	Logs                 []*ReceiptLog `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetReceiptResponse) Reset()         { *m = GetReceiptResponse{} }
+func (m *GetReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptResponse) ProtoMessage()    {}
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c762177ca7a838, []int{33}
+}
+
+func (m *GetReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptResponse.Unmarshal(m, b)
+}
+func (m *GetReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptResponse.Merge(m, src)
+}
+func (m *GetReceiptResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptResponse.Size(m)
+}
+func (m *GetReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptResponse proto.InternalMessageInfo
+
+func (m *GetReceiptResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetReceiptResponse) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetReceiptResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *GetReceiptResponse) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetReceiptResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *GetReceiptResponse) GetLogs() []*ReceiptLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Error)(nil), "service.Error")
+	proto.RegisterType((*AsyncResponse)(nil), "service.AsyncResponse")
+	proto.RegisterType((*DeployServiceRequest)(nil), "service.DeployServiceRequest")
+	proto.RegisterType((*DeployServiceSyncRequest)(nil), "service.DeployServiceSyncRequest")
+	proto.RegisterType((*ExecuteServiceRequest)(nil), "service.ExecuteServiceRequest")
+	proto.RegisterType((*ExecuteServiceCall)(nil), "service.ExecuteServiceCall")
+	proto.RegisterType((*ExecuteServiceBatchRequest)(nil), "service.ExecuteServiceBatchRequest")
+	proto.RegisterType((*ExecuteServiceBatchResponse)(nil), "service.ExecuteServiceBatchResponse")
+	proto.RegisterType((*ExecuteServiceSyncRequest)(nil), "service.ExecuteServiceSyncRequest")
+	proto.RegisterType((*SyncResponse)(nil), "service.SyncResponse")
+	proto.RegisterType((*PollServiceRequest)(nil), "service.PollServiceRequest")
+	proto.RegisterType((*PollServiceResponse)(nil), "service.PollServiceResponse")
+	proto.RegisterType((*Event)(nil), "service.Event")
+	proto.RegisterType((*ExecuteServiceEvent)(nil), "service.ExecuteServiceEvent")
+	proto.RegisterType((*DeployServiceEvent)(nil), "service.DeployServiceEvent")
+	proto.RegisterType((*ErrorEvent)(nil), "service.ErrorEvent")
+	proto.RegisterType((*LateResultEvent)(nil), "service.LateResultEvent")
+	proto.RegisterType((*CallServiceRequest)(nil), "service.CallServiceRequest")
+	proto.RegisterType((*CallServiceResponse)(nil), "service.CallServiceResponse")
+	proto.RegisterType((*EstimateGasRequest)(nil), "service.EstimateGasRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "service.EstimateGasResponse")
+	proto.RegisterType((*GetRequestStatusRequest)(nil), "service.GetRequestStatusRequest")
+	proto.RegisterType((*RequestStatusResponse)(nil), "service.RequestStatusResponse")
+	proto.RegisterType((*CancelServiceRequest)(nil), "service.CancelServiceRequest")
+	proto.RegisterType((*CancelServiceResponse)(nil), "service.CancelServiceResponse")
+	proto.RegisterType((*GetCodeRequest)(nil), "service.GetCodeRequest")
+	proto.RegisterType((*GetCodeResponse)(nil), "service.GetCodeResponse")
+	proto.RegisterType((*GetExpiryRequest)(nil), "service.GetExpiryRequest")
+	proto.RegisterType((*GetExpiryResponse)(nil), "service.GetExpiryResponse")
+	proto.RegisterType((*GetPublicKeyRequest)(nil), "service.GetPublicKeyRequest")
+	proto.RegisterType((*GetPublicKeyResponse)(nil), "service.GetPublicKeyResponse")
+	proto.RegisterType((*GetReceiptRequest)(nil), "service.GetReceiptRequest")
+	proto.RegisterType((*ReceiptLog)(nil), "service.ReceiptLog")
+	proto.RegisterType((*GetReceiptResponse)(nil), "service.GetReceiptResponse")
+}
+
+func init() { proto.RegisterFile("api/v0/service/grpc/service.proto", fileDescriptor_24c762177ca7a838) }
+
+var fileDescriptor_24c762177ca7a838 = []byte{
+	// 1431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcb, 0x72, 0xdb, 0x36,
+	0x17, 0x36, 0xad, 0x9b, 0x75, 0xe4, 0xc4, 0xfe, 0x61, 0x3b, 0x56, 0x68, 0x3b, 0x71, 0xf8, 0x67,
+	0x72, 0xe9, 0x25, 0x4e, 0x9d, 0x69, 0x26, 0x33, 0x9d, 0xb6, 0x63, 0x3b, 0xae, 0x9c, 0x36, 0xe9,
+	0x78, 0xe8, 0x49, 0x17, 0xed, 0x42, 0x03, 0x91, 0xb0, 0x84, 0x09, 0x25, 0xb2, 0x04, 0xe8, 0xc4,
+	0xcb, 0x6e, 0xba, 0xe9, 0xa2, 0xbb, 0xbe, 0x45, 0xdf, 0xa1, 0x8b, 0x3e, 0x41, 0x97, 0x7d, 0x85,
+	0xbe, 0x44, 0x07, 0x20, 0x48, 0x82, 0x14, 0x29, 0x39, 0xdd, 0xf1, 0x5c, 0xf0, 0x9d, 0x0b, 0x0e,
+	0xce, 0x39, 0x12, 0xdc, 0xc1, 0x01, 0xdd, 0xbb, 0x78, 0xbc, 0xc7, 0x48, 0x78, 0x41, 0x1d, 0xb2,
+	0x37, 0x0c, 0x03, 0x27, 0x21, 0x1e, 0x05, 0xa1, 0xcf, 0x7d, 0xd4, 0x52, 0xa4, 0x75, 0x02, 0x8d,
+	0xe3, 0x30, 0xf4, 0x43, 0xb4, 0x03, 0x40, 0xc4, 0x47, 0xdf, 0xf1, 0x5d, 0xd2, 0x35, 0x76, 0x8d,
+	0x07, 0x0d, 0xbb, 0x2d, 0x39, 0x47, 0xbe, 0x4b, 0xd0, 0x2e, 0x74, 0x5c, 0xc2, 0x9c, 0x90, 0x06,
+	0x9c, 0xfa, 0x93, 0xee, 0xe2, 0xae, 0xf1, 0xa0, 0x6d, 0xeb, 0x2c, 0xeb, 0x36, 0x5c, 0x3b, 0x60,
+	0x97, 0x13, 0xc7, 0x26, 0x2c, 0xf0, 0x27, 0x8c, 0xa0, 0xeb, 0xb0, 0x48, 0x5d, 0x89, 0x54, 0xb7,
+	0x17, 0xa9, 0x6b, 0xbd, 0x80, 0xf5, 0xe7, 0x24, 0xf0, 0xfc, 0xcb, 0xb3, 0xd8, 0xb6, 0x4d, 0x7e,
+	0x8c, 0x08, 0xe3, 0x08, 0x41, 0xdd, 0xc5, 0x1c, 0x4b, 0xcd, 0xb6, 0x2d, 0xbf, 0x85, 0x37, 0x9c,
+	0x8e, 0x89, 0x1f, 0xf1, 0xfe, 0x98, 0x49, 0x6b, 0x75, 0xbb, 0xad, 0x38, 0xaf, 0x98, 0x75, 0x0e,
+	0xdd, 0x1c, 0xd4, 0x99, 0xb4, 0x5b, 0x0d, 0xb7, 0x09, 0xad, 0xb7, 0x98, 0x6a, 0x58, 0x4d, 0x41,
+	0xbe, 0x62, 0x05, 0x3b, 0xb5, 0xa2, 0x1d, 0x17, 0x36, 0x8e, 0xdf, 0x11, 0x27, 0xe2, 0xe4, 0x0a,
+	0x3e, 0x77, 0xa1, 0x85, 0x5d, 0x37, 0x24, 0x8c, 0xa9, 0xf4, 0x24, 0xe4, 0x3c, 0x2b, 0x87, 0x80,
+	0xf2, 0x56, 0x8e, 0xb0, 0xe7, 0xe9, 0x70, 0x46, 0x1e, 0x2e, 0x31, 0xbe, 0x98, 0x19, 0xb7, 0x7e,
+	0x35, 0xc0, 0xcc, 0x83, 0x1c, 0x62, 0xee, 0x8c, 0x12, 0x7f, 0x3f, 0x81, 0x86, 0x83, 0x3d, 0x4f,
+	0x40, 0xd5, 0x1e, 0x74, 0xf6, 0xb7, 0x1e, 0x25, 0xe5, 0x30, 0x6d, 0xd8, 0x8e, 0x35, 0xd1, 0x6d,
+	0xe8, 0x60, 0xee, 0x8f, 0xa9, 0xd3, 0xc7, 0x11, 0x1f, 0x49, 0x63, 0x4b, 0x36, 0xc4, 0xac, 0x83,
+	0x88, 0x8f, 0xe6, 0x45, 0xb5, 0x07, 0x5b, 0xa5, 0x0e, 0xa9, 0xea, 0x58, 0x85, 0x1a, 0x75, 0x63,
+	0x7f, 0xea, 0xb6, 0xf8, 0xb4, 0x7e, 0x32, 0xe0, 0x66, 0xfe, 0xc4, 0xbc, 0x6b, 0xad, 0xce, 0xb8,
+	0x76, 0xe1, 0xb5, 0x19, 0x17, 0x5e, 0x2f, 0x3a, 0xfd, 0x1c, 0x96, 0xcf, 0x66, 0xd4, 0x30, 0xba,
+	0x0b, 0x0d, 0x72, 0x41, 0x26, 0x5c, 0xda, 0xeb, 0xec, 0x5f, 0xcf, 0xf2, 0x28, 0xb8, 0x76, 0x2c,
+	0xb4, 0x7e, 0x36, 0x00, 0x9d, 0xfa, 0x9e, 0x57, 0x28, 0x9a, 0x1b, 0xd0, 0xf4, 0xcf, 0xcf, 0x19,
+	0xe1, 0x0a, 0x50, 0x51, 0x68, 0x1d, 0x1a, 0x8e, 0x1f, 0x29, 0xd0, 0x6b, 0x76, 0x4c, 0xa0, 0x87,
+	0xb0, 0xea, 0x52, 0xe6, 0xe0, 0xd0, 0xed, 0x07, 0x21, 0xb9, 0xa0, 0x7e, 0x14, 0xc7, 0xb2, 0x64,
+	0xaf, 0x28, 0xfe, 0xa9, 0x62, 0xeb, 0xd1, 0xd6, 0xf5, 0x68, 0xad, 0xd7, 0xb0, 0x96, 0xf3, 0x43,
+	0x45, 0x55, 0xe5, 0xc8, 0x3d, 0x68, 0xca, 0x00, 0x44, 0x3a, 0x6b, 0x25, 0xe1, 0x29, 0xa9, 0xf5,
+	0x8f, 0x01, 0x0d, 0xc9, 0x41, 0xcf, 0xa0, 0x45, 0xe2, 0x2b, 0x93, 0x50, 0x9d, 0xfd, 0xed, 0x8a,
+	0xca, 0x92, 0xea, 0x27, 0x0b, 0x76, 0xa2, 0x8e, 0x3e, 0x85, 0xa6, 0x2b, 0x9f, 0xb0, 0x4a, 0x65,
+	0x56, 0x92, 0xb9, 0x97, 0x9d, 0x9c, 0x53, 0xca, 0xe8, 0x43, 0x68, 0xc8, 0xa6, 0x24, 0x53, 0xd1,
+	0xd9, 0x5f, 0xcb, 0xcc, 0x09, 0x6e, 0xa2, 0x1d, 0xeb, 0xa0, 0xcf, 0xa0, 0xe3, 0x61, 0x4e, 0xfa,
+	0x21, 0x61, 0x91, 0xc7, 0x65, 0x6e, 0x3a, 0xfb, 0xdd, 0xf4, 0xc8, 0x4b, 0xcc, 0x45, 0x4e, 0x22,
+	0x8f, 0x27, 0xe7, 0xc0, 0x4b, 0x59, 0x87, 0x2d, 0x75, 0xd5, 0xd6, 0x1f, 0x06, 0xac, 0x95, 0x04,
+	0x33, 0x55, 0x1b, 0xd5, 0xd5, 0x28, 0xf2, 0x1d, 0xf1, 0x20, 0xe2, 0xd2, 0xeb, 0xb6, 0xad, 0x28,
+	0x71, 0xc5, 0x3c, 0xc4, 0x13, 0x86, 0x1d, 0xd1, 0x41, 0xfb, 0x23, 0xcc, 0x46, 0xd2, 0xc9, 0xb6,
+	0xbd, 0xa2, 0xf1, 0x4f, 0x30, 0x1b, 0xa1, 0x3b, 0xb0, 0x3c, 0xf0, 0x7c, 0xe7, 0x4d, 0x7f, 0x12,
+	0x8d, 0x07, 0x24, 0xec, 0x36, 0xa4, 0xd9, 0x8e, 0xe4, 0x7d, 0x2b, 0x59, 0xe8, 0x26, 0x2c, 0x0d,
+	0x31, 0xeb, 0x47, 0x8c, 0xb8, 0xdd, 0xa6, 0x14, 0xb7, 0x86, 0x98, 0xbd, 0x66, 0xc4, 0xb5, 0xfe,
+	0x34, 0x00, 0x4d, 0xa7, 0xf5, 0x3d, 0x22, 0x28, 0xf3, 0xb4, 0x76, 0x35, 0x4f, 0xeb, 0xb3, 0x3d,
+	0x6d, 0xe4, 0x3c, 0x45, 0x5b, 0xd0, 0x16, 0x03, 0x28, 0xb6, 0xd0, 0x94, 0x16, 0x96, 0x04, 0x43,
+	0x40, 0x5b, 0x87, 0x00, 0xd9, 0x35, 0x97, 0xbd, 0x4d, 0x07, 0x47, 0x8c, 0x4c, 0xbf, 0x4d, 0x71,
+	0xc6, 0x8e, 0x85, 0xd6, 0xef, 0x06, 0xac, 0x14, 0x2e, 0x7e, 0x0a, 0x69, 0x07, 0x20, 0x8c, 0xdf,
+	0x6c, 0x9f, 0xba, 0xc9, 0xf4, 0x51, 0x9c, 0x17, 0x2e, 0x7a, 0x9a, 0x15, 0x7d, 0x6d, 0x7e, 0xd1,
+	0x67, 0x25, 0xff, 0x24, 0x2d, 0xf9, 0xfa, 0xdc, 0x92, 0x4f, 0x0a, 0x5e, 0x0c, 0x07, 0xd1, 0x95,
+	0x0b, 0xad, 0xe4, 0xfd, 0x86, 0x43, 0x0f, 0xd6, 0x8e, 0xf0, 0x74, 0x1b, 0xa8, 0x06, 0xc9, 0x0a,
+	0x76, 0x51, 0x2f, 0x58, 0x39, 0xa9, 0x18, 0xa7, 0x63, 0xcc, 0x49, 0x0f, 0xb3, 0xff, 0xe6, 0xcc,
+	0x6f, 0xe2, 0x39, 0xe9, 0x20, 0xca, 0x9b, 0x2d, 0x68, 0x8b, 0xa2, 0xf0, 0xe8, 0x98, 0x26, 0x7d,
+	0x49, 0x54, 0xc9, 0x4b, 0x41, 0x27, 0xc2, 0x20, 0xa4, 0x0e, 0x51, 0x68, 0x42, 0x78, 0x2a, 0x68,
+	0x31, 0x4a, 0xce, 0x09, 0x51, 0xf5, 0x28, 0x3e, 0xd1, 0x13, 0xd8, 0x70, 0xfc, 0xc9, 0x39, 0x75,
+	0xc9, 0x84, 0x53, 0xec, 0xf5, 0xcf, 0xb1, 0xe7, 0x0d, 0xb0, 0xf3, 0x46, 0x26, 0x7e, 0xc9, 0x5e,
+	0xd7, 0x85, 0x5f, 0x29, 0x99, 0xf5, 0x10, 0x36, 0x7b, 0x84, 0xab, 0xa0, 0xce, 0x38, 0xe6, 0x51,
+	0x1a, 0x61, 0x71, 0x95, 0x19, 0xc1, 0x46, 0x41, 0xaf, 0x62, 0x5e, 0xac, 0x43, 0x83, 0x71, 0xcc,
+	0x13, 0x9f, 0x63, 0xe2, 0x3d, 0x5e, 0x93, 0x75, 0x0f, 0xd6, 0x8f, 0xf0, 0xc4, 0x21, 0xc5, 0x02,
+	0x28, 0x7a, 0xb4, 0x09, 0x1b, 0x05, 0xbd, 0xd8, 0x23, 0xeb, 0x03, 0xb8, 0xde, 0x23, 0x5c, 0xec,
+	0x70, 0x73, 0xaf, 0xcb, 0xfa, 0x12, 0x56, 0x52, 0xdd, 0xb9, 0x35, 0x82, 0xa0, 0x2e, 0x57, 0x45,
+	0x75, 0xb7, 0xe2, 0xdb, 0xfa, 0x08, 0x56, 0x7b, 0x84, 0x1f, 0xbf, 0x0b, 0x68, 0x78, 0x39, 0xdf,
+	0xdc, 0x31, 0xfc, 0x4f, 0xd3, 0xbe, 0x4a, 0x51, 0x12, 0xa9, 0x9b, 0xec, 0x70, 0x31, 0x65, 0xed,
+	0xc1, 0x5a, 0x8f, 0xf0, 0xd3, 0x68, 0xe0, 0x51, 0xe7, 0x1b, 0x72, 0x05, 0xbb, 0xbf, 0x18, 0xb0,
+	0x9e, 0x3f, 0xa1, 0x6c, 0x6f, 0x83, 0x5c, 0x05, 0x18, 0xc7, 0xe3, 0x40, 0xe5, 0x36, 0x63, 0xcc,
+	0xde, 0xef, 0x02, 0x09, 0xd6, 0x7f, 0x43, 0x2e, 0xd5, 0x4d, 0xb6, 0x83, 0x04, 0x5e, 0xc0, 0x32,
+	0x3a, 0x9c, 0x60, 0x1e, 0x85, 0x44, 0xf5, 0xf7, 0x8c, 0x61, 0xdd, 0x97, 0x59, 0xb0, 0x89, 0x43,
+	0x68, 0xc0, 0xb5, 0x6d, 0x47, 0x56, 0x85, 0xda, 0x76, 0xc4, 0xb7, 0x65, 0x03, 0x28, 0xad, 0x97,
+	0xfe, 0x70, 0x76, 0x9e, 0xb8, 0x1f, 0x50, 0x27, 0x9e, 0xe2, 0x6d, 0x5b, 0x51, 0xe9, 0x63, 0xac,
+	0x69, 0x8f, 0xf1, 0x2f, 0x03, 0x90, 0x6e, 0x5d, 0x25, 0xa2, 0xc4, 0xbc, 0x80, 0x65, 0xb2, 0xd8,
+	0x93, 0xf4, 0xc7, 0x54, 0xae, 0x99, 0xd7, 0xf2, 0xcd, 0xfc, 0x0a, 0xa3, 0xe0, 0x21, 0xac, 0x3a,
+	0xfe, 0x84, 0x87, 0xd8, 0xe1, 0xfd, 0x24, 0x9e, 0x46, 0xfc, 0x14, 0x12, 0xfe, 0x81, 0x8a, 0xeb,
+	0x3e, 0xd4, 0x3d, 0x7f, 0xc8, 0xba, 0xcd, 0xdd, 0x5a, 0x6e, 0xf2, 0x67, 0x49, 0xb1, 0xa5, 0xc2,
+	0xfe, 0xdf, 0x4b, 0xd0, 0x52, 0xcf, 0x00, 0x1d, 0x40, 0x33, 0x6e, 0xae, 0x68, 0xa7, 0xbc, 0xdb,
+	0xaa, 0x8c, 0x9b, 0x37, 0x52, 0x71, 0xee, 0x57, 0x8c, 0xb5, 0x80, 0x4e, 0x00, 0xd4, 0x89, 0xcb,
+	0x89, 0x83, 0xee, 0x94, 0xc3, 0x68, 0xab, 0xaa, 0xb9, 0x91, 0xaa, 0x9c, 0xe5, 0x91, 0x8e, 0xa0,
+	0xa5, 0x06, 0x04, 0xba, 0x55, 0x31, 0x32, 0xe6, 0xbb, 0xf3, 0x03, 0x2c, 0xab, 0x23, 0x72, 0xa1,
+	0x46, 0xff, 0xaf, 0x40, 0xd2, 0xf7, 0x7f, 0xf3, 0xee, 0x6c, 0xa5, 0x14, 0xfc, 0x6b, 0xe8, 0x24,
+	0x0a, 0x22, 0x58, 0xab, 0xe2, 0xd8, 0x15, 0xa3, 0xad, 0x8b, 0xe5, 0x13, 0x65, 0x63, 0x6e, 0x7a,
+	0x27, 0x36, 0xb7, 0xcb, 0x85, 0x29, 0xc8, 0xe7, 0x00, 0x52, 0xc0, 0x43, 0x82, 0xc7, 0xb3, 0xa1,
+	0x0a, 0xdb, 0xaa, 0xb5, 0xf0, 0xd8, 0x10, 0x3e, 0xc8, 0x1f, 0x53, 0xd9, 0xc1, 0x23, 0x3c, 0xc3,
+	0x87, 0x92, 0x29, 0xa9, 0x92, 0x92, 0x0d, 0x2c, 0x0d, 0x6b, 0x7a, 0x16, 0x9a, 0xdb, 0xe5, 0xc2,
+	0x14, 0xeb, 0x3b, 0xd9, 0x21, 0x73, 0xc3, 0x03, 0xed, 0xa6, 0x67, 0x2a, 0xe6, 0x8f, 0x79, 0x4b,
+	0x2b, 0xf6, 0x92, 0xb1, 0x63, 0x2d, 0xa0, 0x17, 0xd0, 0x8c, 0xfb, 0xbf, 0x56, 0xe7, 0x65, 0x83,
+	0xc3, 0xbc, 0x55, 0x25, 0x4e, 0xa1, 0xbe, 0x80, 0x96, 0x9a, 0x02, 0x68, 0x53, 0xf7, 0x4c, 0x9b,
+	0x21, 0x66, 0x77, 0x5a, 0x90, 0x9e, 0x7f, 0x0e, 0xed, 0xb4, 0xad, 0xa3, 0x9b, 0xba, 0x62, 0x6e,
+	0x30, 0x98, 0x66, 0x99, 0x28, 0x45, 0x79, 0x05, 0xcb, 0x7a, 0x8f, 0x46, 0xdb, 0xba, 0x76, 0xb1,
+	0xd9, 0x9b, 0x3b, 0x15, 0xd2, 0x14, 0xae, 0x07, 0x90, 0xf5, 0x39, 0x64, 0xe6, 0x33, 0xae, 0xb7,
+	0x5e, 0x73, 0xab, 0x54, 0x96, 0x00, 0x1d, 0x3e, 0xfb, 0xfe, 0xe9, 0x90, 0xf2, 0x51, 0x34, 0x78,
+	0xe4, 0xf8, 0xe3, 0x3d, 0x1f, 0x33, 0xca, 0x3c, 0x3c, 0x60, 0xf1, 0xd7, 0xc7, 0x43, 0xcc, 0xc9,
+	0x5b, 0x7c, 0xb9, 0x57, 0xf2, 0x0f, 0xcc, 0xa0, 0x29, 0xff, 0x7a, 0x79, 0xf2, 0xef, 0x00, 0x63,
+	0xea, 0xcb, 0x99, 0x9f, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Deploy a new service.
+	Deploy(ctx context.Context, in *DeployServiceRequest, opts ...grpc.CallOption) (*AsyncResponse, error)
+	// Deploy a new service and wait for the outcome of the deployment.
+	DeploySync(ctx context.Context, in *DeployServiceSyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// Execute a deployed service.
+	Execute(ctx context.Context, in *ExecuteServiceRequest, opts ...grpc.CallOption) (*AsyncResponse, error)
+	// Execute multiple deployed services with a single request.
+	ExecuteBatch(ctx context.Context, in *ExecuteServiceBatchRequest, opts ...grpc.CallOption) (*ExecuteServiceBatchResponse, error)
+	// Execute a deployed service and wait for the outcome of the execution.
+	ExecuteSync(ctx context.Context, in *ExecuteServiceSyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// Poll for the outcome of asynchronous requests.
+	Poll(ctx context.Context, in *PollServiceRequest, opts ...grpc.CallOption) (*PollServiceResponse, error)
+	// Stream the outcome of asynchronous requests as they become available.
+	PollStream(ctx context.Context, in *PollServiceRequest, opts ...grpc.CallOption) (Service_PollStreamClient, error)
+	// Run a read-only call against a service.
+	Call(ctx context.Context, in *CallServiceRequest, opts ...grpc.CallOption) (*CallServiceResponse, error)
+	// Estimate the gas and fee of a deploy or execute request.
+	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// Get the status of an asynchronous request.
+	GetRequestStatus(ctx context.Context, in *GetRequestStatusRequest, opts ...grpc.CallOption) (*RequestStatusResponse, error)
+	// Cancel an asynchronous request whose transaction has not been submitted.
+	Cancel(ctx context.Context, in *CancelServiceRequest, opts ...grpc.CallOption) (*CancelServiceResponse, error)
+	// Get the code of a service.
+	GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error)
+	// Get the expiration timestamp of a service.
+	GetExpiry(ctx context.Context, in *GetExpiryRequest, opts ...grpc.CallOption) (*GetExpiryResponse, error)
+	// Get the public key of a service.
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	// Get the receipt of a transaction submitted for the caller.
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
+}
+
+type serviceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewServiceClient(cc *grpc.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Deploy(ctx context.Context, in *DeployServiceRequest, opts ...grpc.CallOption) (*AsyncResponse, error) {
+	out := new(AsyncResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/Deploy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeploySync(ctx context.Context, in *DeployServiceSyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/DeploySync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Execute(ctx context.Context, in *ExecuteServiceRequest, opts ...grpc.CallOption) (*AsyncResponse, error) {
+	out := new(AsyncResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ExecuteBatch(ctx context.Context, in *ExecuteServiceBatchRequest, opts ...grpc.CallOption) (*ExecuteServiceBatchResponse, error) {
+	out := new(ExecuteServiceBatchResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/ExecuteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ExecuteSync(ctx context.Context, in *ExecuteServiceSyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/ExecuteSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Poll(ctx context.Context, in *PollServiceRequest, opts ...grpc.CallOption) (*PollServiceResponse, error) {
+	out := new(PollServiceResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/Poll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PollStream(ctx context.Context, in *PollServiceRequest, opts ...grpc.CallOption) (Service_PollStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/service.Service/PollStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &servicePollStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_PollStreamClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type servicePollStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *servicePollStreamClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) Call(ctx context.Context, in *CallServiceRequest, opts ...grpc.CallOption) (*CallServiceResponse, error) {
+	out := new(CallServiceResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetRequestStatus(ctx context.Context, in *GetRequestStatusRequest, opts ...grpc.CallOption) (*RequestStatusResponse, error) {
+	out := new(RequestStatusResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/GetRequestStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Cancel(ctx context.Context, in *CancelServiceRequest, opts ...grpc.CallOption) (*CancelServiceResponse, error) {
+	out := new(CancelServiceResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error) {
+	out := new(GetCodeResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/GetCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetExpiry(ctx context.Context, in *GetExpiryRequest, opts ...grpc.CallOption) (*GetExpiryResponse, error) {
+	out := new(GetExpiryResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/GetExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error) {
+	out := new(GetPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/GetPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, "/service.Service/GetReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// Deploy a new service.
+	Deploy(context.Context, *DeployServiceRequest) (*AsyncResponse, error)
+	// Deploy a new service and wait for the outcome of the deployment.
+	DeploySync(context.Context, *DeployServiceSyncRequest) (*SyncResponse, error)
+	// Execute a deployed service.
+	Execute(context.Context, *ExecuteServiceRequest) (*AsyncResponse, error)
+	// Execute multiple deployed services with a single request.
+	ExecuteBatch(context.Context, *ExecuteServiceBatchRequest) (*ExecuteServiceBatchResponse, error)
+	// Execute a deployed service and wait for the outcome of the execution.
+	ExecuteSync(context.Context, *ExecuteServiceSyncRequest) (*SyncResponse, error)
+	// Poll for the outcome of asynchronous requests.
+	Poll(context.Context, *PollServiceRequest) (*PollServiceResponse, error)
+	// Stream the outcome of asynchronous requests as they become available.
+	PollStream(*PollServiceRequest, Service_PollStreamServer) error
+	// Run a read-only call against a service.
+	Call(context.Context, *CallServiceRequest) (*CallServiceResponse, error)
+	// Estimate the gas and fee of a deploy or execute request.
+	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
+	// Get the status of an asynchronous request.
+	GetRequestStatus(context.Context, *GetRequestStatusRequest) (*RequestStatusResponse, error)
+	// Cancel an asynchronous request whose transaction has not been submitted.
+	Cancel(context.Context, *CancelServiceRequest) (*CancelServiceResponse, error)
+	// Get the code of a service.
+	GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error)
+	// Get the expiration timestamp of a service.
+	GetExpiry(context.Context, *GetExpiryRequest) (*GetExpiryResponse, error)
+	// Get the public key of a service.
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	// Get the receipt of a transaction submitted for the caller.
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) Deploy(ctx context.Context, req *DeployServiceRequest) (*AsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deploy not implemented")
+}
+func (*UnimplementedServiceServer) DeploySync(ctx context.Context, req *DeployServiceSyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploySync not implemented")
+}
+func (*UnimplementedServiceServer) Execute(ctx context.Context, req *ExecuteServiceRequest) (*AsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
+}
+func (*UnimplementedServiceServer) ExecuteBatch(ctx context.Context, req *ExecuteServiceBatchRequest) (*ExecuteServiceBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBatch not implemented")
+}
+func (*UnimplementedServiceServer) ExecuteSync(ctx context.Context, req *ExecuteServiceSyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSync not implemented")
+}
+func (*UnimplementedServiceServer) Poll(ctx context.Context, req *PollServiceRequest) (*PollServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
+}
+func (*UnimplementedServiceServer) PollStream(req *PollServiceRequest, srv Service_PollStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PollStream not implemented")
+}
+func (*UnimplementedServiceServer) Call(ctx context.Context, req *CallServiceRequest) (*CallServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (*UnimplementedServiceServer) EstimateGas(ctx context.Context, req *EstimateGasRequest) (*EstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (*UnimplementedServiceServer) GetRequestStatus(ctx context.Context, req *GetRequestStatusRequest) (*RequestStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequestStatus not implemented")
+}
+func (*UnimplementedServiceServer) Cancel(ctx context.Context, req *CancelServiceRequest) (*CancelServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (*UnimplementedServiceServer) GetCode(ctx context.Context, req *GetCodeRequest) (*GetCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCode not implemented")
+}
+func (*UnimplementedServiceServer) GetExpiry(ctx context.Context, req *GetExpiryRequest) (*GetExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiry not implemented")
+}
+func (*UnimplementedServiceServer) GetPublicKey(ctx context.Context, req *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (*UnimplementedServiceServer) GetReceipt(ctx context.Context, req *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+
+func RegisterServiceServer(s *grpc.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Deploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/Deploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Deploy(ctx, req.(*DeployServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DeploySync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployServiceSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeploySync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/DeploySync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeploySync(ctx, req.(*DeployServiceSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Execute(ctx, req.(*ExecuteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ExecuteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteServiceBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ExecuteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/ExecuteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ExecuteBatch(ctx, req.(*ExecuteServiceBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ExecuteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteServiceSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ExecuteSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/ExecuteSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ExecuteSync(ctx, req.(*ExecuteServiceSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Poll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/Poll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Poll(ctx, req.(*PollServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PollStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PollServiceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).PollStream(m, &servicePollStreamServer{stream})
+}
+
+type Service_PollStreamServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type servicePollStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *servicePollStreamServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Call(ctx, req.(*CallServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateGas(ctx, req.(*EstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetRequestStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequestStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetRequestStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/GetRequestStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetRequestStatus(ctx, req.(*GetRequestStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Cancel(ctx, req.(*CancelServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/GetCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetCode(ctx, req.(*GetCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/GetExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetExpiry(ctx, req.(*GetExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/GetPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.Service/GetReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "service.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deploy",
+			Handler:    _Service_Deploy_Handler,
+		},
+		{
+			MethodName: "DeploySync",
+			Handler:    _Service_DeploySync_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _Service_Execute_Handler,
+		},
+		{
+			MethodName: "ExecuteBatch",
+			Handler:    _Service_ExecuteBatch_Handler,
+		},
+		{
+			MethodName: "ExecuteSync",
+			Handler:    _Service_ExecuteSync_Handler,
+		},
+		{
+			MethodName: "Poll",
+			Handler:    _Service_Poll_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _Service_Call_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Service_EstimateGas_Handler,
+		},
+		{
+			MethodName: "GetRequestStatus",
+			Handler:    _Service_GetRequestStatus_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Service_Cancel_Handler,
+		},
+		{
+			MethodName: "GetCode",
+			Handler:    _Service_GetCode_Handler,
+		},
+		{
+			MethodName: "GetExpiry",
+			Handler:    _Service_GetExpiry_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _Service_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _Service_GetReceipt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PollStream",
+			Handler:       _Service_PollStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/v0/service/grpc/service.proto",
+}
@@ -5,7 +5,10 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	stderr "errors"
+	"net/http"
+	"time"
 
+	"github.com/oasislabs/oasis-gateway/api/v0/stream"
 	auth "github.com/oasislabs/oasis-gateway/auth/core"
 	backend "github.com/oasislabs/oasis-gateway/backend/core"
 	"github.com/oasislabs/oasis-gateway/errors"
@@ -24,9 +27,40 @@ type Client interface {
 	// the response can be later retrieved with a PollService request
 	ExecuteServiceAsync(context.Context, backend.ExecuteServiceRequest) (uint64, errors.Err)
 
+	// ExecuteServiceBatchAsync triggers multiple execute service operations and
+	// returns their IDs in order
+	ExecuteServiceBatchAsync(context.Context, backend.ExecuteServiceBatchRequest) ([]uint64, errors.Err)
+
+	// DeployServiceSync triggers a deploy service operation and waits for its
+	// outcome until the context is done
+	DeployServiceSync(context.Context, backend.DeployServiceRequest) (backend.SyncResponse, errors.Err)
+
+	// ExecuteServiceSync triggers an execute service operation and waits for its
+	// outcome until the context is done
+	ExecuteServiceSync(context.Context, backend.ExecuteServiceRequest) (backend.SyncResponse, errors.Err)
+
 	// PollService allows the client to poll for asynchronous responses
 	PollService(context.Context, backend.PollServiceRequest) (backend.Events, errors.Err)
 
+	// GetRequestStatus retrieves the status of an asynchronous request
+	GetRequestStatus(context.Context, backend.GetRequestStatusRequest) (backend.RequestStatus, errors.Err)
+
+	// CancelService cancels an asynchronous request whose transaction
+	// has not been submitted yet
+	CancelService(context.Context, backend.CancelServiceRequest) errors.Err
+
+	// CallContract runs a read-only call against a service and returns
+	// its output without submitting a transaction
+	CallContract(context.Context, backend.CallContractRequest) (backend.CallContractResponse, errors.Err)
+
+	// EstimateGas estimates the gas and fee the gateway would use for a
+	// deploy or execute request
+	EstimateGas(context.Context, backend.EstimateGasRequest) (backend.EstimateGasResponse, errors.Err)
+
+	// GetReceipt retrieves the receipt of a transaction submitted
+	// for the caller
+	GetReceipt(context.Context, backend.GetReceiptRequest) (backend.GetReceiptResponse, errors.Err)
+
 	// GetCode retrieves the code associated with a service.
 	GetCode(context.Context, backend.GetCodeRequest) (backend.GetCodeResponse, errors.Err)
 
@@ -39,11 +73,29 @@ type Client interface {
 	GetPublicKey(context.Context, backend.GetPublicKeyRequest) (backend.GetPublicKeyResponse, errors.Err)
 }
 
+const (
+	// defaultSyncWait is the time a synchronous request waits for the
+	// outcome of the request if the client does not specify it
+	defaultSyncWait = 5 * time.Second
+
+	// maxIdempotencyKeyLength is the maximum length of the idempotency
+	// key a client can provide with a request
+	maxIdempotencyKeyLength = 128
+
+	// maxBatchSize is the maximum number of calls a client can
+	// submit in a single batch
+	maxBatchSize = 256
+)
+
 // Services required by the ServiceHandler execution
 type Services struct {
 	Logger   log.Logger
 	Client   Client
 	Verifier auth.Auth
+
+	// WriteTimeout is the write timeout of the http server that
+	// serves the handler, which bounds the time requests can wait
+	WriteTimeout time.Duration
 }
 
 // ServiceHandler implements the handlers for service management
@@ -51,13 +103,18 @@ type ServiceHandler struct {
 	logger   log.Logger
 	client   Client
 	verifier auth.Auth
+	maxWait  time.Duration
 }
 
-// DeployService handles the deployment of new services
-func (h ServiceHandler) DeployService(ctx context.Context, v interface{}) (interface{}, error) {
+// makeDeployRequest verifies the provided deploy request and creates
+// the request to be passed on to the client
+func (h ServiceHandler) makeDeployRequest(
+	ctx context.Context,
+	callType string,
+	req *DeployServiceRequest,
+) (backend.DeployServiceRequest, errors.Err) {
 	aad := ctx.Value(auth.AAD{}).(string)
 	session := ctx.Value(auth.Session{}).(string)
-	req := v.(*DeployServiceRequest)
 
 	authReq := auth.AuthRequest{
 		API:  "Deploy",
@@ -67,20 +124,57 @@ func (h ServiceHandler) DeployService(ctx context.Context, v interface{}) (inter
 	if err := h.verifier.Verify(ctx, authReq); err != nil {
 		e := errors.New(errors.ErrFailedAADVerification, err)
 		h.logger.Debug(ctx, "failed to verify AAD", log.MapFields{
-			"call_type": "DeployServiceFailure",
+			"call_type": callType,
 			"session":   session,
 			"err":       e,
 		})
-		return nil, e
+		return backend.DeployServiceRequest{}, e
 	}
 
-	// a context from an http request is cancelled after the response to the request is returned,
-	// so a new context is needed to handle the asynchronous request
-	id, err := h.client.DeployServiceAsync(context.Background(), backend.DeployServiceRequest{
+	return backend.DeployServiceRequest{
 		AAD:        aad,
 		Data:       req.Data,
 		SessionKey: session,
-	})
+		Timeout:    time.Duration(req.TimeoutMs) * time.Millisecond,
+	}, nil
+}
+
+// getIdempotencyKey returns the idempotency key provided by the
+// client with the request, if any
+func (h ServiceHandler) getIdempotencyKey(ctx context.Context, callType string) (string, errors.Err) {
+	session := ctx.Value(auth.Session{}).(string)
+	key, _ := ctx.Value(rpc.IdempotencyKey{}).(string)
+
+	if len(key) > maxIdempotencyKeyLength {
+		e := errors.New(errors.ErrIdempotencyKeyTooLong, nil)
+		h.logger.Debug(ctx, "received idempotency key that exceeds the maximum length", log.MapFields{
+			"call_type": callType,
+			"session":   session,
+		}, e)
+		return "", e
+	}
+
+	return key, nil
+}
+
+// DeployService handles the deployment of new services
+func (h ServiceHandler) DeployService(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*DeployServiceRequest)
+
+	deployReq, err := h.makeDeployRequest(ctx, "DeployServiceFailure", req)
+	if err != nil {
+		return nil, err
+	}
+
+	deployReq.IdempotencyKey, err = h.getIdempotencyKey(ctx, "DeployServiceFailure")
+	if err != nil {
+		return nil, err
+	}
+
+	// a context from an http request is cancelled after the response to the request is returned,
+	// so a new context is needed to handle the asynchronous request
+	id, err := h.client.DeployServiceAsync(context.Background(), deployReq)
 	if err != nil {
 		h.logger.Debug(ctx, "failed to start request", log.MapFields{
 			"call_type": "DeployServiceFailure",
@@ -92,6 +186,35 @@ func (h ServiceHandler) DeployService(ctx context.Context, v interface{}) (inter
 	return AsyncResponse{ID: id}, nil
 }
 
+// DeployServiceSync handles the deployment of new services waiting
+// for the outcome of the deployment
+func (h ServiceHandler) DeployServiceSync(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*DeployServiceSyncRequest)
+
+	deployReq, err := h.makeDeployRequest(ctx, "DeployServiceSyncFailure", &DeployServiceRequest{
+		Data:      req.Data,
+		TimeoutMs: req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.syncWait(req.WaitMs))
+	defer cancel()
+
+	res, err := h.client.DeployServiceSync(ctx, deployReq)
+	if err != nil {
+		h.logger.Debug(ctx, "failed to start request", log.MapFields{
+			"call_type": "DeployServiceSyncFailure",
+			"session":   session,
+		}, err)
+		return nil, err
+	}
+
+	return h.mapSyncResponse(res), nil
+}
+
 // parseExecuteMessage attempts to extract the AAD and PK from a standard confidential message format.
 func (h ServiceHandler) parseExecuteMessage(v *ExecuteServiceRequest) (authReq auth.AuthRequest) {
 	authReq.API = "Execute"
@@ -122,41 +245,63 @@ func (h ServiceHandler) parseExecuteMessage(v *ExecuteServiceRequest) (authReq a
 	return
 }
 
-// ExecuteService handles the execution of deployed services
-func (h ServiceHandler) ExecuteService(ctx context.Context, v interface{}) (interface{}, error) {
+// makeExecuteRequest verifies the provided execute request and creates
+// the request to be passed on to the client
+func (h ServiceHandler) makeExecuteRequest(
+	ctx context.Context,
+	callType string,
+	req *ExecuteServiceRequest,
+) (backend.ExecuteServiceRequest, errors.Err) {
 	aad := ctx.Value(auth.AAD{}).(string)
 	session := ctx.Value(auth.Session{}).(string)
 
-	req := v.(*ExecuteServiceRequest)
-
 	if len(req.Address) == 0 {
 		e := errors.New(errors.ErrInvalidAddress, nil)
 		h.logger.Debug(ctx, "received empty address", log.MapFields{
-			"call_type": "ExecuteServiceFailure",
+			"call_type": callType,
 			"session":   session,
 		}, e)
-		return nil, e
+		return backend.ExecuteServiceRequest{}, e
 	}
 
 	authReq := h.parseExecuteMessage(req)
 	if err := h.verifier.Verify(ctx, authReq); err != nil {
 		e := errors.New(errors.ErrFailedAADVerification, err)
 		h.logger.Debug(ctx, "failed to verify AAD", log.MapFields{
-			"call_type": "ExecuteServiceFailure",
+			"call_type": callType,
 			"session":   session,
 			"err":       e,
 		})
-		return nil, e
+		return backend.ExecuteServiceRequest{}, e
 	}
 
-	// a context from an http request is cancelled after the response to the request is returned,
-	// so a new context is needed to handle the asynchronous request
-	id, err := h.client.ExecuteServiceAsync(context.Background(), backend.ExecuteServiceRequest{
+	return backend.ExecuteServiceRequest{
 		AAD:        aad,
 		Address:    req.Address,
 		Data:       req.Data,
 		SessionKey: session,
-	})
+		Timeout:    time.Duration(req.TimeoutMs) * time.Millisecond,
+	}, nil
+}
+
+// ExecuteService handles the execution of deployed services
+func (h ServiceHandler) ExecuteService(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*ExecuteServiceRequest)
+
+	executeReq, err := h.makeExecuteRequest(ctx, "ExecuteServiceFailure", req)
+	if err != nil {
+		return nil, err
+	}
+
+	executeReq.IdempotencyKey, err = h.getIdempotencyKey(ctx, "ExecuteServiceFailure")
+	if err != nil {
+		return nil, err
+	}
+
+	// a context from an http request is cancelled after the response to the request is returned,
+	// so a new context is needed to handle the asynchronous request
+	id, err := h.client.ExecuteServiceAsync(context.Background(), executeReq)
 	if err != nil {
 		h.logger.Debug(ctx, "failed to start request", log.MapFields{
 			"call_type": "ExecuteServiceFailure",
@@ -169,7 +314,110 @@ func (h ServiceHandler) ExecuteService(ctx context.Context, v interface{}) (inte
 	return AsyncResponse{ID: id}, nil
 }
 
-func (h ServiceHandler) mapEvent(event backend.Event) Event {
+// ExecuteServiceBatch handles the execution of multiple deployed services
+// in a single request
+func (h ServiceHandler) ExecuteServiceBatch(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*ExecuteServiceBatchRequest)
+
+	if len(req.Calls) == 0 || len(req.Calls) > maxBatchSize {
+		e := errors.New(errors.ErrBatchSize, nil)
+		h.logger.Debug(ctx, "received batch with invalid size", log.MapFields{
+			"call_type": "ExecuteServiceBatchFailure",
+			"session":   session,
+			"size":      len(req.Calls),
+		}, e)
+		return nil, e
+	}
+
+	items := make([]backend.ExecuteServiceBatchItem, 0, len(req.Calls))
+	for _, call := range req.Calls {
+		executeReq, err := h.makeExecuteRequest(ctx, "ExecuteServiceBatchFailure", &ExecuteServiceRequest{
+			Address:   call.Address,
+			Data:      call.Data,
+			TimeoutMs: req.TimeoutMs,
+		})
+		if err != nil && req.AtomicAuth {
+			return nil, err
+		}
+
+		items = append(items, backend.ExecuteServiceBatchItem{Request: executeReq, Err: err})
+	}
+
+	// a context from an http request is cancelled after the response to the request is returned,
+	// so a new context is needed to handle the asynchronous requests
+	ids, err := h.client.ExecuteServiceBatchAsync(context.Background(), backend.ExecuteServiceBatchRequest{
+		Items:      items,
+		SessionKey: session,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "failed to start requests", log.MapFields{
+			"call_type": "ExecuteServiceBatchFailure",
+			"session":   session,
+		}, err)
+		return nil, err
+	}
+
+	return ExecuteServiceBatchResponse{IDs: ids}, nil
+}
+
+// ExecuteServiceSync handles the execution of deployed services waiting
+// for the outcome of the execution
+func (h ServiceHandler) ExecuteServiceSync(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*ExecuteServiceSyncRequest)
+
+	executeReq, err := h.makeExecuteRequest(ctx, "ExecuteServiceSyncFailure", &ExecuteServiceRequest{
+		Address:   req.Address,
+		Data:      req.Data,
+		TimeoutMs: req.TimeoutMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.syncWait(req.WaitMs))
+	defer cancel()
+
+	res, err := h.client.ExecuteServiceSync(ctx, executeReq)
+	if err != nil {
+		h.logger.Debug(ctx, "failed to start request", log.MapFields{
+			"call_type": "ExecuteServiceSyncFailure",
+			"address":   req.Address,
+			"session":   session,
+		}, err)
+		return nil, err
+	}
+
+	return h.mapSyncResponse(res), nil
+}
+
+// syncWait returns the time a synchronous request should wait for
+// the outcome of the request
+func (h ServiceHandler) syncWait(waitMs uint64) time.Duration {
+	wait := time.Duration(waitMs) * time.Millisecond
+	if waitMs == 0 {
+		wait = defaultSyncWait
+	}
+
+	if wait > h.maxWait {
+		return h.maxWait
+	}
+
+	return wait
+}
+
+func (h ServiceHandler) mapSyncResponse(res backend.SyncResponse) SyncResponse {
+	if res.Event == nil {
+		return SyncResponse{ID: res.ID}
+	}
+
+	return SyncResponse{ID: res.ID, Event: MapEvent(res.Event)}
+}
+
+// MapEvent maps an event generated by the backend to the event
+// exposed through the API
+func MapEvent(event backend.Event) Event {
 	switch r := event.(type) {
 	case backend.ErrorEvent:
 		return ErrorEvent{
@@ -177,21 +425,47 @@ func (h ServiceHandler) mapEvent(event backend.Event) Event {
 			Cause: r.Cause,
 		}
 	case backend.ExecuteServiceResponse:
-		return ExecuteServiceEvent{
-			ID:      r.ID,
-			Address: r.Address,
-			Output:  r.Output,
-		}
+		return mapExecuteEvent(r)
 	case backend.DeployServiceResponse:
-		return DeployServiceEvent{
-			ID:      r.ID,
-			Address: r.Address,
+		return mapDeployEvent(r)
+	case backend.LateResultEvent:
+		ev := LateResultEvent{ID: r.ID, RequestID: r.RequestID}
+		if r.Execute != nil {
+			execute := mapExecuteEvent(*r.Execute)
+			ev.Execute = &execute
 		}
+		if r.Deploy != nil {
+			deploy := mapDeployEvent(*r.Deploy)
+			ev.Deploy = &deploy
+		}
+		return ev
 	default:
 		panic("received unexpected event type from polling service")
 	}
 }
 
+func mapExecuteEvent(r backend.ExecuteServiceResponse) ExecuteServiceEvent {
+	return ExecuteServiceEvent{
+		ID:              r.ID,
+		Address:         r.Address,
+		Output:          r.Output,
+		TransactionHash: r.TransactionHash,
+		BlockNumber:     r.BlockNumber,
+		GasUsed:         r.GasUsed,
+	}
+}
+
+func mapDeployEvent(r backend.DeployServiceResponse) DeployServiceEvent {
+	return DeployServiceEvent{
+		ID:              r.ID,
+		Address:         r.Address,
+		TransactionHash: r.TransactionHash,
+		BlockNumber:     r.BlockNumber,
+		GasUsed:         r.GasUsed,
+		CodeHash:        r.CodeHash,
+	}
+}
+
 // PollService polls the service response queue to retrieve available responses
 func (h ServiceHandler) PollService(ctx context.Context, v interface{}) (interface{}, error) {
 	session := ctx.Value(auth.Session{}).(string)
@@ -204,6 +478,7 @@ func (h ServiceHandler) PollService(ctx context.Context, v interface{}) (interfa
 		Offset:          req.Offset,
 		Count:           req.Count,
 		DiscardPrevious: req.DiscardPrevious,
+		Wait:            h.pollWait(req.WaitMs),
 		SessionKey:      session,
 	})
 	if err != nil {
@@ -212,12 +487,267 @@ func (h ServiceHandler) PollService(ctx context.Context, v interface{}) (interfa
 
 	events := make([]Event, 0, len(res.Events))
 	for _, r := range res.Events {
-		events = append(events, h.mapEvent(r))
+		events = append(events, MapEvent(r))
 	}
 
 	return PollServiceResponse{Offset: res.Offset, Events: events}, nil
 }
 
+// pollWait returns the time a poll request should wait for
+// events to be available
+func (h ServiceHandler) pollWait(waitMs uint64) time.Duration {
+	wait := time.Duration(waitMs) * time.Millisecond
+	if wait > h.maxWait {
+		return h.maxWait
+	}
+
+	return wait
+}
+
+// PollServiceStream streams the service responses to the client as
+// server-sent events as they become available
+func (h ServiceHandler) PollServiceStream(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*PollServiceRequest)
+	if req.Count == 0 {
+		req.Count = stream.PollCount
+	}
+
+	return pollServiceStream{
+		logger:  h.logger,
+		client:  h.client,
+		session: session,
+		req:     *req,
+		maxWait: h.maxWait,
+	}, nil
+}
+
+// pollServiceStream implements rpc.HttpStream and rpc.GrpcStream to
+// serve the service responses of a session as they become available
+type pollServiceStream struct {
+	logger  log.Logger
+	client  Client
+	session string
+	req     PollServiceRequest
+	maxWait time.Duration
+}
+
+// ServeStream is the implementation of rpc.HttpStream for pollServiceStream
+func (s pollServiceStream) ServeStream(res http.ResponseWriter, req *http.Request) error {
+	return stream.ServeEventStream(res, req, s.props())
+}
+
+// ServeGrpcStream is the implementation of rpc.GrpcStream for pollServiceStream
+func (s pollServiceStream) ServeGrpcStream(ctx context.Context, send func(interface{}) error) error {
+	return stream.ServeGrpcEventStream(ctx, s.props(), send)
+}
+
+// props returns the properties of the stream, which are the same
+// for the server-sent event streams and the gRPC streams
+func (s pollServiceStream) props() stream.EventStreamProps {
+	return stream.EventStreamProps{
+		Logger: s.logger,
+		Offset: s.req.Offset,
+		Poll: func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+			return s.client.PollService(ctx, backend.PollServiceRequest{
+				Offset:          offset,
+				Count:           s.req.Count,
+				DiscardPrevious: s.req.DiscardPrevious,
+				SessionKey:      s.session,
+			})
+		},
+		Map: func(ev backend.Event) interface{} {
+			return MapEvent(ev)
+		},
+		MaxDuration: s.maxWait,
+	}
+}
+
+// GetRequestStatus retrieves the status of an asynchronous request
+func (h ServiceHandler) GetRequestStatus(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*GetRequestStatusRequest)
+
+	res, err := h.client.GetRequestStatus(ctx, backend.GetRequestStatusRequest{
+		ID:         req.ID,
+		SessionKey: session,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "request failed", log.MapFields{
+			"call_type": "GetRequestStatusFailure",
+			"id":        req.ID,
+			"session":   session,
+		}, err)
+		return nil, err
+	}
+
+	return RequestStatusResponse{
+		ID:              req.ID,
+		State:           string(res.State),
+		TransactionHash: res.TransactionHash,
+	}, nil
+}
+
+// CancelService cancels an asynchronous request whose transaction has
+// not been submitted yet. The outcome of the request is an ErrorEvent
+// that can be polled as any other event
+func (h ServiceHandler) CancelService(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	req := v.(*CancelServiceRequest)
+
+	err := h.client.CancelService(ctx, backend.CancelServiceRequest{
+		ID:         req.ID,
+		SessionKey: session,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "failed to cancel request", log.MapFields{
+			"call_type": "CancelServiceFailure",
+			"id":        req.ID,
+			"session":   session,
+		}, err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// CallService runs a read-only call against a service and returns
+// its output synchronously
+func (h ServiceHandler) CallService(ctx context.Context, v interface{}) (interface{}, error) {
+	req := v.(*CallServiceRequest)
+
+	if len(req.Address) == 0 {
+		err := errors.New(errors.ErrInvalidAddress, stderr.New("address field has not been set"))
+		h.logger.Debug(ctx, "failed to start request", log.MapFields{
+			"call_type": "CallServiceFailure",
+			"address":   req.Address,
+		}, err)
+		return nil, err
+	}
+
+	// a call is verified in the same way as the execution of the
+	// service, since it is provided with the same data
+	authReq := h.parseExecuteMessage(&ExecuteServiceRequest{
+		Address: req.Address,
+		Data:    req.Data,
+	})
+	if err := h.verifier.Verify(ctx, authReq); err != nil {
+		e := errors.New(errors.ErrFailedAADVerification, err)
+		h.logger.Debug(ctx, "failed to verify AAD", log.MapFields{
+			"call_type": "CallServiceFailure",
+			"address":   req.Address,
+		}, e)
+		return nil, e
+	}
+
+	res, err := h.client.CallContract(ctx, backend.CallContractRequest{
+		Address: req.Address,
+		Data:    req.Data,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "request failed", log.MapFields{
+			"call_type": "CallServiceFailure",
+			"address":   req.Address,
+		}, err)
+		return nil, err
+	}
+
+	return CallServiceResponse{
+		Address: res.Address,
+		Output:  res.Output,
+	}, nil
+}
+
+// EstimateGas estimates the gas and fee the gateway would use for a
+// deploy or execute request with the provided data
+func (h ServiceHandler) EstimateGas(ctx context.Context, v interface{}) (interface{}, error) {
+	req := v.(*EstimateGasRequest)
+
+	// the data is verified in the same way as the data of the deploy
+	// or execute request for which the gas is estimated
+	authReq := auth.AuthRequest{API: "Deploy", Data: req.Data}
+	if len(req.Address) > 0 {
+		authReq = h.parseExecuteMessage(&ExecuteServiceRequest{
+			Address: req.Address,
+			Data:    req.Data,
+		})
+	}
+
+	if err := h.verifier.Verify(ctx, authReq); err != nil {
+		e := errors.New(errors.ErrFailedAADVerification, err)
+		h.logger.Debug(ctx, "failed to verify AAD", log.MapFields{
+			"call_type": "EstimateGasFailure",
+			"address":   req.Address,
+		}, e)
+		return nil, e
+	}
+
+	res, err := h.client.EstimateGas(ctx, backend.EstimateGasRequest{
+		Address: req.Address,
+		Data:    req.Data,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "request failed", log.MapFields{
+			"call_type": "EstimateGasFailure",
+			"address":   req.Address,
+		}, err)
+		return nil, err
+	}
+
+	return EstimateGasResponse{
+		GasLimit:             res.GasLimit,
+		GasPrice:             res.GasPrice,
+		Fee:                  res.Fee,
+		ConfidentialFallback: res.ConfidentialFallback,
+	}, nil
+}
+
+// GetReceipt retrieves the receipt of a transaction. The receipt is
+// only returned if the transaction was submitted for the caller's AAD
+func (h ServiceHandler) GetReceipt(ctx context.Context, v interface{}) (interface{}, error) {
+	req := v.(*GetReceiptRequest)
+	aad := ctx.Value(auth.AAD{}).(string)
+
+	if len(req.Hash) == 0 {
+		err := errors.New(errors.ErrInvalidTransactionHash, stderr.New("hash field has not been set"))
+		h.logger.Debug(ctx, "failed to start request", log.MapFields{
+			"call_type": "GetReceiptFailure",
+			"hash":      req.Hash,
+		}, err)
+		return nil, err
+	}
+
+	res, err := h.client.GetReceipt(ctx, backend.GetReceiptRequest{
+		Hash: req.Hash,
+		AAD:  aad,
+	})
+	if err != nil {
+		h.logger.Debug(ctx, "request failed", log.MapFields{
+			"call_type": "GetReceiptFailure",
+			"hash":      req.Hash,
+		}, err)
+		return nil, err
+	}
+
+	logs := make([]ReceiptLog, 0, len(res.Logs))
+	for _, l := range res.Logs {
+		logs = append(logs, ReceiptLog{
+			Address: l.Address,
+			Topics:  l.Topics,
+			Data:    l.Data,
+		})
+	}
+
+	return GetReceiptResponse{
+		Hash:            res.Hash,
+		Status:          res.Status,
+		GasUsed:         res.GasUsed,
+		BlockNumber:     res.BlockNumber,
+		ContractAddress: res.ContractAddress,
+		Logs:            logs,
+	}, nil
+}
+
 // GetCode retrieves the source code associated with a service.
 func (h ServiceHandler) GetCode(ctx context.Context, v interface{}) (interface{}, error) {
 	req := v.(*GetCodeRequest)
@@ -327,6 +857,7 @@ func NewServiceHandler(services Services) ServiceHandler {
 		logger:   services.Logger.ForClass("service", "handler"),
 		client:   services.Client,
 		verifier: services.Verifier,
+		maxWait:  stream.MaxWait(services.WriteTimeout),
 	}
 }
 
@@ -335,22 +866,44 @@ func NewServiceHandler(services Services) ServiceHandler {
 func BindHandler(services Services, binder rpc.HandlerBinder) {
 	handler := NewServiceHandler(services)
 
-	binder.Bind("POST", "/v0/api/service/deploy", rpc.HandlerFunc(handler.DeployService),
+	binder.Bind("POST", "/v0/api/service/deploy", rpc.Describe(rpc.HandlerFunc(handler.DeployService), AsyncResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &DeployServiceRequest{} }))
-	binder.Bind("POST", "/v0/api/service/execute", rpc.HandlerFunc(handler.ExecuteService),
+	binder.Bind("POST", "/v0/api/service/execute", rpc.Describe(rpc.HandlerFunc(handler.ExecuteService), AsyncResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &ExecuteServiceRequest{} }))
-	binder.Bind("POST", "/v0/api/service/poll", rpc.HandlerFunc(handler.PollService),
+	binder.Bind("POST", "/v0/api/service/executeBatch", rpc.Describe(rpc.HandlerFunc(handler.ExecuteServiceBatch), ExecuteServiceBatchResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &ExecuteServiceBatchRequest{} }))
+	binder.Bind("POST", "/v0/api/service/deploySync", rpc.Describe(rpc.HandlerFunc(handler.DeployServiceSync), SyncResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &DeployServiceSyncRequest{} }))
+	binder.Bind("POST", "/v0/api/service/executeSync", rpc.Describe(rpc.HandlerFunc(handler.ExecuteServiceSync), SyncResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &ExecuteServiceSyncRequest{} }))
+	binder.Bind("POST", "/v0/api/service/poll", rpc.Describe(rpc.HandlerFunc(handler.PollService), PollServiceResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &PollServiceRequest{} }))
+	binder.Bind("POST", "/v0/api/service/poll/stream", rpc.HandlerFunc(handler.PollServiceStream),
+		rpc.EntityFactoryFunc(func() interface{} { return &PollServiceRequest{} }))
+	binder.Bind("GET", "/v0/api/service/poll/stream", rpc.HandlerFunc(handler.PollServiceStream),
 		rpc.EntityFactoryFunc(func() interface{} { return &PollServiceRequest{} }))
-	binder.Bind("GET", "/v0/api/service/getCode", rpc.HandlerFunc(handler.GetCode),
+	binder.Bind("POST", "/v0/api/service/call", rpc.Describe(rpc.HandlerFunc(handler.CallService), CallServiceResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &CallServiceRequest{} }))
+	binder.Bind("POST", "/v0/api/service/estimateGas", rpc.Describe(rpc.HandlerFunc(handler.EstimateGas), EstimateGasResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &EstimateGasRequest{} }))
+	binder.Bind("POST", "/v0/api/service/status", rpc.Describe(rpc.HandlerFunc(handler.GetRequestStatus), RequestStatusResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &GetRequestStatusRequest{} }))
+	binder.Bind("POST", "/v0/api/service/cancel", rpc.HandlerFunc(handler.CancelService),
+		rpc.EntityFactoryFunc(func() interface{} { return &CancelServiceRequest{} }))
+	binder.Bind("GET", "/v0/api/service/getCode", rpc.Describe(rpc.HandlerFunc(handler.GetCode), GetCodeResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &GetCodeRequest{} }))
-	binder.Bind("GET", "/v0/api/service/getExpiry", rpc.HandlerFunc(handler.GetExpiry),
+	binder.Bind("GET", "/v0/api/service/getExpiry", rpc.Describe(rpc.HandlerFunc(handler.GetExpiry), GetExpiryResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &GetExpiryRequest{} }))
-	binder.Bind("GET", "/v0/api/service/getPublicKey", rpc.HandlerFunc(handler.GetPublicKey),
+	binder.Bind("GET", "/v0/api/service/getPublicKey", rpc.Describe(rpc.HandlerFunc(handler.GetPublicKey), GetPublicKeyResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &GetPublicKeyRequest{} }))
-	binder.Bind("POST", "/v0/api/service/getCode", rpc.HandlerFunc(handler.GetCode),
+	binder.Bind("GET", "/v0/api/service/getReceipt", rpc.Describe(rpc.HandlerFunc(handler.GetReceipt), GetReceiptResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &GetReceiptRequest{} }))
+	binder.Bind("POST", "/v0/api/service/getCode", rpc.Describe(rpc.HandlerFunc(handler.GetCode), GetCodeResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &GetCodeRequest{} }))
-	binder.Bind("POST", "/v0/api/service/getExpiry", rpc.HandlerFunc(handler.GetExpiry),
+	binder.Bind("POST", "/v0/api/service/getExpiry", rpc.Describe(rpc.HandlerFunc(handler.GetExpiry), GetExpiryResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &GetExpiryRequest{} }))
-	binder.Bind("POST", "/v0/api/service/getPublicKey", rpc.HandlerFunc(handler.GetPublicKey),
+	binder.Bind("POST", "/v0/api/service/getPublicKey", rpc.Describe(rpc.HandlerFunc(handler.GetPublicKey), GetPublicKeyResponse{}),
 		rpc.EntityFactoryFunc(func() interface{} { return &GetPublicKeyRequest{} }))
+	binder.Bind("POST", "/v0/api/service/getReceipt", rpc.Describe(rpc.HandlerFunc(handler.GetReceipt), GetReceiptResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &GetReceiptRequest{} }))
 }
@@ -4,7 +4,10 @@ import (
 	"context"
 	stderr "errors"
 	"io/ioutil"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	auth "github.com/oasislabs/oasis-gateway/auth/core"
 	insecureauth "github.com/oasislabs/oasis-gateway/auth/insecure"
@@ -60,6 +63,42 @@ func (c *MockClient) ExecuteServiceAsync(
 	return uint64(args.Int(0)), nil
 }
 
+func (c *MockClient) ExecuteServiceBatchAsync(
+	ctx context.Context,
+	req backend.ExecuteServiceBatchRequest,
+) ([]uint64, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).([]uint64), nil
+}
+
+func (c *MockClient) DeployServiceSync(
+	ctx context.Context,
+	req backend.DeployServiceRequest,
+) (backend.SyncResponse, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.SyncResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.SyncResponse), nil
+}
+
+func (c *MockClient) ExecuteServiceSync(
+	ctx context.Context,
+	req backend.ExecuteServiceRequest,
+) (backend.SyncResponse, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.SyncResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.SyncResponse), nil
+}
+
 func (c *MockClient) PollService(
 	ctx context.Context,
 	req backend.PollServiceRequest,
@@ -72,6 +111,66 @@ func (c *MockClient) PollService(
 	return args.Get(0).(backend.Events), nil
 }
 
+func (c *MockClient) GetRequestStatus(
+	ctx context.Context,
+	req backend.GetRequestStatusRequest,
+) (backend.RequestStatus, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.RequestStatus{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.RequestStatus), nil
+}
+
+func (c *MockClient) CancelService(
+	ctx context.Context,
+	req backend.CancelServiceRequest,
+) errors.Err {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(0) != nil {
+		return args.Get(0).(errors.Err)
+	}
+
+	return nil
+}
+
+func (c *MockClient) CallContract(
+	ctx context.Context,
+	req backend.CallContractRequest,
+) (backend.CallContractResponse, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.CallContractResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.CallContractResponse), nil
+}
+
+func (c *MockClient) EstimateGas(
+	ctx context.Context,
+	req backend.EstimateGasRequest,
+) (backend.EstimateGasResponse, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.EstimateGasResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.EstimateGasResponse), nil
+}
+
+func (c *MockClient) GetReceipt(
+	ctx context.Context,
+	req backend.GetReceiptRequest,
+) (backend.GetReceiptResponse, errors.Err) {
+	args := c.Mock.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.GetReceiptResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.GetReceiptResponse), nil
+}
+
 func (c *MockClient) GetCode(
 	ctx context.Context,
 	req backend.GetCodeRequest,
@@ -177,6 +276,42 @@ func TestDeployServiceOK(t *testing.T) {
 	assert.Equal(t, uint64(0), res.(AsyncResponse).ID)
 }
 
+func TestDeployServiceOKIdempotencyKey(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+	ctx = context.WithValue(ctx, rpc.IdempotencyKey{}, "key")
+
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("DeployServiceAsync",
+		mock.Anything,
+		backend.DeployServiceRequest{
+			AAD:            "aad",
+			Data:           "0x00",
+			SessionKey:     "sessionKey",
+			IdempotencyKey: "key",
+		}).Return(0, nil)
+
+	res, err := handler.DeployService(ctx, &DeployServiceRequest{Data: "0x00"})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), res.(AsyncResponse).ID)
+}
+
+func TestExecuteServiceErrIdempotencyKeyTooLong(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+	ctx = context.WithValue(ctx, rpc.IdempotencyKey{}, strings.Repeat("k", maxIdempotencyKeyLength+1))
+
+	handler := createServiceHandler()
+
+	_, err := handler.ExecuteService(ctx, &ExecuteServiceRequest{
+		Data:    "0x00",
+		Address: "0x00",
+	})
+
+	assert.Equal(t, errors.ErrIdempotencyKeyTooLong, err.(errors.Err).ErrorCode())
+}
+
 func TestExecuteServiceEmptyData(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
@@ -269,6 +404,184 @@ func TestExecuteServiceOK(t *testing.T) {
 	assert.Equal(t, uint64(0), res.(AsyncResponse).ID)
 }
 
+func TestExecuteServiceBatchErrSize(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	_, err := handler.ExecuteServiceBatch(ctx, &ExecuteServiceBatchRequest{})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrBatchSize, err.(errors.Err).ErrorCode())
+}
+
+func TestExecuteServiceBatchErrAtomicAuth(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	_, err := handler.ExecuteServiceBatch(ctx, &ExecuteServiceBatchRequest{
+		Calls: []ExecuteServiceCall{
+			{Address: "0x00", Data: "0x00"},
+			{Address: "", Data: "0x00"},
+		},
+		AtomicAuth: true,
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrInvalidAddress, err.(errors.Err).ErrorCode())
+	handler.client.(*MockClient).AssertNotCalled(t, "ExecuteServiceBatchAsync", mock.Anything, mock.Anything)
+}
+
+func TestExecuteServiceBatchOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("ExecuteServiceBatchAsync",
+		mock.Anything,
+		backend.ExecuteServiceBatchRequest{
+			Items: []backend.ExecuteServiceBatchItem{
+				{Request: backend.ExecuteServiceRequest{
+					AAD:        "aad",
+					Data:       "0x00",
+					Address:    "0x00",
+					SessionKey: "sessionKey",
+				}},
+				{Err: errors.New(errors.ErrInvalidAddress, nil)},
+			},
+			SessionKey: "sessionKey",
+		}).Return([]uint64{3, 4}, nil)
+
+	res, err := handler.ExecuteServiceBatch(ctx, &ExecuteServiceBatchRequest{
+		Calls: []ExecuteServiceCall{
+			{Address: "0x00", Data: "0x00"},
+			{Address: "", Data: "0x00"},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, ExecuteServiceBatchResponse{IDs: []uint64{3, 4}}, res)
+}
+
+func TestDeployServiceSyncOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("DeployServiceSync",
+		mock.Anything,
+		backend.DeployServiceRequest{
+			AAD:        "aad",
+			Data:       "0x00",
+			SessionKey: "sessionKey",
+		}).Return(backend.SyncResponse{
+		ID:    1,
+		Event: backend.DeployServiceResponse{ID: 1, Address: "0x00"},
+	}, nil)
+
+	res, err := handler.DeployServiceSync(ctx, &DeployServiceSyncRequest{Data: "0x00"})
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{
+		ID:    1,
+		Event: DeployServiceEvent{ID: 1, Address: "0x00"},
+	}, res)
+}
+
+func TestExecuteServiceSyncEmptyAddress(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	_, err := handler.ExecuteServiceSync(ctx, &ExecuteServiceSyncRequest{
+		Data:    "0x00",
+		Address: "",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrInvalidAddress, err.(errors.Err).ErrorCode())
+	handler.client.(*MockClient).AssertNotCalled(t, "ExecuteServiceSync", mock.Anything, mock.Anything)
+}
+
+func TestExecuteServiceSyncOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("ExecuteServiceSync",
+		mock.Anything,
+		backend.ExecuteServiceRequest{
+			AAD:        "aad",
+			Data:       "0x00",
+			Address:    "0x00",
+			SessionKey: "sessionKey",
+		}).Return(backend.SyncResponse{
+		ID:    0,
+		Event: backend.ExecuteServiceResponse{ID: 0, Address: "0x00", Output: "0x01"},
+	}, nil)
+
+	res, err := handler.ExecuteServiceSync(ctx, &ExecuteServiceSyncRequest{
+		Data:    "0x00",
+		Address: "0x00",
+		WaitMs:  1000,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{
+		ID:    0,
+		Event: ExecuteServiceEvent{ID: 0, Address: "0x00", Output: "0x01"},
+	}, res)
+}
+
+func TestExecuteServiceSyncTimeout(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("ExecuteServiceSync",
+		mock.Anything, mock.Anything).Return(backend.SyncResponse{ID: 2}, nil)
+
+	res, err := handler.ExecuteServiceSync(ctx, &ExecuteServiceSyncRequest{
+		Data:    "0x00",
+		Address: "0x00",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{ID: 2}, res)
+}
+
+func TestSyncWait(t *testing.T) {
+	handler := createServiceHandler()
+
+	assert.Equal(t, defaultSyncWait, handler.syncWait(0))
+	assert.Equal(t, 100*time.Millisecond, handler.syncWait(100))
+	assert.Equal(t, 8*time.Second, handler.syncWait(3600000))
+}
+
+func TestSyncWaitWriteTimeout(t *testing.T) {
+	handler := NewServiceHandler(Services{
+		Logger:       Logger,
+		Client:       &MockClient{},
+		WriteTimeout: 5 * time.Second,
+	})
+
+	assert.Equal(t, 4*time.Second, handler.syncWait(0))
+	assert.Equal(t, 4*time.Second, handler.syncWait(3600000))
+}
+
+func TestPollWait(t *testing.T) {
+	handler := createServiceHandler()
+
+	assert.Equal(t, time.Duration(0), handler.pollWait(0))
+	assert.Equal(t, 100*time.Millisecond, handler.pollWait(100))
+	assert.Equal(t, 8*time.Second, handler.pollWait(3600000))
+}
+
 func TestPollServiceErr(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
@@ -397,6 +710,234 @@ func TestPollServiceErrorOK(t *testing.T) {
 	}, evs.Events[0])
 }
 
+func TestPollServiceStreamOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
+
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("PollService",
+		mock.Anything,
+		backend.PollServiceRequest{
+			Offset:     3,
+			Count:      16,
+			SessionKey: "sessionKey",
+		}).Return(backend.Events{
+		Offset: 3,
+		Events: []backend.Event{backend.ExecuteServiceResponse{ID: 3, Address: "0x00", Output: "0x01"}}}, nil)
+	handler.client.(*MockClient).On("PollService",
+		mock.Anything,
+		backend.PollServiceRequest{
+			Offset:     4,
+			Count:      16,
+			SessionKey: "sessionKey",
+		}).Return(backend.Events{}, errors.New(errors.ErrInternalError, nil))
+
+	v, err := handler.PollServiceStream(ctx, &PollServiceRequest{Offset: 0})
+	assert.Nil(t, err)
+
+	req := httptest.NewRequest("POST", "/v0/api/service/poll/stream", nil)
+	req.Header.Set(rpc.HttpHeaderLastEventID, "3")
+	res := httptest.NewRecorder()
+
+	assert.Nil(t, v.(rpc.HttpStream).ServeStream(res, req))
+	assert.Equal(t, "retry: 50\n\n"+
+		"id: 4\ndata: {\"id\":3,\"address\":\"0x00\",\"output\":\"0x01\"}\n\n"+
+		"event: error\ndata: {\"errorCode\":1000,"+
+		"\"description\":\"Internal Error. Please check the status of the service.\"}\n\n",
+		res.Body.String())
+}
+
+func TestGetRequestStatusErr(t *testing.T) {
+	ctx := context.WithValue(Context, auth.Session{}, "sessionKey")
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("GetRequestStatus",
+		mock.Anything,
+		backend.GetRequestStatusRequest{ID: 1, SessionKey: "sessionKey"},
+	).Return(backend.RequestStatus{}, errors.New(errors.ErrRequestStatusNotFound, nil))
+
+	_, err := handler.GetRequestStatus(ctx, &GetRequestStatusRequest{ID: 1})
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrRequestStatusNotFound, err.(errors.Err).ErrorCode())
+}
+
+func TestGetRequestStatusOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.Session{}, "sessionKey")
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("GetRequestStatus",
+		mock.Anything,
+		backend.GetRequestStatusRequest{ID: 1, SessionKey: "sessionKey"},
+	).Return(backend.RequestStatus{
+		State:           backend.RequestSubmitted,
+		TransactionHash: "0x01",
+	}, nil)
+
+	res, err := handler.GetRequestStatus(ctx, &GetRequestStatusRequest{ID: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, RequestStatusResponse{
+		ID:              1,
+		State:           "submitted",
+		TransactionHash: "0x01",
+	}, res)
+}
+
+func TestCancelServiceErr(t *testing.T) {
+	ctx := context.WithValue(Context, auth.Session{}, "sessionKey")
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("CancelService",
+		mock.Anything,
+		backend.CancelServiceRequest{ID: 1, SessionKey: "sessionKey"},
+	).Return(errors.New(errors.ErrRequestNotCancellable, nil))
+
+	_, err := handler.CancelService(ctx, &CancelServiceRequest{ID: 1})
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrRequestNotCancellable, err.(errors.Err).ErrorCode())
+}
+
+func TestCancelServiceOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.Session{}, "sessionKey")
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("CancelService",
+		mock.Anything,
+		backend.CancelServiceRequest{ID: 1, SessionKey: "sessionKey"},
+	).Return(nil)
+
+	res, err := handler.CancelService(ctx, &CancelServiceRequest{ID: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, res)
+}
+
+func TestCallServiceEmptyAddress(t *testing.T) {
+	handler := createServiceHandler()
+
+	_, err := handler.CallService(Context, &CallServiceRequest{Data: "0x00"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrInvalidAddress, err.(errors.Err).ErrorCode())
+}
+
+func TestCallServiceOK(t *testing.T) {
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("CallContract",
+		mock.Anything,
+		backend.CallContractRequest{
+			Address: "0x00",
+			Data:    "0x01",
+		}).Return(backend.CallContractResponse{
+		Address: "0x00",
+		Output:  "0x02",
+	}, nil)
+
+	res, err := handler.CallService(Context, &CallServiceRequest{
+		Address: "0x00",
+		Data:    "0x01",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, CallServiceResponse{
+		Address: "0x00",
+		Output:  "0x02",
+	}, res)
+}
+
+func TestEstimateGasErr(t *testing.T) {
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("EstimateGas",
+		mock.Anything,
+		backend.EstimateGasRequest{Data: "0x00"},
+	).Return(backend.EstimateGasResponse{}, errors.New(errors.ErrEstimateGas, nil))
+
+	_, err := handler.EstimateGas(Context, &EstimateGasRequest{Data: "0x00"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrEstimateGas, err.(errors.Err).ErrorCode())
+}
+
+func TestEstimateGasOK(t *testing.T) {
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("EstimateGas",
+		mock.Anything,
+		backend.EstimateGasRequest{Address: "0x00", Data: "0x00"},
+	).Return(backend.EstimateGasResponse{
+		GasLimit:             15177522,
+		GasPrice:             "0x3b9aca00",
+		Fee:                  "0x35ebe037617400",
+		ConfidentialFallback: true,
+	}, nil)
+
+	res, err := handler.EstimateGas(Context, &EstimateGasRequest{Address: "0x00", Data: "0x00"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, EstimateGasResponse{
+		GasLimit:             15177522,
+		GasPrice:             "0x3b9aca00",
+		Fee:                  "0x35ebe037617400",
+		ConfidentialFallback: true,
+	}, res)
+}
+
+func TestGetReceiptEmptyHash(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	handler := createServiceHandler()
+
+	_, err := handler.GetReceipt(ctx, &GetReceiptRequest{})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrInvalidTransactionHash, err.(errors.Err).ErrorCode())
+}
+
+func TestGetReceiptNotOwned(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("GetReceipt",
+		mock.Anything,
+		backend.GetReceiptRequest{Hash: "0x01", AAD: "aad"},
+	).Return(backend.GetReceiptResponse{}, errors.New(errors.ErrTransactionNotOwned, nil))
+
+	_, err := handler.GetReceipt(ctx, &GetReceiptRequest{Hash: "0x01"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrTransactionNotOwned, err.(errors.Err).ErrorCode())
+}
+
+func TestGetReceiptOK(t *testing.T) {
+	ctx := context.WithValue(Context, auth.AAD{}, "aad")
+	handler := createServiceHandler()
+
+	handler.client.(*MockClient).On("GetReceipt",
+		mock.Anything,
+		backend.GetReceiptRequest{Hash: "0x01", AAD: "aad"},
+	).Return(backend.GetReceiptResponse{
+		Hash:        "0x01",
+		Status:      1,
+		GasUsed:     21000,
+		BlockNumber: 7,
+		Logs: []backend.ReceiptLog{
+			{Address: "0x02", Topics: []string{"0x03"}, Data: "0x04"},
+		},
+	}, nil)
+
+	res, err := handler.GetReceipt(ctx, &GetReceiptRequest{Hash: "0x01"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, GetReceiptResponse{
+		Hash:        "0x01",
+		Status:      1,
+		GasUsed:     21000,
+		BlockNumber: 7,
+		Logs: []ReceiptLog{
+			{Address: "0x02", Topics: []string{"0x03"}, Data: "0x04"},
+		},
+	}, res)
+}
+
 func TestGetCodeEmptyAddress(t *testing.T) {
 	ctx := context.WithValue(Context, auth.AAD{}, "aad")
 	ctx = context.WithValue(ctx, auth.Session{}, "sessionKey")
@@ -615,10 +1156,8 @@ func TestGetPublicKeyEmptyOK(t *testing.T) {
 }
 
 func TestMapUnknownEvent(t *testing.T) {
-	handler := createServiceHandler()
-
 	assert.Panics(t, func() {
-		handler.mapEvent(InvalidEvent{})
+		MapEvent(InvalidEvent{})
 	})
 }
 
@@ -672,7 +1211,32 @@ func TestBindHandlerOK(t *testing.T) {
 
 	assert.True(t, router.HasHandler("/v0/api/service/deploy", "POST"))
 	assert.True(t, router.HasHandler("/v0/api/service/execute", "POST"))
+	assert.True(t, router.HasHandler("/v0/api/service/executeBatch", "POST"))
 	assert.True(t, router.HasHandler("/v0/api/service/poll", "POST"))
+	assert.True(t, router.HasHandler("/v0/api/service/status", "POST"))
+	assert.True(t, router.HasHandler("/v0/api/service/call", "POST"))
+	assert.True(t, router.HasHandler("/v0/api/service/estimateGas", "POST"))
+	assert.True(t, router.HasHandler("/v0/api/service/cancel", "POST"))
 	assert.True(t, router.HasHandler("/v0/api/service/getExpiry", "GET"))
 	assert.True(t, router.HasHandler("/v0/api/service/getPublicKey", "GET"))
 }
+
+func TestCallServiceFailedVerification(t *testing.T) {
+	handler := createServiceHandler()
+
+	_, err := handler.CallService(Context, &CallServiceRequest{Address: "0x00", Data: ""})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrFailedAADVerification, err.(errors.Err).ErrorCode())
+	handler.client.(*MockClient).AssertNotCalled(t, "CallContract", mock.Anything, mock.Anything)
+}
+
+func TestEstimateGasFailedVerification(t *testing.T) {
+	handler := createServiceHandler()
+
+	_, err := handler.EstimateGas(Context, &EstimateGasRequest{Address: "0x00", Data: ""})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrFailedAADVerification, err.(errors.Err).ErrorCode())
+	handler.client.(*MockClient).AssertNotCalled(t, "EstimateGas", mock.Anything, mock.Anything)
+}
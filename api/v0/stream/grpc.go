@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"context"
+
+	"github.com/oasislabs/oasis-gateway/log"
+)
+
+// ServeGrpcEventStream sends to the client the events of a queue over a
+// gRPC server-streaming call. As with the server-sent event streams, the
+// call completes once the stream has been open for its maximum duration,
+// and the client is expected to call it again. Events may be sent in a
+// different order than their offsets, so a client calling it again needs
+// to provide the lowest offset for which it has not received an event,
+// and discard the events it receives again. If polling the queue fails
+// the error is returned so that the call is closed with it
+func ServeGrpcEventStream(ctx context.Context, props EventStreamProps, send func(interface{}) error) error {
+	ctx, cancel := context.WithTimeout(ctx, props.maxDuration())
+	defer cancel()
+
+	poller := NewPoller(props.Offset, props.Poll)
+	for {
+		evs, err := poller.Next(ctx)
+		if err != nil {
+			props.Logger.Debug(ctx, "failed to poll events for stream", log.MapFields{
+				"call_type": "GrpcEventStreamPollFailure",
+			}, err)
+			return err
+		}
+
+		if len(evs) == 0 {
+			return nil
+		}
+
+		for _, ev := range evs {
+			if err := send(props.Map(ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
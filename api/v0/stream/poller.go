@@ -0,0 +1,90 @@
+package stream
+
+import (
+	"context"
+	"time"
+
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+)
+
+const (
+	// MinPollInterval is the interval at which a queue is polled
+	// for new events after events have been found
+	MinPollInterval = 50 * time.Millisecond
+
+	// MaxPollInterval is the maximum interval at which a queue is
+	// polled when no new events are found
+	MaxPollInterval = 1 * time.Second
+
+	// PollCount is the maximum number of events retrieved from
+	// a queue on each poll
+	PollCount = 16
+)
+
+// PollFunc retrieves the events available in a queue starting
+// at the provided offset
+type PollFunc func(ctx context.Context, offset uint64) (backend.Events, errors.Err)
+
+// NextInterval returns the interval to wait before polling a queue
+// again, based on the number of events found on the last poll
+func NextInterval(interval time.Duration, found int) time.Duration {
+	if found > 0 {
+		return MinPollInterval
+	}
+
+	interval *= 2
+	if interval > MaxPollInterval {
+		return MaxPollInterval
+	}
+
+	return interval
+}
+
+// Poller polls a queue for new events and returns each event
+// only once
+type Poller struct {
+	poll     PollFunc
+	window   *Window
+	interval time.Duration
+}
+
+// NewPoller creates a new poller for the events of a queue
+// starting at the provided offset
+func NewPoller(offset uint64, poll PollFunc) *Poller {
+	return &Poller{
+		poll:     poll,
+		window:   NewWindow(offset),
+		interval: MinPollInterval,
+	}
+}
+
+// Next blocks until new events are available in the queue and
+// returns them. If the context is done before any event is
+// available, no events are returned
+func (p *Poller) Next(ctx context.Context) ([]backend.Event, errors.Err) {
+	for {
+		evs, err := p.poll(ctx, p.window.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		var events []backend.Event
+		for _, ev := range evs.Events {
+			if p.window.Deliver(ev.EventID()) {
+				events = append(events, ev)
+			}
+		}
+
+		p.interval = NextInterval(p.interval, len(events))
+		if len(events) > 0 {
+			return events, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-time.After(p.interval):
+		}
+	}
+}
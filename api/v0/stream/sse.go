@@ -0,0 +1,118 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// defaultMaxWait is the maximum time a handler waits before writing
+// its response when the http server has no write timeout
+const defaultMaxWait = 8 * time.Second
+
+// MaxWait returns the maximum time a handler can wait for events or
+// for the outcome of a request before writing its response. The wait
+// is kept below the write timeout of the http server, after which the
+// response would be cut, leaving a fifth of the timeout to write the
+// response. A write timeout of 0 means that the server has none
+func MaxWait(writeTimeout time.Duration) time.Duration {
+	if writeTimeout <= 0 {
+		return defaultMaxWait
+	}
+
+	return writeTimeout - writeTimeout/5
+}
+
+// EventStreamProps are the properties required to serve
+// an event stream
+type EventStreamProps struct {
+	// Logger used to report failures when polling
+	Logger log.Logger
+
+	// Offset from which events are streamed if the client does
+	// not provide the ID of the last event it received
+	Offset uint64
+
+	// Poll retrieves the events from the queue
+	Poll PollFunc
+
+	// Map converts the events from the queue to their API representation
+	Map func(backend.Event) interface{}
+
+	// MaxDuration is the maximum time a stream is kept open, after
+	// which clients are expected to reconnect, providing the
+	// Last-Event-ID header for a server-sent event stream or the
+	// lowest offset for which they have not received an event for a
+	// gRPC stream. If not set, the stream is kept open for the time
+	// returned by MaxWait for a server without write timeout
+	MaxDuration time.Duration
+}
+
+// maxDuration returns the maximum time a stream is kept open
+func (p EventStreamProps) maxDuration() time.Duration {
+	if p.MaxDuration == 0 {
+		return MaxWait(0)
+	}
+
+	return p.MaxDuration
+}
+
+// ServeEventStream streams to the client the events of a queue as
+// server-sent events. Events may be set in a queue in a different
+// order than their offsets, so the ID of each message is not the
+// offset of the event but the lowest offset that has not been sent
+// yet. A client reconnecting with the Last-Event-ID header receives
+// the events from that offset, which may include some events it
+// already received and that it can tell apart by their ID.
+// If polling the queue fails, an error message is sent and the
+// stream is closed
+func ServeEventStream(res http.ResponseWriter, req *http.Request, props EventStreamProps) error {
+	offset := props.Offset
+	if id, ok := rpc.ParseLastEventID(req); ok {
+		offset = id
+	}
+
+	w, err := rpc.NewHttpEventStreamWriter(res)
+	if err != nil {
+		return err
+	}
+
+	if err := w.WriteRetry(uint64(MinPollInterval / time.Millisecond)); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), props.maxDuration())
+	defer cancel()
+
+	poller := NewPoller(offset, props.Poll)
+	sent := NewWindow(offset)
+	for {
+		evs, err := poller.Next(ctx)
+		if err != nil {
+			props.Logger.Debug(ctx, "failed to poll events for stream", log.MapFields{
+				"call_type": "EventStreamPollFailure",
+				"path":      req.URL.EscapedPath(),
+			}, err)
+
+			return w.WriteError(rpc.Error{
+				ErrorCode:   err.ErrorCode().Code(),
+				Description: err.ErrorCode().Desc(),
+			})
+		}
+
+		if len(evs) == 0 {
+			return nil
+		}
+
+		for _, ev := range evs {
+			sent.Deliver(ev.EventID())
+			if err := w.WriteEvent(sent.Offset(), props.Map(ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package stream
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/stretchr/testify/assert"
+)
+
+var Logger = log.NewLogrus(log.LogrusLoggerProperties{
+	Output: ioutil.Discard,
+})
+
+func TestServeEventStreamLastEventID(t *testing.T) {
+	var offsets []uint64
+	poll := func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+		offsets = append(offsets, offset)
+		if len(offsets) > 1 {
+			return backend.Events{}, errors.New(errors.ErrQueueDiscard, nil)
+		}
+
+		return backend.Events{
+			Offset: offset,
+			Events: []backend.Event{
+				backend.DataEvent{ID: 5, Data: "0x00"},
+				backend.DataEvent{ID: 4, Data: "0x01"},
+			},
+		}, nil
+	}
+
+	req := httptest.NewRequest("POST", "/stream", nil)
+	req.Header.Set("Last-Event-ID", "4")
+	res := httptest.NewRecorder()
+
+	err := ServeEventStream(res, req, EventStreamProps{
+		Logger: Logger,
+		Offset: 0,
+		Poll:   poll,
+		Map:    func(ev backend.Event) interface{} { return ev },
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{4, 6}, offsets)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "text/event-stream", res.Header().Get("Content-Type"))
+	assert.Equal(t, "retry: 50\n\n"+
+		"id: 4\ndata: {\"ID\":5,\"Data\":\"0x00\",\"Topics\":null,\"Type\":\"\",\"BlockNumber\":0,"+
+		"\"BlockHash\":\"\",\"TransactionHash\":\"\",\"TransactionIndex\":0,\"LogIndex\":0,\"Address\":\"\","+
+		"\"Event\":\"\",\"Args\":null}\n\n"+
+		"id: 6\ndata: {\"ID\":4,\"Data\":\"0x01\",\"Topics\":null,\"Type\":\"\",\"BlockNumber\":0,"+
+		"\"BlockHash\":\"\",\"TransactionHash\":\"\",\"TransactionIndex\":0,\"LogIndex\":0,\"Address\":\"\","+
+		"\"Event\":\"\",\"Args\":null}\n\n"+
+		"event: error\ndata: {\"errorCode\":1030,"+
+		"\"description\":\"Internal Error. Please check the status of the service.\"}\n\n",
+		res.Body.String())
+}
+
+func TestServeEventStreamReconnectOutOfOrder(t *testing.T) {
+	// the event at offset 0 is set in the queue after the event at
+	// offset 1, and the client reconnects in between
+	set := map[uint64]bool{1: true}
+	polls := 0
+	poll := func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+		// the stream is closed on the second poll
+		polls++
+		if polls%2 == 0 {
+			return backend.Events{}, errors.New(errors.ErrQueueDiscard, nil)
+		}
+
+		var evs []backend.Event
+		for id := offset; id < 2; id++ {
+			if set[id] {
+				evs = append(evs, backend.ErrorEvent{ID: id})
+			}
+		}
+
+		return backend.Events{Offset: offset, Events: evs}, nil
+	}
+	stream := func(lastEventID string) string {
+		req := httptest.NewRequest("POST", "/stream", nil)
+		if len(lastEventID) > 0 {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		res := httptest.NewRecorder()
+
+		assert.Nil(t, ServeEventStream(res, req, EventStreamProps{
+			Logger: Logger,
+			Offset: 0,
+			Poll:   poll,
+			Map:    func(ev backend.Event) interface{} { return ev.EventID() },
+		}))
+		return res.Body.String()
+	}
+
+	errorMessage := "event: error\ndata: {\"errorCode\":1030," +
+		"\"description\":\"Internal Error. Please check the status of the service.\"}\n\n"
+	assert.Equal(t, "retry: 50\n\nid: 0\ndata: 1\n\n"+errorMessage, stream(""))
+
+	set[0] = true
+	assert.Equal(t, "retry: 50\n\nid: 1\ndata: 0\n\nid: 2\ndata: 1\n\n"+errorMessage, stream("0"))
+}
+
+func TestServeEventStreamContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	poll := func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+		cancel()
+		return backend.Events{Offset: offset}, nil
+	}
+
+	req := httptest.NewRequest("POST", "/stream", nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+
+	err := ServeEventStream(res, req, EventStreamProps{
+		Logger: Logger,
+		Offset: 0,
+		Poll:   poll,
+		Map:    func(ev backend.Event) interface{} { return ev },
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "retry: 50\n\n", res.Body.String())
+}
+
+func TestServeGrpcEventStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var offsets []uint64
+	poll := func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+		offsets = append(offsets, offset)
+		if len(offsets) > 1 {
+			cancel()
+			return backend.Events{Offset: offset}, nil
+		}
+
+		return backend.Events{
+			Offset: offset,
+			Events: []backend.Event{
+				backend.DataEvent{ID: 3, Data: "0x00"},
+				backend.DataEvent{ID: 4, Data: "0x01"},
+			},
+		}, nil
+	}
+
+	var sent []interface{}
+	err := ServeGrpcEventStream(ctx, EventStreamProps{
+		Logger: Logger,
+		Offset: 3,
+		Poll:   poll,
+		Map:    func(ev backend.Event) interface{} { return ev.EventID() },
+	}, func(v interface{}) error {
+		sent = append(sent, v)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{3, 5}, offsets)
+	assert.Equal(t, []interface{}{uint64(3), uint64(4)}, sent)
+}
+
+func TestServeGrpcEventStreamPollErr(t *testing.T) {
+	poll := func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+		return backend.Events{}, errors.New(errors.ErrQueueDiscard, nil)
+	}
+
+	err := ServeGrpcEventStream(context.Background(), EventStreamProps{
+		Logger: Logger,
+		Poll:   poll,
+		Map:    func(ev backend.Event) interface{} { return ev },
+	}, func(v interface{}) error {
+		return nil
+	})
+
+	assert.Equal(t, errors.ErrQueueDiscard, err.(errors.Err).ErrorCode())
+}
+
+func TestServeGrpcEventStreamMaxDuration(t *testing.T) {
+	poll := func(ctx context.Context, offset uint64) (backend.Events, errors.Err) {
+		return backend.Events{Offset: offset}, nil
+	}
+
+	err := ServeGrpcEventStream(context.Background(), EventStreamProps{
+		Logger:      Logger,
+		Poll:        poll,
+		Map:         func(ev backend.Event) interface{} { return ev },
+		MaxDuration: 10 * time.Millisecond,
+	}, func(v interface{}) error {
+		return nil
+	})
+
+	// the call completes once the stream has been open for its
+	// maximum duration, so that the client calls it again
+	assert.Nil(t, err)
+}
+
+func TestMaxWait(t *testing.T) {
+	assert.Equal(t, 8*time.Second, MaxWait(0))
+	assert.Equal(t, 8*time.Second, MaxWait(10*time.Second))
+	assert.Equal(t, 800*time.Millisecond, MaxWait(time.Second))
+}
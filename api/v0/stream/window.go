@@ -0,0 +1,49 @@
+package stream
+
+// Window keeps track of the events of a queue that have already
+// been delivered to the client. Events may be set in a queue in a
+// different order than their offsets were reserved, so the events
+// delivered ahead of the lowest pending offset need to be tracked
+type Window struct {
+	// offset is the lowest offset in the queue that has not been
+	// delivered to the client yet
+	offset uint64
+
+	// delivered is the set of offsets greater than offset that have
+	// already been delivered to the client
+	delivered map[uint64]struct{}
+}
+
+// NewWindow creates a new window for a queue from which events
+// are delivered starting at the provided offset
+func NewWindow(offset uint64) *Window {
+	return &Window{offset: offset, delivered: make(map[uint64]struct{})}
+}
+
+// Offset returns the lowest offset of the queue that has not
+// been delivered yet
+func (w *Window) Offset() uint64 {
+	return w.offset
+}
+
+// Deliver marks the offset as delivered. It returns false if the
+// offset had already been delivered before
+func (w *Window) Deliver(offset uint64) bool {
+	if offset < w.offset {
+		return false
+	}
+
+	if _, ok := w.delivered[offset]; ok {
+		return false
+	}
+
+	w.delivered[offset] = struct{}{}
+	for {
+		if _, ok := w.delivered[w.offset]; !ok {
+			return true
+		}
+
+		delete(w.delivered, w.offset)
+		w.offset++
+	}
+}
@@ -0,0 +1,25 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowDeliver(t *testing.T) {
+	w := NewWindow(2)
+
+	assert.False(t, w.Deliver(1))
+	assert.True(t, w.Deliver(3))
+	assert.Equal(t, uint64(2), w.Offset())
+	assert.False(t, w.Deliver(3))
+	assert.True(t, w.Deliver(2))
+	assert.Equal(t, uint64(4), w.Offset())
+	assert.Equal(t, 0, len(w.delivered))
+}
+
+func TestNextInterval(t *testing.T) {
+	assert.Equal(t, MinPollInterval, NextInterval(MaxPollInterval, 1))
+	assert.Equal(t, 2*MinPollInterval, NextInterval(MinPollInterval, 0))
+	assert.Equal(t, MaxPollInterval, NextInterval(MaxPollInterval, 0))
+}
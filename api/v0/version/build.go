@@ -0,0 +1,18 @@
+package version
+
+// The build metadata of the gateway is injected at build time
+// through the linker, for example
+//
+//	go build -ldflags "-X github.com/oasislabs/oasis-gateway/api/v0/version.Version=1.0.0"
+//
+// The Makefile sets all of them on build-gateway
+var (
+	// Version is the semantic version of the gateway
+	Version = "dev"
+
+	// GitCommit is the git commit from which the gateway was built
+	GitCommit = "unknown"
+
+	// BuildTime is the time at which the gateway was built in RFC3339
+	BuildTime = "unknown"
+)
@@ -1,10 +1,31 @@
 package version
 
-// GetVersionRequest is a request to retrieve the health
-// status of the component.
+// GetVersionRequest is a request to retrieve the version
+// of the component.
 type GetVersionRequest struct{}
 
-// GetVersionResponse is the response to the health request
+// GetVersionResponse is the response to the version request with
+// the build metadata and the providers the component runs with
 type GetVersionResponse struct {
-	Version int `json:"version"`
+	// Version is the semantic version of the component
+	Version string `json:"version"`
+
+	// GitCommit is the git commit from which the component was built
+	GitCommit string `json:"gitCommit"`
+
+	// BuildTime is the time at which the component was built
+	BuildTime string `json:"buildTime"`
+
+	// GoVersion is the version of Go the component was built with
+	GoVersion string `json:"goVersion"`
+
+	// BackendProvider is the backend the component is configured with
+	BackendProvider string `json:"backendProvider"`
+
+	// MailboxProvider is the mailbox the component is configured with
+	MailboxProvider string `json:"mailboxProvider"`
+
+	// AuthProviders are the authentication providers the component
+	// is configured with
+	AuthProviders []string `json:"authProviders"`
 }
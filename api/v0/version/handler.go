@@ -2,33 +2,58 @@ package version
 
 import (
 	"context"
+	"runtime"
 
 	"github.com/oasislabs/oasis-gateway/rpc"
 )
 
 // Deps are the dependencies expected by the VersionHandler
-type Deps struct{}
+type Deps struct {
+	// BackendProvider is the backend the component is configured with
+	BackendProvider string
+
+	// MailboxProvider is the mailbox the component is configured with
+	MailboxProvider string
+
+	// AuthProviders are the authentication providers the component
+	// is configured with
+	AuthProviders []string
+}
 
 // Handler is the handler to satisfy version related requests
-type Handler struct{}
+type Handler struct {
+	response GetVersionResponse
+}
 
 // NewHandler creates a new instance of a version handler
-func NewHandler() Handler {
-	return Handler{}
+func NewHandler(deps *Deps) Handler {
+	authProviders := make([]string, len(deps.AuthProviders))
+	copy(authProviders, deps.AuthProviders)
+
+	return Handler{
+		response: GetVersionResponse{
+			Version:         Version,
+			GitCommit:       GitCommit,
+			BuildTime:       BuildTime,
+			GoVersion:       runtime.Version(),
+			BackendProvider: deps.BackendProvider,
+			MailboxProvider: deps.MailboxProvider,
+			AuthProviders:   authProviders,
+		},
+	}
 }
 
 // GetVersion returns the version of the component
 func (h Handler) GetVersion(ctx context.Context, v interface{}) (interface{}, error) {
 	_ = v.(*GetVersionRequest)
-	return &GetVersionResponse{
-		Version: 0,
-	}, nil
+	res := h.response
+	return &res, nil
 }
 
 // BindHandler binds the version handler to the handler binder
 func BindHandler(deps *Deps, binder rpc.HandlerBinder) {
-	handler := NewHandler()
+	handler := NewHandler(deps)
 
-	binder.Bind("GET", "/v0/api/version", rpc.HandlerFunc(handler.GetVersion),
-		rpc.EntityFactoryFunc(func() interface{} { return nil }))
+	binder.Bind("GET", "/v0/api/version", rpc.Describe(rpc.HandlerFunc(handler.GetVersion), GetVersionResponse{}),
+		rpc.EntityFactoryFunc(func() interface{} { return &GetVersionRequest{} }))
 }
@@ -2,18 +2,29 @@ package version
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestGetVersion(t *testing.T) {
-	h := NewHandler()
+	h := NewHandler(&Deps{
+		BackendProvider: "ethereum",
+		MailboxProvider: "mem",
+		AuthProviders:   []string{"insecure"},
+	})
 
 	res, err := h.GetVersion(context.TODO(), &GetVersionRequest{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, &GetVersionResponse{
-		Version: 0,
+		Version:         "dev",
+		GitCommit:       "unknown",
+		BuildTime:       "unknown",
+		GoVersion:       runtime.Version(),
+		BackendProvider: "ethereum",
+		MailboxProvider: "mem",
+		AuthProviders:   []string{"insecure"},
 	}, res)
 }
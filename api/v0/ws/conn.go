@@ -0,0 +1,340 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/oasislabs/oasis-gateway/api/v0/event"
+	"github.com/oasislabs/oasis-gateway/api/v0/service"
+	"github.com/oasislabs/oasis-gateway/api/v0/stream"
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// writeTimeout is the maximum time to write a message to the client
+	writeTimeout = 10 * time.Second
+
+	// maxPayloadBytes is the maximum size of a request sent by the client
+	maxPayloadBytes = 1 << 12
+)
+
+// incoming is a request received from the client, or the
+// error found when decoding it
+type incoming struct {
+	req Request
+	err errors.Err
+}
+
+// ConnectionProps are the properties required to create
+// a new Connection
+type ConnectionProps struct {
+	Logger     log.Logger
+	Client     Client
+	SessionKey string
+
+	// AllowedOrigins are the origins other than the one of the
+	// server from which a browser can open the connection
+	AllowedOrigins []string
+}
+
+// Connection is a websocket connection with a client. The connection
+// pushes to the client the events available in the queues the client
+// watches, until the client closes the connection
+type Connection struct {
+	logger  log.Logger
+	client  Client
+	session string
+	origins []string
+	service *stream.Window
+	events  map[uint64]*stream.Window
+}
+
+// NewConnection creates a new connection for the provided session
+func NewConnection(props ConnectionProps) *Connection {
+	return &Connection{
+		logger:  props.Logger,
+		client:  props.Client,
+		session: props.SessionKey,
+		origins: props.AllowedOrigins,
+		events:  make(map[uint64]*stream.Window),
+	}
+}
+
+// ServeStream is the implementation of rpc.HttpStream for Connection
+func (c *Connection) ServeStream(res http.ResponseWriter, req *http.Request) error {
+	server := websocket.Server{Handler: c.serve, Handshake: c.handshake}
+	server.ServeHTTP(res, req)
+	return nil
+}
+
+// handshake rejects the connections opened by a browser from an origin
+// that is not allowed, so that a page from another site cannot use the
+// credentials of the browser to open a connection. Clients other than
+// browsers do not send an Origin header and are always accepted
+func (c *Connection) handshake(config *websocket.Config, req *http.Request) error {
+	origin := req.Header.Get("Origin")
+	if len(origin) == 0 {
+		return nil
+	}
+
+	u, err := url.ParseRequestURI(origin)
+	if err != nil {
+		return err
+	}
+
+	config.Origin = u
+	if u.Host == req.Host {
+		return nil
+	}
+
+	for _, allowed := range c.origins {
+		if allowed == "*" || allowed == origin {
+			return nil
+		}
+	}
+
+	c.logger.Debug(req.Context(), "rejected connection from origin", log.MapFields{
+		"call_type": "WebSocketHandshakeFailure",
+		"session":   c.session,
+		"origin":    origin,
+	})
+	return fmt.Errorf("origin %s is not allowed", origin)
+}
+
+func (c *Connection) serve(conn *websocket.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	ctx := conn.Request().Context()
+	conn.MaxPayloadBytes = maxPayloadBytes
+
+	// the deadlines set by the http server are meant for a single
+	// request and response, not for a long lived connection
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		c.logger.Debug(ctx, "failed to reset connection deadline", log.MapFields{
+			"call_type": "WebSocketServeFailure",
+			"session":   c.session,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	in := make(chan incoming)
+	go c.receive(conn, in, done)
+
+	interval := stream.MinPollInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-in:
+			if !ok {
+				return
+			}
+
+			err := msg.err
+			if err == nil {
+				err = c.handleRequest(ctx, msg.req)
+			}
+			if err != nil {
+				if err := c.sendError(ctx, conn, msg.req.Type, err); err != nil {
+					return
+				}
+				continue
+			}
+
+			interval = stream.MinPollInterval
+			if _, err := c.push(ctx, conn); err != nil {
+				return
+			}
+		case <-time.After(interval):
+			n, err := c.push(ctx, conn)
+			if err != nil {
+				return
+			}
+
+			interval = stream.NextInterval(interval, n)
+		}
+	}
+}
+
+// receive reads the requests sent by the client and passes them on
+// to the serving loop until the connection is closed
+func (c *Connection) receive(conn *websocket.Conn, in chan<- incoming, done <-chan struct{}) {
+	defer close(in)
+
+	for {
+		var p []byte
+		if err := websocket.Message.Receive(conn, &p); err != nil {
+			return
+		}
+
+		var msg incoming
+		if err := json.Unmarshal(p, &msg.req); err != nil {
+			msg.err = errors.New(errors.ErrDeserializeJSON, err)
+		}
+
+		select {
+		case in <- msg:
+		case <-done:
+			return
+		}
+	}
+}
+
+func (c *Connection) handleRequest(ctx context.Context, req Request) errors.Err {
+	switch req.Type {
+	case WatchService:
+		c.service = stream.NewWindow(req.Offset)
+		return nil
+	case WatchEvent:
+		c.events[req.ID] = stream.NewWindow(req.Offset)
+		return nil
+	case AckService:
+		return c.client.DiscardService(ctx, backend.DiscardServiceRequest{
+			Offset:     req.Offset,
+			SessionKey: c.session,
+		})
+	case AckEvent:
+		return c.client.DiscardEvent(ctx, backend.DiscardEventRequest{
+			Offset:     req.Offset,
+			ID:         req.ID,
+			SessionKey: c.session,
+		})
+	default:
+		return errors.New(errors.ErrUnknownMessageType,
+			fmt.Errorf("unknown request type %s", req.Type))
+	}
+}
+
+// push polls the watched queues and pushes to the client the events
+// that have not been pushed yet. It returns the number of events pushed
+// and an error only if the connection with the client failed
+func (c *Connection) push(ctx context.Context, conn *websocket.Conn) (int, error) {
+	count := 0
+
+	if c.service != nil {
+		n, err := c.pushService(ctx, conn)
+		count += n
+		if err != nil {
+			return count, err
+		}
+	}
+
+	for id := range c.events {
+		n, err := c.pushEvent(ctx, conn, id)
+		count += n
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+func (c *Connection) pushService(ctx context.Context, conn *websocket.Conn) (int, error) {
+	evs, err := c.client.PollService(ctx, backend.PollServiceRequest{
+		Offset:     c.service.Offset(),
+		Count:      stream.PollCount,
+		SessionKey: c.session,
+	})
+	if err != nil {
+		// stop watching the queue so that the client is not
+		// flooded with the same error on every poll
+		c.service = nil
+		return 0, c.sendError(ctx, conn, WatchService, err)
+	}
+
+	count := 0
+	for _, ev := range evs.Events {
+		if !c.service.Deliver(ev.EventID()) {
+			continue
+		}
+
+		if err := c.send(ctx, conn, ServiceMessage{
+			Type:  ServiceMessageType,
+			Event: service.MapEvent(ev),
+		}); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func (c *Connection) pushEvent(ctx context.Context, conn *websocket.Conn, id uint64) (int, error) {
+	w := c.events[id]
+	evs, err := c.client.PollEvent(ctx, backend.PollEventRequest{
+		Offset:     w.Offset(),
+		Count:      stream.PollCount,
+		ID:         id,
+		SessionKey: c.session,
+	})
+	if err != nil {
+		delete(c.events, id)
+		return 0, c.sendError(ctx, conn, WatchEvent, err)
+	}
+
+	count := 0
+	for _, ev := range evs.Events {
+		if !w.Deliver(ev.EventID()) {
+			continue
+		}
+
+		if err := c.send(ctx, conn, EventMessage{
+			Type:  EventMessageType,
+			ID:    id,
+			Event: event.MapEvent(ev),
+		}); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func (c *Connection) sendError(ctx context.Context, conn *websocket.Conn, reqType RequestType, err errors.Err) error {
+	c.logger.Debug(ctx, "failed to handle request", log.MapFields{
+		"call_type": "WebSocketRequestFailure",
+		"session":   c.session,
+		"request":   string(reqType),
+	}, err)
+
+	return c.send(ctx, conn, ErrorMessage{
+		Type:    ErrorMessageType,
+		Request: reqType,
+		Cause: rpc.Error{
+			ErrorCode:   err.ErrorCode().Code(),
+			Description: err.ErrorCode().Desc(),
+		},
+	})
+}
+
+func (c *Connection) send(ctx context.Context, conn *websocket.Conn, v interface{}) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+
+	if err := websocket.JSON.Send(conn, v); err != nil {
+		c.logger.Debug(ctx, "failed to send message", log.MapFields{
+			"call_type": "WebSocketSendFailure",
+			"session":   c.session,
+			"err":       err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
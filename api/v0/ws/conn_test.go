@@ -0,0 +1,214 @@
+package ws
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oasislabs/oasis-gateway/api/v0/stream"
+	auth "github.com/oasislabs/oasis-gateway/auth/core"
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/websocket"
+)
+
+var Context = context.TODO()
+
+var Logger = log.NewLogrus(log.LogrusLoggerProperties{
+	Output: ioutil.Discard,
+})
+
+type MockClient struct {
+	mock.Mock
+}
+
+func (c *MockClient) PollService(
+	ctx context.Context,
+	req backend.PollServiceRequest,
+) (backend.Events, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.Events{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.Events), nil
+}
+
+func (c *MockClient) DiscardService(
+	ctx context.Context,
+	req backend.DiscardServiceRequest,
+) errors.Err {
+	args := c.Called(ctx, req)
+	if args.Get(0) != nil {
+		return args.Get(0).(errors.Err)
+	}
+
+	return nil
+}
+
+func (c *MockClient) PollEvent(
+	ctx context.Context,
+	req backend.PollEventRequest,
+) (backend.Events, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return backend.Events{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(backend.Events), nil
+}
+
+func (c *MockClient) DiscardEvent(
+	ctx context.Context,
+	req backend.DiscardEventRequest,
+) errors.Err {
+	args := c.Called(ctx, req)
+	if args.Get(0) != nil {
+		return args.Get(0).(errors.Err)
+	}
+
+	return nil
+}
+
+func dial(t *testing.T, client Client) (*websocket.Conn, func()) {
+	server := serve(t, client, nil)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	conn, err := websocket.Dial(url, "", server.URL)
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	assert.Nil(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	return conn, func() {
+		_ = conn.Close()
+		server.Close()
+	}
+}
+
+func serve(t *testing.T, client Client, origins []string) *httptest.Server {
+	handler := NewWebSocketHandler(Services{Logger: Logger, Client: client, AllowedOrigins: origins})
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx := context.WithValue(req.Context(), auth.Session{}, "session")
+		v, err := handler.Connect(ctx, &ConnectRequest{})
+		assert.Nil(t, err)
+		assert.Nil(t, v.(*Connection).ServeStream(res, req))
+	}))
+}
+
+func TestConnectionWatchService(t *testing.T) {
+	client := &MockClient{}
+	client.On("PollService", mock.Anything, backend.PollServiceRequest{
+		Offset:     0,
+		Count:      stream.PollCount,
+		SessionKey: "session",
+	}).Return(backend.Events{
+		Offset: 0,
+		Events: []backend.Event{
+			backend.ExecuteServiceResponse{ID: 0, Address: "0x00", Output: "0x01"},
+		},
+	}, nil)
+	client.On("PollService", mock.Anything, mock.Anything).
+		Return(backend.Events{Offset: 1}, nil)
+
+	conn, close := dial(t, client)
+	defer close()
+
+	assert.Nil(t, websocket.JSON.Send(conn, Request{Type: WatchService, Offset: 0}))
+
+	var msg string
+	assert.Nil(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, "{\"type\":\"service\",\"event\":{\"id\":0,\"address\":\"0x00\",\"output\":\"0x01\"}}", msg)
+}
+
+func TestConnectionWatchEvent(t *testing.T) {
+	client := &MockClient{}
+	client.On("PollEvent", mock.Anything, backend.PollEventRequest{
+		Offset:     0,
+		Count:      stream.PollCount,
+		ID:         1,
+		SessionKey: "session",
+	}).Return(backend.Events{
+		Offset: 0,
+		Events: []backend.Event{
+			backend.DataEvent{ID: 0, Data: "0x00", Topics: []string{"0x01"}},
+		},
+	}, nil).Once()
+	client.On("PollEvent", mock.Anything, mock.Anything).
+		Return(backend.Events{Offset: 1}, nil)
+
+	conn, close := dial(t, client)
+	defer close()
+
+	assert.Nil(t, websocket.JSON.Send(conn, Request{Type: WatchEvent, ID: 1}))
+
+	var msg string
+	assert.Nil(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, "{\"type\":\"event\",\"id\":1,\"event\":{\"id\":0,\"type\":\"\",\"data\":\"0x00\",\"topics\":[\"0x01\"],\"blockNumber\":0,"+
+		"\"transactionIndex\":0,\"logIndex\":0}}", msg)
+}
+
+func TestConnectionAckService(t *testing.T) {
+	client := &MockClient{}
+	client.On("DiscardService", mock.Anything, mock.Anything).
+		Return(errors.New(errors.ErrQueueDiscard, nil))
+
+	conn, close := dial(t, client)
+	defer close()
+
+	assert.Nil(t, websocket.JSON.Send(conn, Request{Type: AckService, Offset: 3}))
+
+	var msg string
+	assert.Nil(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, "{\"type\":\"error\",\"request\":\"ackService\",\"cause\":{\"errorCode\":1030,"+
+		"\"description\":\"Internal Error. Please check the status of the service.\"}}", msg)
+	client.AssertCalled(t, "DiscardService", mock.Anything, backend.DiscardServiceRequest{
+		Offset:     3,
+		SessionKey: "session",
+	})
+}
+
+func TestConnectionUnknownRequest(t *testing.T) {
+	conn, close := dial(t, &MockClient{})
+	defer close()
+
+	assert.Nil(t, websocket.Message.Send(conn, "{\"type\":\"unknown\"}"))
+
+	var msg string
+	assert.Nil(t, websocket.Message.Receive(conn, &msg))
+	assert.Equal(t, "{\"type\":\"error\",\"request\":\"unknown\",\"cause\":{\"errorCode\":2014,"+
+		"\"description\":\"Unknown message type.\"}}", msg)
+}
+
+func TestNewWebSocketHandlerNoClient(t *testing.T) {
+	assert.Panics(t, func() {
+		NewWebSocketHandler(Services{Logger: Logger})
+	})
+}
+
+func TestConnectionOriginNotAllowed(t *testing.T) {
+	server := serve(t, &MockClient{}, []string{"http://allowed.example"})
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	_, err := websocket.Dial(url, "", "http://other.example")
+	assert.Equal(t, websocket.ErrBadStatus, err.(*websocket.DialError).Err)
+}
+
+func TestConnectionOriginAllowed(t *testing.T) {
+	server := serve(t, &MockClient{}, []string{"http://allowed.example"})
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	conn, err := websocket.Dial(url, "", "http://allowed.example")
+	assert.Nil(t, err)
+	assert.Nil(t, conn.Close())
+}
@@ -0,0 +1,105 @@
+package ws
+
+import (
+	"github.com/oasislabs/oasis-gateway/api/v0/event"
+	"github.com/oasislabs/oasis-gateway/api/v0/service"
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// RequestType defines the type of the requests a client can send
+// over an open websocket connection
+type RequestType string
+
+const (
+	// WatchService requests the server to push the events of the
+	// service queue starting from the provided offset
+	WatchService RequestType = "watchService"
+
+	// WatchEvent requests the server to push the events of the
+	// subscription with the provided ID starting from the provided offset
+	WatchEvent RequestType = "watchEvent"
+
+	// AckService acknowledges all the events of the service queue with
+	// an offset lower than the provided offset, so they can be discarded
+	AckService RequestType = "ackService"
+
+	// AckEvent acknowledges all the events of the subscription with
+	// the provided ID with an offset lower than the provided offset,
+	// so they can be discarded
+	AckEvent RequestType = "ackEvent"
+)
+
+// MessageType defines the type of the messages the server pushes
+// to the client over an open websocket connection
+type MessageType string
+
+const (
+	// ServiceMessageType is the type for messages that carry an
+	// event from the service queue
+	ServiceMessageType MessageType = "service"
+
+	// EventMessageType is the type for messages that carry an
+	// event from a subscription
+	EventMessageType MessageType = "event"
+
+	// ErrorMessageType is the type for messages that notify the client
+	// of a failure handling the connection
+	ErrorMessageType MessageType = "error"
+)
+
+// ConnectRequest is the request issued by the client to open a
+// websocket connection
+type ConnectRequest struct{}
+
+// Request is a message sent by the client over an open websocket
+// connection
+type Request struct {
+	// Type of the request
+	Type RequestType `json:"type"`
+
+	// ID is the unique identifier for a subscription. Only used for
+	// requests that refer to a subscription
+	ID uint64 `json:"id"`
+
+	// Offset at which events need to be pushed for a watch request, or
+	// before which events are acknowledged for an ack request
+	Offset uint64 `json:"offset"`
+}
+
+// ServiceMessage is the message the server pushes to the client
+// when a new event is available in the service queue
+type ServiceMessage struct {
+	// Type is always ServiceMessageType
+	Type MessageType `json:"type"`
+
+	// Event is the event retrieved from the service queue
+	Event service.Event `json:"event"`
+}
+
+// EventMessage is the message the server pushes to the client
+// when a new event is available for a subscription
+type EventMessage struct {
+	// Type is always EventMessageType
+	Type MessageType `json:"type"`
+
+	// ID is the unique identifier for the subscription the
+	// event belongs to
+	ID uint64 `json:"id"`
+
+	// Event is the event retrieved from the subscription
+	Event event.Event `json:"event"`
+}
+
+// ErrorMessage is the message the server pushes to the client
+// when a request fails
+type ErrorMessage struct {
+	// Type is always ErrorMessageType
+	Type MessageType `json:"type"`
+
+	// Request is the type of the request that failed, if the
+	// failure was caused by a request
+	Request RequestType `json:"request,omitempty"`
+
+	// Cause is the error that caused the failure
+	Cause rpc.Error `json:"cause"`
+}
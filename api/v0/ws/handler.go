@@ -0,0 +1,88 @@
+package ws
+
+import (
+	"context"
+
+	auth "github.com/oasislabs/oasis-gateway/auth/core"
+	backend "github.com/oasislabs/oasis-gateway/backend/core"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+)
+
+// Client interface for the underlying operations needed for the API
+// implementation
+type Client interface {
+	// PollService allows the client to poll for asynchronous responses
+	PollService(context.Context, backend.PollServiceRequest) (backend.Events, errors.Err)
+
+	// DiscardService discards the asynchronous responses already received
+	DiscardService(context.Context, backend.DiscardServiceRequest) errors.Err
+
+	// PollEvent allows the client to poll for events from a subscription
+	PollEvent(context.Context, backend.PollEventRequest) (backend.Events, errors.Err)
+
+	// DiscardEvent discards the events from a subscription already received
+	DiscardEvent(context.Context, backend.DiscardEventRequest) errors.Err
+}
+
+// path is the path on which the websocket connections are opened
+const path = "/v0/api/ws"
+
+// Services required by the WebSocketHandler execution
+type Services struct {
+	Logger log.Logger
+	Client Client
+
+	// AllowedOrigins are the origins other than the one of the
+	// server from which a browser can open a connection. If it
+	// contains "*", any origin is allowed
+	AllowedOrigins []string
+}
+
+// WebSocketHandler implements the handlers to push service and
+// subscription events to the client over a websocket connection
+type WebSocketHandler struct {
+	logger  log.Logger
+	client  Client
+	origins []string
+}
+
+// Connect handles the request to open a websocket connection. The
+// connection is bound to the session of the authenticated request
+func (h WebSocketHandler) Connect(ctx context.Context, v interface{}) (interface{}, error) {
+	session := ctx.Value(auth.Session{}).(string)
+	_ = v.(*ConnectRequest)
+
+	return NewConnection(ConnectionProps{
+		Logger:         h.logger,
+		Client:         h.client,
+		SessionKey:     session,
+		AllowedOrigins: h.origins,
+	}), nil
+}
+
+// NewWebSocketHandler creates a new instance of the handler
+func NewWebSocketHandler(services Services) WebSocketHandler {
+	if services.Client == nil {
+		panic("Client must be provided as a service")
+	}
+	if services.Logger == nil {
+		panic("Logger must be provided as a service")
+	}
+
+	return WebSocketHandler{
+		logger:  services.Logger.ForClass("ws", "handler"),
+		client:  services.Client,
+		origins: services.AllowedOrigins,
+	}
+}
+
+// BindHandler binds the websocket handler to the provided
+// HandlerBinder
+func BindHandler(services Services, binder rpc.HandlerBinder) {
+	handler := NewWebSocketHandler(services)
+
+	binder.Bind("GET", path, rpc.HandlerFunc(handler.Connect),
+		rpc.EntityFactoryFunc(func() interface{} { return &ConnectRequest{} }))
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/oasislabs/oasis-gateway/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// GrpcAuth authenticates the calls to a gRPC server with an Auth. The
+// metadata of a call is exposed to the Auth as the headers of an http
+// request, so clients provide the same credentials for both protocols
+type GrpcAuth struct {
+	auth   Auth
+	logger log.Logger
+}
+
+// NewGrpcAuth creates a new instance of GrpcAuth. It will panic
+// in case there are errors in its construction
+func NewGrpcAuth(auth Auth, logger log.Logger) *GrpcAuth {
+	if auth == nil {
+		panic("auth must be set")
+	}
+
+	if logger == nil {
+		panic("log must be set")
+	}
+
+	return &GrpcAuth{
+		auth:   auth,
+		logger: logger.ForClass("auth", "GrpcAuth"),
+	}
+}
+
+// UnaryServerInterceptor is the grpc.UnaryServerInterceptor that
+// authenticates unary calls
+func (a *GrpcAuth) UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor is the grpc.StreamServerInterceptor that
+// authenticates streaming calls
+func (a *GrpcAuth) StreamServerInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, grpcAuthStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate authenticates a call and returns the context with
+// the AAD and the session of the client
+func (a *GrpcAuth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	req, err := http.NewRequest(http.MethodPost, method, nil)
+	if err != nil {
+		return nil, rpc.GrpcError(ctx, errors.New(errors.ErrInternalError, err))
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	req, err = a.auth.Authenticate(req.WithContext(ctx))
+	if err != nil {
+		a.logger.Debug(ctx, "failed to authenticate call", log.MapFields{
+			"call_type": "GrpcAuthenticateFailure",
+			"method":    method,
+			"err":       err.Error(),
+		})
+		return nil, rpc.GrpcError(ctx, errors.New(errors.ErrAuthenticateRequest, err))
+	}
+
+	sessionKey := md.Get(strings.ToLower(RequestHeaderSessionKey))
+	if len(sessionKey) == 0 || len(sessionKey[0]) == 0 {
+		return nil, rpc.GrpcError(ctx, errors.New(errors.ErrAuthenticateRequest,
+			fmt.Errorf("no %s metadata provided", RequestHeaderSessionKey)))
+	}
+
+	session, err := makeSession(MustGetAAD(req.Context()), sessionKey[0])
+	if err != nil {
+		return nil, rpc.GrpcError(ctx, errors.New(errors.ErrInvalidAAD, err))
+	}
+
+	return context.WithValue(req.Context(), Session{}, session), nil
+}
+
+// grpcAuthStream is a grpc.ServerStream that exposes the context
+// of an authenticated call to the handler of the stream
+type grpcAuthStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context is the implementation of grpc.ServerStream for grpcAuthStream
+func (s grpcAuthStream) Context() context.Context {
+	return s.ctx
+}
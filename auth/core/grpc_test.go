@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s grpcServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestGrpcAuthUnary(t *testing.T) {
+	grpcAuth := NewGrpcAuth(&NilAuth{}, Logger)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-oasis-session-key", "session"))
+
+	res, err := grpcAuth.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: "/service.Service/Deploy",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, "nil", ctx.Value(AAD{}))
+		assert.Equal(t, "5da3a4c7f117944275b4c8629c4916403625d5a4a6573a01ecb03f0e9d2edbe6:session",
+			ctx.Value(Session{}))
+		return 0, nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, res)
+}
+
+func TestGrpcAuthUnaryNoSession(t *testing.T) {
+	grpcAuth := NewGrpcAuth(&NilAuth{}, Logger)
+
+	_, err := grpcAuth.UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{
+		FullMethod: "/service.Service/Deploy",
+	}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Fail(t, "handler should not be called")
+		return nil, nil
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "Failed to authenticate request.", status.Convert(err).Message())
+}
+
+func TestGrpcAuthStream(t *testing.T) {
+	grpcAuth := NewGrpcAuth(&NilAuth{}, Logger)
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-oasis-session-key", "session"))
+
+	err := grpcAuth.StreamServerInterceptor(nil, grpcServerStream{ctx: ctx}, &grpc.StreamServerInfo{
+		FullMethod: "/service.Service/PollStream",
+	}, func(srv interface{}, ss grpc.ServerStream) error {
+		assert.Equal(t, "nil", ss.Context().Value(AAD{}))
+		assert.NotNil(t, ss.Context().Value(Session{}))
+		return nil
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestNewGrpcAuthNoAuth(t *testing.T) {
+	assert.Panics(t, func() {
+		NewGrpcAuth(nil, Logger)
+	})
+}
@@ -74,14 +74,23 @@ func (m *HttpMiddlewareAuth) ServeHTTP(req *http.Request) (interface{}, error) {
 		}
 	}
 
-	expectedAAD := MustGetAAD(req.Context())
-	hasher := sha256.New()
-	if _, err = hasher.Write([]byte(expectedAAD)); err != nil {
+	session, err := makeSession(MustGetAAD(req.Context()), sessionKey)
+	if err != nil {
 		return nil, rpc.HttpForbidden(context.TODO(), errors.New(errors.ErrInvalidAAD, err))
 	}
 
-	aadHash := hex.EncodeToString(hasher.Sum(nil))
-
-	req = req.WithContext(context.WithValue(req.Context(), Session{}, fmt.Sprintf(sessionKeyFormat, aadHash, sessionKey)))
+	req = req.WithContext(context.WithValue(req.Context(), Session{}, session))
 	return m.next.ServeHTTP(req)
 }
+
+// makeSession derives the session of a client from the AAD it is
+// authenticated with and the session key it provides
+func makeSession(aad, sessionKey string) (string, error) {
+	hasher := sha256.New()
+	if _, err := hasher.Write([]byte(aad)); err != nil {
+		return "", err
+	}
+
+	aadHash := hex.EncodeToString(hasher.Sum(nil))
+	return fmt.Sprintf(sessionKeyFormat, aadHash, sessionKey), nil
+}
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"errors"
+	"time"
 
 	"github.com/oasislabs/oasis-gateway/config"
 	"github.com/oasislabs/oasis-gateway/log"
@@ -21,12 +22,16 @@ func (m BackendProvider) String() string {
 }
 
 type Config struct {
-	Provider      BackendProvider
-	BackendConfig BackendConfig
+	Provider             BackendProvider
+	BackendConfig        BackendConfig
+	RequestTimeout       time.Duration
+	RestoreSubscriptions bool
 }
 
 func (c *Config) Log(fields log.Fields) {
 	fields.Add("backend.provider", c.Provider)
+	fields.Add("backend.request_timeout_ms", c.RequestTimeout.Nanoseconds()/int64(time.Millisecond))
+	fields.Add("backend.restore_subscriptions", c.RestoreSubscriptions)
 
 	if c.BackendConfig != nil {
 		c.BackendConfig.Log(fields)
@@ -39,6 +44,13 @@ func (c *Config) Configure(v *viper.Viper) error {
 		return config.ErrKeyNotSet{Key: "backend.provider"}
 	}
 
+	timeoutMs := v.GetInt64("backend.request_timeout_ms")
+	if timeoutMs < 0 {
+		return errors.New("backend.request_timeout_ms cannot be negative")
+	}
+	c.RequestTimeout = time.Duration(timeoutMs) * time.Millisecond
+	c.RestoreSubscriptions = v.GetBool("backend.restore_subscriptions")
+
 	switch c.Provider {
 	case BackendEthereum:
 		c.BackendConfig = &EthereumConfig{}
@@ -62,6 +74,12 @@ func (c *Config) Bind(v *viper.Viper, cmd *cobra.Command) error {
 		"provider for the mailbox service. "+
 			"Options are "+BackendEthereum.String()+
 			", "+BackendEkiden.String()+".")
+	cmd.PersistentFlags().Int64("backend.request_timeout_ms", 300000,
+		"time after which an asynchronous request that has not completed fails with a timeout. "+
+			"Requests can set their own timeout. If set to 0 requests do not time out")
+	cmd.PersistentFlags().Bool("backend.restore_subscriptions", false,
+		"if set the subscriptions are stored in the mailbox and the ones of the instances "+
+			"that stopped are restored on startup and periodically")
 
 	if err := (&EthereumConfig{}).Bind(v, cmd); err != nil {
 		return err
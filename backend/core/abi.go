@@ -0,0 +1,419 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
+)
+
+const (
+	// abiElementType is the type of the element that keeps
+	// the ABI registered for an address
+	abiElementType = "abi"
+
+	// abiReloadInterval is the interval at which the registry reloads
+	// the ABIs from the mailbox, so that it picks up the ABIs registered
+	// by other instances. It must be lower than the time after which the
+	// mailbox expires idle queues
+	abiReloadInterval = time.Minute
+
+	// maxAbiRecords is the maximum number of registered
+	// ABIs that are retrieved from the mailbox
+	maxAbiRecords = 1 << 16
+)
+
+// abiRecord is the ABI registered for an address
+// that is kept in the mailbox
+type abiRecord struct {
+	Address string
+	ABI     string
+}
+
+// registeredAbi is a parsed ABI along with the offset
+// of the element that keeps it in the mailbox
+type registeredAbi struct {
+	offset uint64
+	raw    string
+	abi    abi.ABI
+}
+
+// AbiRegistryProps properties used to create an AbiRegistry
+type AbiRegistryProps struct {
+	// Context used by the registry and that can be used
+	// to signal a cancellation
+	Context context.Context
+
+	// Logger used by the registry
+	Logger log.Logger
+
+	// MQueue is the messaging queue in which the
+	// registered ABIs are kept
+	MQueue mqueue.MQueue
+}
+
+// AbiRegistry keeps the contract ABIs registered for addresses, which
+// are used to decode the logs emitted by those addresses. The ABIs are
+// kept in the mailbox so that they are shared amongst instances
+type AbiRegistry struct {
+	ctx    context.Context
+	logger log.Logger
+	mqueue mqueue.MQueue
+	lock   sync.RWMutex
+	abis   map[string]registeredAbi
+}
+
+// NewAbiRegistry creates a new registry. The registry is empty until
+// the ABIs are loaded, and then it reloads them periodically
+func NewAbiRegistry(props AbiRegistryProps) *AbiRegistry {
+	if props.Context == nil {
+		panic("Context must be set")
+	}
+	if props.Logger == nil {
+		panic("Logger must be set")
+	}
+	if props.MQueue == nil {
+		panic("MQueue must be set")
+	}
+
+	r := &AbiRegistry{
+		ctx:    props.Context,
+		logger: props.Logger.ForClass("backend/core", "AbiRegistry"),
+		mqueue: props.MQueue,
+		abis:   make(map[string]registeredAbi),
+	}
+
+	go r.startLoop()
+	return r
+}
+
+func (r *AbiRegistry) startLoop() {
+	ticker := time.NewTicker(abiReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Load(r.ctx); err != nil {
+				r.logger.Warn(r.ctx, "failed to reload abis", log.MapFields{
+					"call_type": "LoadAbisFailure",
+					"err":       err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// Load replaces the ABIs of the registry with the ones kept in the mailbox
+func (r *AbiRegistry) Load(ctx context.Context) errors.Err {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.load(ctx)
+}
+
+// load replaces the ABIs of the registry with the ones kept in
+// the mailbox. The caller must hold the lock of the registry
+func (r *AbiRegistry) load(ctx context.Context) errors.Err {
+	els, err := r.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    AbisID(),
+		Offset: 0,
+		Count:  maxAbiRecords,
+	})
+	if err != nil {
+		return errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	abis := make(map[string]registeredAbi)
+	for _, el := range els.Elements {
+		if el.Type != abiElementType {
+			continue
+		}
+
+		var record abiRecord
+		if err := json.Unmarshal([]byte(el.Value), &record); err != nil {
+			r.logger.Warn(ctx, "failed to deserialize abi", log.MapFields{
+				"call_type": "LoadAbisFailure",
+				"offset":    el.Offset,
+				"err":       err.Error(),
+			})
+			continue
+		}
+
+		// an ABI registered again is stored before the previous one is
+		// discarded, so if both are found only the latest is kept
+		if prev, ok := abis[record.Address]; ok {
+			r.discard(ctx, prev.offset)
+		}
+
+		// avoid parsing the ABIs that have not changed
+		if current, ok := r.abis[record.Address]; ok && current.offset == el.Offset {
+			abis[record.Address] = current
+			continue
+		}
+
+		parsed, err := abi.JSON(strings.NewReader(record.ABI))
+		if err != nil {
+			r.logger.Warn(ctx, "failed to parse abi", log.MapFields{
+				"call_type": "LoadAbisFailure",
+				"address":   record.Address,
+				"err":       err.Error(),
+			})
+			continue
+		}
+
+		abis[record.Address] = registeredAbi{offset: el.Offset, raw: record.ABI, abi: parsed}
+	}
+
+	r.abis = abis
+	return nil
+}
+
+// discard discards the element that keeps an ABI
+// that is no longer registered
+func (r *AbiRegistry) discard(ctx context.Context, offset uint64) errors.Err {
+	if err := r.mqueue.Discard(ctx, mqueue.DiscardRequest{
+		Key:          AbisID(),
+		KeepPrevious: true,
+		Count:        1,
+		Offset:       offset,
+	}); err != nil {
+		err := errors.New(errors.ErrQueueDiscard, err)
+		r.logger.Debug(ctx, "failed to discard abi", log.MapFields{
+			"call_type": "DiscardAbiFailure",
+			"offset":    offset,
+		}, err)
+		return err
+	}
+
+	return nil
+}
+
+// Register registers the ABI for the address, replacing
+// the ABI registered before for the same address
+func (r *AbiRegistry) Register(ctx context.Context, address, raw string) errors.Err {
+	if !common.IsHexAddress(address) {
+		return errors.New(errors.ErrInvalidAddress, fmt.Errorf("invalid address %s", address))
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		return errors.New(errors.ErrInvalidAbi, err)
+	}
+
+	key := strings.ToLower(address)
+	p, err := json.Marshal(abiRecord{Address: key, ABI: raw})
+	if err != nil {
+		return errors.New(errors.ErrInternalError, err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// the ABI may have been registered by another instance
+	if err := r.load(ctx); err != nil {
+		return err
+	}
+
+	offset, err := r.mqueue.Next(ctx, mqueue.NextRequest{Key: AbisID()})
+	if err != nil {
+		return errors.New(errors.ErrQueueNext, err)
+	}
+
+	if err := r.mqueue.Insert(ctx, mqueue.InsertRequest{
+		Key: AbisID(),
+		Element: mqueue.Element{
+			Offset: offset,
+			Type:   abiElementType,
+			Value:  string(p),
+		},
+	}); err != nil {
+		return errors.New(errors.ErrQueueInsert, err)
+	}
+
+	if prev, ok := r.abis[key]; ok {
+		// a failure only leaves a stale element that is
+		// discarded the next time the ABIs are loaded
+		_ = r.discard(ctx, prev.offset)
+	}
+
+	r.abis[key] = registeredAbi{offset: offset, raw: raw, abi: parsed}
+	return nil
+}
+
+// Get returns the ABI registered for the address
+func (r *AbiRegistry) Get(ctx context.Context, address string) (string, errors.Err) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	registered, ok := r.abis[strings.ToLower(address)]
+	if !ok {
+		return "", errors.New(errors.ErrAbiNotFound, nil)
+	}
+
+	return registered.raw, nil
+}
+
+// Remove removes the ABI registered for the address
+func (r *AbiRegistry) Remove(ctx context.Context, address string) errors.Err {
+	key := strings.ToLower(address)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := r.load(ctx); err != nil {
+		return err
+	}
+
+	registered, ok := r.abis[key]
+	if !ok {
+		return errors.New(errors.ErrAbiNotFound, nil)
+	}
+
+	if err := r.discard(ctx, registered.offset); err != nil {
+		return err
+	}
+
+	delete(r.abis, key)
+	return nil
+}
+
+// Decode decodes the log with the ABI registered for the address
+// that emitted it. It returns the name of the event and its arguments,
+// or false if there is no ABI registered with an event for the log
+func (r *AbiRegistry) Decode(l types.Log) (string, []EventArg, bool) {
+	if len(l.Topics) == 0 {
+		return "", nil, false
+	}
+
+	r.lock.RLock()
+	registered, ok := r.abis[strings.ToLower(l.Address.Hex())]
+	r.lock.RUnlock()
+	if !ok {
+		return "", nil, false
+	}
+
+	for _, event := range registered.abi.Events {
+		// anonymous events do not log the ID of the event
+		if event.Anonymous || event.Id() != l.Topics[0] {
+			continue
+		}
+
+		args, err := decodeEventArgs(event, l)
+		if err != nil {
+			r.logger.Debug(r.ctx, "failed to decode log", log.MapFields{
+				"call_type": "DecodeLogFailure",
+				"address":   l.Address.Hex(),
+				"event":     event.Name,
+			}, errors.New(errors.ErrInvalidAbi, err))
+			return "", nil, false
+		}
+
+		return event.Name, args, true
+	}
+
+	return "", nil, false
+}
+
+// decodeEventArgs decodes the arguments of the event from the log. The
+// indexed arguments are taken from the topics that follow the event ID
+// and the rest are unpacked from the data
+func decodeEventArgs(event abi.Event, l types.Log) ([]EventArg, error) {
+	values, err := event.Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := l.Topics[1:]
+	args := make([]EventArg, 0, len(event.Inputs))
+	for _, input := range event.Inputs {
+		arg := EventArg{Name: input.Name, Type: input.Type.String(), Indexed: input.Indexed}
+
+		if input.Indexed {
+			if len(topics) == 0 {
+				return nil, stderr.New("log has less topics than indexed arguments")
+			}
+
+			value, err := decodeTopic(input.Type, topics[0])
+			if err != nil {
+				return nil, err
+			}
+
+			arg.Value = value
+			topics = topics[1:]
+		} else {
+			arg.Value = formatValue(input.Type, values[0])
+			values = values[1:]
+		}
+
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
+
+// decodeTopic decodes the value of an indexed argument
+func decodeTopic(t abi.Type, topic common.Hash) (string, error) {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		// the value of an indexed argument of these types is not logged,
+		// only its hash is, so the hash is all that can be provided
+		return topic.Hex(), nil
+	}
+
+	values, err := abi.Arguments{{Type: t}}.UnpackValues(topic.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	return formatValue(t, values[0]), nil
+}
+
+// formatValue formats an unpacked value of the type. Numbers are
+// formatted in decimal, so that big numbers keep their precision, byte
+// values and addresses are hex encoded, and arrays are formatted as a
+// JSON array with their elements formatted as strings
+func formatValue(t abi.Type, v interface{}) string {
+	switch t.T {
+	case abi.AddressTy:
+		return v.(common.Address).Hex()
+	case abi.HashTy:
+		return v.(common.Hash).Hex()
+	case abi.BoolTy:
+		return strconv.FormatBool(v.(bool))
+	case abi.StringTy:
+		return v.(string)
+	case abi.BytesTy:
+		return hexutil.Encode(v.([]byte))
+	case abi.FixedBytesTy:
+		value := reflect.ValueOf(v)
+		p := make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(p), value)
+		return hexutil.Encode(p)
+	case abi.SliceTy, abi.ArrayTy:
+		value := reflect.ValueOf(v)
+		elems := make([]string, value.Len())
+		for i := range elems {
+			elems[i] = formatValue(*t.Elem, value.Index(i).Interface())
+		}
+
+		p, _ := json.Marshal(elems)
+		return string(p)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/oasislabs/oasis-gateway/errors"
+	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
+	"github.com/oasislabs/oasis-gateway/mqueue/mailboxtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const transferAbi = `[{"type":"event","name":"Transfer","anonymous":false,"inputs":[` +
+	`{"name":"from","type":"address","indexed":true},` +
+	`{"name":"memo","type":"string","indexed":true},` +
+	`{"name":"value","type":"uint256","indexed":false},` +
+	`{"name":"ids","type":"uint8[]","indexed":false}]}]`
+
+const transferAddress = "0x0000000000000000000000000000000000000001"
+
+func createAbiRegistry() *AbiRegistry {
+	return NewAbiRegistry(AbiRegistryProps{
+		Context: context.Background(),
+		Logger:  Logger,
+		MQueue:  &mailboxtest.Mailbox{},
+	})
+}
+
+func registerTransferAbi(t *testing.T, registry *AbiRegistry) {
+	registry.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mock.Anything).Return(mqueue.Elements{}, nil).Once()
+	registry.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "abis"}).Return(uint64(0), nil).Once()
+	registry.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil).Once()
+
+	err := registry.Register(Context, transferAddress, transferAbi)
+	assert.Nil(t, err)
+}
+
+func TestAbiRegistryRegisterOK(t *testing.T) {
+	registry := createAbiRegistry()
+
+	registerTransferAbi(t, registry)
+
+	registry.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "abis",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   "abi",
+				Value: "{\"Address\":\"" + transferAddress + "\",\"ABI\":" +
+					"\"[{\\\"type\\\":\\\"event\\\",\\\"name\\\":\\\"Transfer\\\",\\\"anonymous\\\":false,\\\"inputs\\\":[" +
+					"{\\\"name\\\":\\\"from\\\",\\\"type\\\":\\\"address\\\",\\\"indexed\\\":true}," +
+					"{\\\"name\\\":\\\"memo\\\",\\\"type\\\":\\\"string\\\",\\\"indexed\\\":true}," +
+					"{\\\"name\\\":\\\"value\\\",\\\"type\\\":\\\"uint256\\\",\\\"indexed\\\":false}," +
+					"{\\\"name\\\":\\\"ids\\\",\\\"type\\\":\\\"uint8[]\\\",\\\"indexed\\\":false}]}]\"}",
+			},
+		})
+
+	raw, err := registry.Get(Context, transferAddress)
+	assert.Nil(t, err)
+	assert.Equal(t, transferAbi, raw)
+}
+
+func TestAbiRegistryRegisterErrInvalidAbi(t *testing.T) {
+	registry := createAbiRegistry()
+
+	err := registry.Register(Context, transferAddress, "{")
+
+	assert.Equal(t, errors.ErrInvalidAbi, err.ErrorCode())
+}
+
+func TestAbiRegistryRegisterErrInvalidAddress(t *testing.T) {
+	registry := createAbiRegistry()
+
+	err := registry.Register(Context, "address", transferAbi)
+
+	assert.Equal(t, errors.ErrInvalidAddress, err.ErrorCode())
+}
+
+func TestAbiRegistryLoadKeepsLatest(t *testing.T) {
+	registry := createAbiRegistry()
+	value := "{\"Address\":\"" + transferAddress + "\",\"ABI\":\"[]\"}"
+
+	registry.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "abis",
+			Offset: 0,
+			Count:  maxAbiRecords,
+		}).Return(mqueue.Elements{
+		Elements: []mqueue.Element{
+			{Offset: 0, Type: "abi", Value: value},
+			{Offset: 1, Type: "abi", Value: value},
+		},
+	}, nil)
+	registry.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+
+	err := registry.Load(Context)
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(1), registry.abis[transferAddress].offset)
+	registry.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
+		mock.Anything, mqueue.DiscardRequest{
+			Key:          "abis",
+			KeepPrevious: true,
+			Count:        1,
+			Offset:       0,
+		})
+}
+
+func TestAbiRegistryRemoveErrNotFound(t *testing.T) {
+	registry := createAbiRegistry()
+
+	registry.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mock.Anything).Return(mqueue.Elements{}, nil)
+
+	err := registry.Remove(Context, transferAddress)
+
+	assert.Equal(t, errors.ErrAbiNotFound, err.ErrorCode())
+}
+
+func TestAbiRegistryDecodeOK(t *testing.T) {
+	registry := createAbiRegistry()
+	registerTransferAbi(t, registry)
+
+	value := common.LeftPadBytes(big.NewInt(1000).Bytes(), 32)
+	offset := common.LeftPadBytes(big.NewInt(64).Bytes(), 32)
+	length := common.LeftPadBytes(big.NewInt(2).Bytes(), 32)
+	first := common.LeftPadBytes([]byte{1}, 32)
+	second := common.LeftPadBytes([]byte{2}, 32)
+	memo := crypto.Keccak256Hash([]byte("memo"))
+
+	event, args, ok := registry.Decode(types.Log{
+		Address: common.HexToAddress(transferAddress),
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,string,uint256,uint8[])")),
+			common.HexToHash("0x0000000000000000000000000000000000000002"),
+			memo,
+		},
+		Data: append(append(append(append(value, offset...), length...), first...), second...),
+	})
+
+	assert.True(t, ok)
+	assert.Equal(t, "Transfer", event)
+	assert.Equal(t, []EventArg{
+		{Name: "from", Type: "address", Indexed: true, Value: "0x0000000000000000000000000000000000000002"},
+		{Name: "memo", Type: "string", Indexed: true, Value: memo.Hex()},
+		{Name: "value", Type: "uint256", Value: "1000"},
+		{Name: "ids", Type: "uint8[]", Value: "[\"1\",\"2\"]"},
+	}, args)
+}
+
+func TestAbiRegistryDecodeUnknownEvent(t *testing.T) {
+	registry := createAbiRegistry()
+	registerTransferAbi(t, registry)
+
+	_, _, ok := registry.Decode(types.Log{
+		Address: common.HexToAddress(transferAddress),
+		Topics:  []common.Hash{crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))},
+	})
+
+	assert.False(t, ok)
+}
+
+func TestAbiRegistryDecodeUnknownAddress(t *testing.T) {
+	registry := createAbiRegistry()
+	registerTransferAbi(t, registry)
+
+	_, _, ok := registry.Decode(types.Log{
+		Address: common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		Topics:  []common.Hash{crypto.Keccak256Hash([]byte("Transfer(address,string,uint256,uint8[])"))},
+	})
+
+	assert.False(t, ok)
+}
@@ -0,0 +1,145 @@
+package core
+
+import (
+	"context"
+	"sync"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
+)
+
+// pendingRequest keeps track of whether an asynchronous request can
+// still be cancelled. A request can be cancelled until its transaction
+// is submitted.
+//
+// The submission and the cancellation of a request are decided by
+// whichever reserves the first offset of the cancel queue of the request.
+// The queue is kept in the mailbox, so a request can be cancelled from
+// any gateway instance
+type pendingRequest struct {
+	lock      sync.Mutex
+	mqueue    mqueue.MQueue
+	key       string
+	cancel    context.CancelFunc
+	cancelled bool
+	submitted bool
+}
+
+// submit marks the request as submitted. It returns false if the
+// request has already been cancelled, in which case the context of
+// the request is cancelled
+func (p *pendingRequest) submit(ctx context.Context) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.cancelled {
+		return false
+	}
+
+	// the decision is only taken once for a request
+	if p.submitted {
+		return true
+	}
+
+	offset, err := p.mqueue.Next(ctx, mqueue.NextRequest{Key: p.key})
+	if err != nil {
+		// without a decision the transaction cannot be submitted,
+		// since the request may have been cancelled
+		p.cancel()
+		return false
+	}
+
+	if offset > 0 {
+		p.cancelled = true
+		p.cancel()
+		return false
+	}
+
+	p.submitted = true
+	return true
+}
+
+// cancelRequest cancels the context of the request once its
+// cancellation has been decided. It returns false if the
+// transaction of the request has already been submitted
+func (p *pendingRequest) cancelRequest() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.submitted {
+		return false
+	}
+
+	p.cancelled = true
+	p.cancel()
+	return true
+}
+
+func (p *pendingRequest) isCancelled() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.cancelled
+}
+
+func (m *RequestManager) addPending(key string, cancelKey string, cancel context.CancelFunc) *pendingRequest {
+	pending := &pendingRequest{mqueue: m.mqueue, key: cancelKey, cancel: cancel}
+
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	m.pending[key] = pending
+	return pending
+}
+
+func (m *RequestManager) removePending(key string) {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	delete(m.pending, key)
+}
+
+// CancelService cancels an asynchronous request whose transaction has
+// not been submitted yet. The outcome of the request is an ErrorEvent
+// with ErrRequestCancelled as its cause.
+//
+// The request may be run by another gateway instance, in which case it
+// is cancelled when it attempts to submit its transaction
+func (m *RequestManager) CancelService(ctx context.Context, req CancelServiceRequest) errors.Err {
+	if len(req.SessionKey) == 0 {
+		return errors.New(errors.ErrInvalidKey, nil)
+	}
+
+	status, err := m.GetRequestStatus(ctx, GetRequestStatusRequest{ID: req.ID, SessionKey: req.SessionKey})
+	if err != nil {
+		if err.ErrorCode() == errors.ErrRequestStatusNotFound {
+			return errors.New(errors.ErrRequestNotCancellable, err)
+		}
+		return err
+	}
+
+	if status.State != RequestQueued && status.State != RequestEstimatingGas {
+		return errors.New(errors.ErrRequestNotCancellable, nil)
+	}
+
+	offset, derr := m.mqueue.Next(ctx, mqueue.NextRequest{Key: CancelID(req.SessionKey, req.ID)})
+	if derr != nil {
+		return errors.New(errors.ErrQueueNext, derr)
+	}
+
+	if offset > 0 {
+		return errors.New(errors.ErrRequestNotCancellable, nil)
+	}
+
+	// a request run by this instance does not need
+	// to wait until it attempts to submit its transaction
+	m.pendingLock.Lock()
+	pending, ok := m.pending[StatusID(req.SessionKey, req.ID)]
+	m.pendingLock.Unlock()
+
+	if ok {
+		pending.cancelRequest()
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/oasislabs/oasis-gateway/errors"
 	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
@@ -25,7 +26,23 @@ const (
 	DeployServiceEventType  EventType = "deployServiceEventType"
 	ExecuteServiceEventType EventType = "executeServiceEventType"
 	ErrorEventType          EventType = "errorEventType"
+	LateResultEventType     EventType = "lateResultEventType"
 	DataEventType           EventType = "dataEventType"
+	RetractedEventType      EventType = "retractedEventType"
+)
+
+// The event types a subscription can be created for
+const (
+	// LogsEvent is the event type for the logs emitted by services
+	LogsEvent = "logs"
+
+	// NewHeadsEvent is the event type for the headers of the blocks
+	// added to the chain
+	NewHeadsEvent = "newHeads"
+
+	// TransactionsEvent is the event type for the transactions of
+	// the requests of the session that are committed
+	TransactionsEvent = "transactions"
 )
 
 func (t EventType) String() string {
@@ -67,6 +84,13 @@ func deserializeElement(el mqueue.Element) (Event, errors.Err) {
 			return nil, errors.New(errors.ErrDeserializeEvent, err)
 		}
 
+		return ev, nil
+	case LateResultEventType:
+		var ev LateResultEvent
+		if err := json.Unmarshal([]byte(el.Value), &ev); err != nil {
+			return nil, errors.New(errors.ErrDeserializeEvent, err)
+		}
+
 		return ev, nil
 	case DataEventType:
 		var ev DataEvent
@@ -74,6 +98,13 @@ func deserializeElement(el mqueue.Element) (Event, errors.Err) {
 			return nil, errors.New(errors.ErrDeserializeEvent, err)
 		}
 
+		return ev, nil
+	case RetractedEventType:
+		var ev RetractedEvent
+		if err := json.Unmarshal([]byte(el.Value), &ev); err != nil {
+			return nil, errors.New(errors.ErrDeserializeEvent, err)
+		}
+
 		return ev, nil
 	default:
 		return nil, errors.New(errors.ErrUnkownEventType, nil)
@@ -92,6 +123,56 @@ func SubinfoID(key string) string {
 	return fmt.Sprintf("%s:subinfo", key)
 }
 
+// IdempotencyID generates the ID of the queue that keeps the ID
+// of the request started with an idempotency key for an endpoint
+func IdempotencyID(key string, endpoint string, idempotencyKey string) string {
+	return fmt.Sprintf("%s:idempotency:%s:%s", key, endpoint, idempotencyKey)
+}
+
+// StatusID generates the ID of the queue that keeps the
+// status transitions of a request
+func StatusID(key string, id uint64) string {
+	return fmt.Sprintf("%s:status:%d", key, id)
+}
+
+// CancelID generates the ID of the queue whose first offset is
+// reserved either to submit the transaction of a request or to
+// cancel the request, whatever happens first
+func CancelID(key string, id uint64) string {
+	return fmt.Sprintf("%s:cancel:%d", key, id)
+}
+
+// CheckpointID generates the ID of the queue that keeps the
+// checkpoint of a subscription so that it can be restored
+func CheckpointID(key string) string {
+	return fmt.Sprintf("%s:checkpoint", key)
+}
+
+// SubscriptionsID generates the ID of the queue that keeps the
+// definitions of the subscriptions so that they can be restored
+func SubscriptionsID() string {
+	return "subscriptions"
+}
+
+// SubscriptionClaimID generates the ID of the queue whose first offset
+// is reserved by the instance that restores the subscription from the
+// definition stored at the offset, so that only one instance restores it
+func SubscriptionClaimID(key string, offset uint64) string {
+	return fmt.Sprintf("%s:claim:%d", key, offset)
+}
+
+// AbisID generates the ID of the queue that keeps the
+// contract ABIs registered for addresses
+func AbisID() string {
+	return "abis"
+}
+
+// ReceiptID generates the ID of the queue that keeps the
+// AAD that submitted a transaction
+func ReceiptID(hash string) string {
+	return fmt.Sprintf("receipt:%s", hash)
+}
+
 // ExecuteServiceRequest is is used by the user to trigger a service
 // execution. A client is always subscribed to a subscription with
 // topic "service" from which the client can retrieve the asynchronous
@@ -109,6 +190,16 @@ type ExecuteServiceRequest struct {
 
 	// Key is the identifier of the session
 	SessionKey string
+
+	// IdempotencyKey is an optional key provided by the client. If a
+	// request was already started with the same key for the session,
+	// the ID of that request is returned instead of starting a new one
+	IdempotencyKey string
+
+	// Timeout is the maximum time the request is waited for before an
+	// ErrorEvent is stored as its outcome. If not set, the default
+	// timeout of the RequestManager applies
+	Timeout time.Duration
 }
 
 // DeployServiceRequest is issued by the user to trigger a service
@@ -125,6 +216,102 @@ type DeployServiceRequest struct {
 
 	// Key is the identifier of the session
 	SessionKey string
+
+	// IdempotencyKey is an optional key provided by the client. If a
+	// request was already started with the same key for the session,
+	// the ID of that request is returned instead of starting a new one
+	IdempotencyKey string
+
+	// Timeout is the maximum time the request is waited for before an
+	// ErrorEvent is stored as its outcome. If not set, the default
+	// timeout of the RequestManager applies
+	Timeout time.Duration
+}
+
+// RequestState is the state in the lifecycle of an asynchronous request
+type RequestState string
+
+const (
+	// RequestQueued is the state of a request waiting to be executed
+	RequestQueued RequestState = "queued"
+
+	// RequestEstimatingGas is the state of a request for which the gas
+	// of its transaction is being estimated
+	RequestEstimatingGas RequestState = "estimating_gas"
+
+	// RequestSubmitted is the state of a request whose transaction has
+	// been submitted and is waiting to be committed
+	RequestSubmitted RequestState = "submitted"
+
+	// RequestCommitted is the state of a request that completed successfully
+	RequestCommitted RequestState = "committed"
+
+	// RequestFailed is the state of a request that failed
+	RequestFailed RequestState = "failed"
+)
+
+// RequestStatus is the status of an asynchronous request
+type RequestStatus struct {
+	// State of the request in its lifecycle
+	State RequestState `json:"state"`
+
+	// TransactionHash is the hash of the transaction submitted for the
+	// request, once the request has one
+	TransactionHash string `json:"transactionHash,omitempty"`
+}
+
+// GetRequestStatusRequest is a request to retrieve the status
+// of an asynchronous request
+type GetRequestStatusRequest struct {
+	// ID of the asynchronous request
+	ID uint64
+
+	// Key is the identifier of the session
+	SessionKey string
+}
+
+// ExecuteServiceBatchItem is an execute service request that is part
+// of a batch. If Err is set the request is not executed and its outcome
+// is an ErrorEvent with Err as its cause
+type ExecuteServiceBatchItem struct {
+	// Request is the execute service request
+	Request ExecuteServiceRequest
+
+	// Err is the error found when the request was verified, if any
+	Err errors.Err
+}
+
+// ExecuteServiceBatchRequest is a request to execute multiple services
+// with a single call
+type ExecuteServiceBatchRequest struct {
+	// Items of the batch in the order in which their IDs are reserved
+	Items []ExecuteServiceBatchItem
+
+	// Key is the identifier of the session
+	SessionKey string
+}
+
+// CancelServiceRequest is a request issued by the client to cancel
+// an asynchronous request whose transaction has not been submitted yet
+type CancelServiceRequest struct {
+	// ID of the asynchronous request
+	ID uint64
+
+	// Key is the identifier of the session
+	SessionKey string
+}
+
+// SyncResponse is the outcome of a request for which the caller
+// waits until the request completes
+type SyncResponse struct {
+	// ID to identify the asynchronous response. If the caller stopped
+	// waiting before the request completed, the ID can be used to
+	// poll for the outcome of the request
+	ID uint64
+
+	// Event is the outcome of the request. It is nil in case the
+	// request did not complete in the time the caller waited for it
+	Event Event
 }
 
 // GetCodeRequest is a request to retrieve the code
@@ -148,6 +335,100 @@ type GetCodeResponse struct {
 	Code string
 }
 
+// CallContractRequest is a request to run a read-only call against
+// a service without submitting a transaction
+type CallContractRequest struct {
+	// Address is the unique address that identifies the service
+	Address string
+
+	// Data is the encoded call to run against the service
+	Data string
+}
+
+// CallContractResponse is the outcome of a read-only call
+type CallContractResponse struct {
+	// Address is the unique address that identifies the service
+	Address string
+
+	// Output returned by the service
+	Output string
+}
+
+// EstimateGasRequest is a request to estimate the gas the gateway
+// would use for a deploy or execute request
+type EstimateGasRequest struct {
+	// Address is the unique address that identifies the service. If
+	// empty the estimate is for a deployment
+	Address string
+
+	// Data is the data of the deploy or execute request
+	Data string
+}
+
+// EstimateGasResponse is the gas and fee the gateway would use
+// for a deploy or execute request
+type EstimateGasResponse struct {
+	// GasLimit is the gas limit of the transaction
+	GasLimit uint64
+
+	// GasPrice is the price for each unit of gas encoded as hex
+	GasPrice string
+
+	// Fee is the maximum cost of the transaction encoded as hex
+	Fee string
+
+	// ConfidentialFallback is true if the gas could not be estimated
+	// and the estimate used for confidential services is returned
+	ConfidentialFallback bool
+}
+
+// GetReceiptRequest is a request to retrieve the receipt of a
+// transaction submitted by the gateway
+type GetReceiptRequest struct {
+	// Hash of the transaction
+	Hash string
+
+	// AAD is the identifier of the caller. The receipt is only
+	// returned if the transaction was submitted for the same AAD
+	AAD string
+}
+
+// ReceiptLog is a log emitted by a transaction
+type ReceiptLog struct {
+	// Address of the service that emitted the log
+	Address string
+
+	// Topics of the log encoded as hex
+	Topics []string
+
+	// Data of the log encoded as hex
+	Data string
+}
+
+// GetReceiptResponse is the receipt of a transaction
+type GetReceiptResponse struct {
+	// Hash of the transaction
+	Hash string
+
+	// Status of the transaction. 1 if the transaction succeeded
+	// and 0 if it failed
+	Status uint64
+
+	// GasUsed by the transaction
+	GasUsed uint64
+
+	// BlockNumber is the number of the block that includes
+	// the transaction
+	BlockNumber uint64
+
+	// ContractAddress is the address of the service created by the
+	// transaction, if the transaction was a deployment
+	ContractAddress string
+
+	// Logs emitted by the transaction
+	Logs []ReceiptLog
+}
+
 // GetExpiryRequest is a request to retrieve the expiration timestamp
 // associated with a specific service
 type GetExpiryRequest struct {
@@ -221,6 +502,16 @@ type ExecuteServiceResponse struct {
 
 	// Output generated by the service at the end of its execution
 	Output string
+
+	// TransactionHash is the hash of the transaction that executed
+	// the service
+	TransactionHash string
+
+	// BlockNumber is the number of the block that includes the transaction
+	BlockNumber uint64
+
+	// GasUsed is the amount of gas used by the transaction
+	GasUsed uint64
 }
 
 // DeployServiceResponse is the event that can be polled by the user
@@ -234,6 +525,37 @@ type DeployServiceResponse struct {
 	// is generated when a service is deployed and it can be used
 	// for service execution
 	Address string
+
+	// TransactionHash is the hash of the transaction that deployed
+	// the service
+	TransactionHash string
+
+	// BlockNumber is the number of the block that includes the transaction
+	BlockNumber uint64
+
+	// GasUsed is the amount of gas used by the transaction
+	GasUsed uint64
+
+	// CodeHash is the hash of the code deployed for the service
+	CodeHash string
+}
+
+// LateResultEvent is the event that can be polled by the user when the
+// transaction of a request commits after the request timed out and an
+// ErrorEvent was already provided as its outcome
+type LateResultEvent struct {
+	// ID to identify an asynchronous response. It uniquely identifies the
+	// event and orders it in the sequence of events expected by the user
+	ID uint64
+
+	// RequestID is the ID of the request that timed out
+	RequestID uint64
+
+	// Execute is the outcome of the request if it was an execution
+	Execute *ExecuteServiceResponse `json:",omitempty"`
+
+	// Deploy is the outcome of the request if it was a deployment
+	Deploy *DeployServiceResponse `json:",omitempty"`
 }
 
 // DataEvent is that event that can be polled by the user to poll
@@ -248,6 +570,101 @@ type DataEvent struct {
 
 	// Topics is the list of topics to which this event refers
 	Topics []string
+
+	// Type is the event type of the subscription that generated
+	// the event
+	Type string
+
+	// BlockNumber is the number of the block to which the
+	// event refers
+	BlockNumber uint64
+
+	// BlockHash is the hash of the block to which the event
+	// refers, if known
+	BlockHash string
+
+	// TransactionHash is the hash of the transaction to which
+	// the event refers, if any
+	TransactionHash string
+
+	// TransactionIndex is the index of the transaction of a
+	// log in its block
+	TransactionIndex uint
+
+	// LogIndex is the index of a log in its block
+	LogIndex uint
+
+	// Address is the address that emitted a log
+	Address string
+
+	// Event is the name of the event of a log decoded with
+	// the ABI registered for the address that emitted it
+	Event string
+
+	// Args are the arguments of the event of a decoded log
+	Args []EventArg
+}
+
+// RetractedEvent is the event that a subscription delivers when a
+// log for which it delivered a DataEvent is removed from the chain
+// by a reorg
+type RetractedEvent struct {
+	// ID to identify the event itself within the sequence of events.
+	ID uint64
+
+	// RetractedID is the ID of the DataEvent of the removed log
+	RetractedID uint64
+
+	// BlockNumber is the number of the block of the removed log
+	BlockNumber uint64
+}
+
+// EventArg is an argument of the event of a decoded log
+type EventArg struct {
+	// Name of the argument
+	Name string
+
+	// Type of the argument as defined in the ABI
+	Type string
+
+	// Indexed is true if the argument is logged as a topic
+	Indexed bool
+
+	// Value of the argument formatted as a string
+	Value string
+}
+
+// RegisterAbiRequest is a request to register the ABI of
+// the contract at an address to decode its logs
+type RegisterAbiRequest struct {
+	// Address of the contract
+	Address string
+
+	// ABI of the contract in its JSON format
+	ABI string
+}
+
+// GetAbiRequest is a request to retrieve the ABI
+// registered for an address
+type GetAbiRequest struct {
+	// Address of the contract
+	Address string
+}
+
+// GetAbiResponse is the response to GetAbiRequest
+type GetAbiResponse struct {
+	// Address of the contract
+	Address string
+
+	// ABI of the contract in its JSON format
+	ABI string
+}
+
+// RemoveAbiRequest is a request to remove the ABI
+// registered for an address
+type RemoveAbiRequest struct {
+	// Address of the contract
+	Address string
 }
 
 // EventID is the implementation of Event for ExecuteServiceResponse
@@ -280,6 +697,16 @@ func (e ErrorEvent) EventType() EventType {
 	return ErrorEventType
 }
 
+// EventID is the implementation of Event for LateResultEvent
+func (e LateResultEvent) EventID() uint64 {
+	return e.ID
+}
+
+// EventType is the implementation of Event for LateResultEvent
+func (e LateResultEvent) EventType() EventType {
+	return LateResultEventType
+}
+
 // EventID is the implementation of rpc.Event for DataEvent
 func (e DataEvent) EventID() uint64 {
 	return e.ID
@@ -290,6 +717,16 @@ func (e DataEvent) EventType() EventType {
 	return DataEventType
 }
 
+// EventID is the implementation of Event for RetractedEvent
+func (e RetractedEvent) EventID() uint64 {
+	return e.ID
+}
+
+// EventType is the implementation of Event for RetractedEvent
+func (e RetractedEvent) EventType() EventType {
+	return RetractedEventType
+}
+
 // PollServiceRequest is a request issued by a client to
 // retrieve a window of responses generated by
 // asynchronous requests
@@ -307,27 +744,67 @@ type PollServiceRequest struct {
 	// discard all the events that have a sequence number lower than the offer
 	DiscardPrevious bool
 
+	// Wait is the maximum time the request waits for events to be
+	// available if there are none at the requested offset
+	Wait time.Duration
+
+	// Key is the identifier of the request issuer
+	SessionKey string
+}
+
+// DiscardServiceRequest is a request issued by the client to
+// discard the events from the service queue it already received
+type DiscardServiceRequest struct {
+	// Offset before which all the events are discarded
+	Offset uint64
+
 	// Key is the identifier of the request issuer
 	SessionKey string
 }
 
+// DiscardEventRequest is a request issued by the client to
+// discard the events from a subscription it already received
+type DiscardEventRequest struct {
+	// Offset before which all the events are discarded
+	Offset uint64
+
+	// ID is the unique identifier for a subscription based on
+	// the user's key namespace
+	ID uint64
+
+	// Key is the identifier of the session
+	SessionKey string
+}
+
 // SubscribeRequest is a request issued by the client to subscribe to a
 // specific event type and receive events from it until the subscription is
 // closed
 type SubscribeRequest struct {
-	// Event is the subscription event to subscribe to
-	Event string
+	// Events are the event types to subscribe to. The events of
+	// all of them are delivered through the same subscription
+	Events []string
 
-	// Address will be used to filter events only issues by or to
-	// the address
-	Address string
+	// Addresses will be used to filter logs only emitted by any
+	// of the addresses. If empty, logs from all addresses match
+	Addresses []string
 
 	// Key is the identifier of the session
 	SessionKey string
 
-	// Topics is the list of topics the subscription client is
-	// interested in
-	Topics []string
+	// Topics are the topics the subscription client is interested
+	// in by position. A log matches if each of its topics is one of
+	// the topics at the same position. An empty position matches
+	// any topic
+	Topics [][]string
+
+	// FromBlock is the block from which the past logs are delivered
+	// before the new ones. If 0, only new logs are delivered
+	FromBlock uint64
+
+	// Confirmations is the number of blocks that need to be added
+	// on top of the block of a log before the log is delivered. If 0,
+	// logs are delivered as soon as they are emitted
+	Confirmations uint64
 }
 
 // PollEventRequest is a request issued by the client to
@@ -346,6 +823,10 @@ type PollEventRequest struct {
 	// discard all the events that have a sequence number lower than the offer
 	DiscardPrevious bool
 
+	// Wait is the maximum time the request waits for events to be
+	// available if there are none at the requested offset
+	Wait time.Duration
+
 	// ID is the unique identifier for a subscription based on
 	// the user's key namespace
 	ID uint64
@@ -366,21 +847,93 @@ type UnsubscribeRequest struct {
 	SessionKey string
 }
 
+// ListSubscriptionsRequest is a request issued by the client to
+// list the subscriptions owned by its session
+type ListSubscriptionsRequest struct {
+	// Key is the identifier of the session
+	SessionKey string
+}
+
+// ListSubscriptionsResponse is the response to ListSubscriptionsRequest
+type ListSubscriptionsResponse struct {
+	// Subscriptions owned by the session ordered by their ID
+	Subscriptions []SubscriptionInfo
+}
+
+// SubscriptionInfo describes a subscription owned by a session
+type SubscriptionInfo struct {
+	// ID is the unique identifier for a subscription based on
+	// the user's key namespace
+	ID uint64
+
+	// Events are the event types of the subscription
+	Events []string
+
+	// Addresses the logs of the subscription are filtered by
+	Addresses []string
+
+	// Topics the logs of the subscription are filtered by
+	Topics [][]string
+
+	// FromBlock is the block from which the past logs were delivered
+	FromBlock uint64
+
+	// CreatedAt is the time at which the subscription was created
+	CreatedAt time.Time
+
+	// LastBlockNumber is the number of the block of the last event
+	// delivered by the subscription as of its last stored checkpoint.
+	// It is 0 if the subscription has not stored a checkpoint yet
+	LastBlockNumber uint64
+
+	// QueueDepth is the number of events delivered by the subscription
+	// that the client has not discarded yet
+	QueueDepth uint64
+}
+
 // CreateSubscriptionRequest is the request to subscribe to a specific
 // event type for a service
 type CreateSubscriptionRequest struct {
-	// Event is the subscription event type
-	Event string
+	// Events are the subscription event types
+	Events []string
 
-	// Address will be used to filter events only issues by or to
-	// the address
-	Address string
+	// Addresses will be used to filter logs only emitted by any
+	// of the addresses. If empty, logs from all addresses match
+	Addresses []string
 
 	// SubID is the unique subscription's identifier
 	SubID string
 
-	// Topics is the list of topics the client is interested in
-	Topics []string
+	// Topics are the topics the client is interested in by
+	// position. An empty position matches any topic
+	Topics [][]string
+
+	// FromBlock is the block from which the past logs are delivered
+	// before the new ones. If 0, only new logs are delivered
+	FromBlock uint64
+
+	// Checkpoint is the last log delivered by the subscription
+	// before it was restored. If set, the logs are delivered
+	// from the one that follows it
+	Checkpoint *Checkpoint
+
+	// Confirmations is the number of blocks that need to be added
+	// on top of the block of a log before the log is delivered
+	Confirmations uint64
+}
+
+// Checkpoint is the position of the last log
+// delivered by a subscription
+type Checkpoint struct {
+	// BlockNumber is the number of the block of the log
+	BlockNumber uint64
+
+	// Index is the index of the log within the block
+	Index uint
+
+	// Retracted is true if the log has been removed by a reorg,
+	// in which case the logs are delivered from the log itself
+	Retracted bool
 }
 
 // UnsubscribeRequest is a request issued by the client to destroy
@@ -2,9 +2,15 @@ package core
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	stderr "errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/oasislabs/oasis-gateway/errors"
 	"github.com/oasislabs/oasis-gateway/log"
 	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
@@ -17,7 +23,10 @@ import (
 type Client interface {
 	Name() string
 	Stats() stats.Metrics
+	CallContract(context.Context, CallContractRequest) (CallContractResponse, errors.Err)
+	EstimateGas(context.Context, EstimateGasRequest) (EstimateGasResponse, errors.Err)
 	GetCode(context.Context, GetCodeRequest) (GetCodeResponse, errors.Err)
+	GetReceipt(context.Context, GetReceiptRequest) (GetReceiptResponse, errors.Err)
 	GetExpiry(context.Context, GetExpiryRequest) (GetExpiryResponse, errors.Err)
 	GetPublicKey(context.Context, GetPublicKeyRequest) (GetPublicKeyResponse, errors.Err)
 	ExecuteService(context.Context, uint64, ExecuteServiceRequest) (ExecuteServiceResponse, errors.Err)
@@ -26,15 +35,78 @@ type Client interface {
 	UnsubscribeRequest(context.Context, DestroySubscriptionRequest) errors.Err
 }
 
+const (
+	// idempotencyElementType is the type of the element that keeps the
+	// ID of a request started with an idempotency key
+	idempotencyElementType = "idempotencyKey"
+
+	// idempotencyWait is the maximum time a retry waits for the request
+	// started with the same idempotency key to get its ID
+	idempotencyWait = 2 * time.Second
+
+	// idempotencyRetention is the time for which the ID of a request
+	// started with an idempotency key is kept, so that the retries
+	// within it return the same ID
+	idempotencyRetention = 24 * time.Hour
+
+	// The endpoints by which the idempotency keys are scoped
+	executeEndpoint = "execute"
+	deployEndpoint  = "deploy"
+
+	// maxSubscriptionRecords is the maximum number of stored subscription
+	// definitions that are retrieved when restoring the subscriptions
+	maxSubscriptionRecords = 1 << 16
+
+	// subinfoElementType is the type of the element that describes
+	// a subscription in the subinfo queue of its session
+	subinfoElementType = "subinfo"
+
+	// maxListedSubscriptions is the maximum number of subscriptions
+	// of a session that are listed
+	maxListedSubscriptions = 1 << 10
+
+	// maxConfirmations is the maximum number of confirmations a
+	// subscription can wait for before it delivers a log
+	maxConfirmations = 1 << 10
+)
+
+// idempotencyRecord is the element kept for a request started with
+// an idempotency key. PayloadHash identifies the payload of the
+// request, so that a key reused for another payload is rejected
+type idempotencyRecord struct {
+	ID          uint64
+	PayloadHash string
+}
+
+// subinfoRecord describes a subscription in the subinfo
+// queue of its session, so that the session can list the
+// subscriptions it owns
+type subinfoRecord struct {
+	Request   SubscribeRequest
+	CreatedAt time.Time
+}
+
 // RequestManager handles the client RPC requests. Most requests
 // are asynchronous and they are handled by returning an identifier
 // that the caller can later on query to find out the outcome
 // of the request.
 type RequestManager struct {
-	mqueue mqueue.MQueue
-	client Client
-	logger log.Logger
-	subman *SubscriptionManager
+	mqueue  mqueue.MQueue
+	client  Client
+	logger  log.Logger
+	subman  *SubscriptionManager
+	abis    *AbiRegistry
+	timeout time.Duration
+
+	// instance identifies this instance in the stored definitions
+	// of the subscriptions. It is empty if subscriptions are not
+	// restored, in which case their definitions are not stored
+	instance string
+
+	// pending keeps the requests started by this instance that
+	// have not completed yet, so that they can be cancelled
+	pendingLock sync.Mutex
+	pending     map[string]*pendingRequest
 }
 
 func (r *RequestManager) Name() string {
@@ -51,6 +123,19 @@ type RequestManagerProperties struct {
 	MQueue mqueue.MQueue
 	Client Client
 	Logger log.Logger
+
+	// RequestTimeout is the timeout of the asynchronous requests that
+	// do not set their own. If zero, those requests do not time out
+	RequestTimeout time.Duration
+
+	// CheckpointInterval is the interval at which the subscriptions
+	// store their checkpoints. If zero, a default interval is used
+	CheckpointInterval time.Duration
+
+	// RestoreSubscriptions if set stores the definitions of the
+	// subscriptions and periodically restores the ones of the
+	// instances that stopped
+	RestoreSubscriptions bool
 }
 
 // NewRequestManager creates a new instance of a request manager
@@ -67,15 +152,56 @@ func NewRequestManager(properties RequestManagerProperties) *RequestManager {
 		panic("Logger must be set")
 	}
 
-	return &RequestManager{
+	abis := NewAbiRegistry(AbiRegistryProps{
+		Context: context.Background(),
+		Logger:  properties.Logger,
+		MQueue:  properties.MQueue,
+	})
+
+	var instance string
+	if properties.RestoreSubscriptions {
+		instance = uuid.New().String()
+	}
+
+	m := &RequestManager{
 		mqueue: properties.MQueue,
 		logger: properties.Logger,
 		client: properties.Client,
+		abis:   abis,
 		subman: NewSubscriptionManager(SubscriptionManagerProps{
-			Context: context.Background(),
-			Logger:  properties.Logger,
-			MQueue:  properties.MQueue,
+			Context:            context.Background(),
+			Logger:             properties.Logger,
+			MQueue:             properties.MQueue,
+			Abis:               abis,
+			CheckpointInterval: properties.CheckpointInterval,
+			Instance:           instance,
 		}),
+		instance: instance,
+		pending:  make(map[string]*pendingRequest),
+		timeout:  properties.RequestTimeout,
+	}
+
+	if properties.RestoreSubscriptions {
+		go m.startRestoreLoop()
+	}
+
+	return m
+}
+
+// startRestoreLoop periodically restores the subscriptions of the
+// instances that stopped after this instance started
+func (m *RequestManager) startRestoreLoop() {
+	ticker := time.NewTicker(persistInterval)
+	defer ticker.Stop()
+
+	ctx := context.Background()
+	for range ticker.C {
+		if err := m.RestoreSubscriptions(ctx); err != nil {
+			m.logger.Warn(ctx, "failed to restore subscriptions", log.MapFields{
+				"call_type": "RestoreSubscriptionFailure",
+				"err":       err.Error(),
+			})
+		}
 	}
 }
 
@@ -91,6 +217,28 @@ func (m *RequestManager) GetCode(
 	return m.client.GetCode(ctx, req)
 }
 
+// CallContract runs a read-only call against a service. The call is not
+// submitted as a transaction, so it does not go through the wallets
+func (m *RequestManager) CallContract(
+	ctx context.Context,
+	req CallContractRequest,
+) (CallContractResponse, errors.Err) {
+	if len(req.Address) == 0 {
+		return CallContractResponse{}, errors.New(errors.ErrInvalidAddress, nil)
+	}
+
+	return m.client.CallContract(ctx, req)
+}
+
+// EstimateGas estimates the gas and fee the gateway would use for
+// a deploy or execute request
+func (m *RequestManager) EstimateGas(
+	ctx context.Context,
+	req EstimateGasRequest,
+) (EstimateGasResponse, errors.Err) {
+	return m.client.EstimateGas(ctx, req)
+}
+
 // GetExpiry retrieves the expiration timestamp for a specific service
 func (m *RequestManager) GetExpiry(
 	ctx context.Context,
@@ -125,29 +273,300 @@ func (m *RequestManager) ExecuteServiceAsync(
 		return 0, errors.New(errors.ErrInvalidAddress, nil)
 	}
 
-	id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: req.SessionKey})
-	if err != nil {
-		return 0, errors.New(errors.ErrQueueNext, err)
+	payload := []string{req.AAD, req.Address, req.Data}
+	return m.startRequest(ctx, req.SessionKey, executeEndpoint, req.IdempotencyKey, payload, func(id uint64) {
+		go m.doRequest(ctx, req.SessionKey, id, req.AAD, m.requestTimeout(req.Timeout),
+			func(ctx context.Context) (Event, errors.Err) {
+				return m.client.ExecuteService(ctx, id, req)
+			})
+	})
+}
+
+// ExecuteServiceBatchAsync reserves an ID for each of the items of the
+// batch in order and starts their execution. The requests are executed
+// concurrently, so they are spread across the available wallets
+func (m *RequestManager) ExecuteServiceBatchAsync(
+	ctx context.Context,
+	req ExecuteServiceBatchRequest,
+) ([]uint64, errors.Err) {
+	ids := make([]uint64, 0, len(req.Items))
+	for range req.Items {
+		id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: req.SessionKey})
+		if err != nil {
+			err := errors.New(errors.ErrQueueNext, err)
+
+			// the IDs already reserved need an event so that the
+			// session can keep polling past them
+			for _, id := range ids {
+				go m.doRequest(ctx, req.SessionKey, id, "", 0, func(context.Context) (Event, errors.Err) {
+					return nil, err
+				})
+			}
+
+			return nil, err
+		}
+
+		ids = append(ids, id)
 	}
 
-	go m.doRequest(ctx, req.SessionKey, id, func() (Event, errors.Err) { return m.client.ExecuteService(ctx, id, req) })
+	for i, item := range req.Items {
+		id, item := ids[i], item
+		timeout := m.requestTimeout(item.Request.Timeout)
+		go m.doRequest(ctx, req.SessionKey, id, item.Request.AAD, timeout, func(ctx context.Context) (Event, errors.Err) {
+			if item.Err != nil {
+				return nil, item.Err
+			}
 
-	return id, nil
+			return m.client.ExecuteService(ctx, id, item.Request)
+		})
+	}
+
+	return ids, nil
 }
 
 // RequestManager starts a request and provides an identifier for the caller to
 // find the request later on. Deploys a new service
 func (m *RequestManager) DeployServiceAsync(ctx context.Context, req DeployServiceRequest) (uint64, errors.Err) {
-	id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: req.SessionKey})
+	payload := []string{req.AAD, req.Data}
+	return m.startRequest(ctx, req.SessionKey, deployEndpoint, req.IdempotencyKey, payload, func(id uint64) {
+		go m.doRequest(ctx, req.SessionKey, id, req.AAD, m.requestTimeout(req.Timeout),
+			func(ctx context.Context) (Event, errors.Err) {
+				return m.client.DeployService(ctx, id, req)
+			})
+	})
+}
+
+// startRequest reserves an ID for a request in the session's queue and
+// starts the request. If an idempotency key is provided and a request
+// was already started with the same key for the endpoint, the ID of that
+// request is returned instead, as long as its payload is the same. The
+// key is reserved in its own queue, so that the first request to reserve
+// it is the only one that is started. The ID is kept for
+// idempotencyRetention
+func (m *RequestManager) startRequest(
+	ctx context.Context,
+	sessionKey string,
+	endpoint string,
+	idempotencyKey string,
+	payload []string,
+	start func(id uint64),
+) (uint64, errors.Err) {
+	if len(idempotencyKey) == 0 {
+		id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: sessionKey})
+		if err != nil {
+			return 0, errors.New(errors.ErrQueueNext, err)
+		}
+
+		start(id)
+		return id, nil
+	}
+
+	key := IdempotencyID(sessionKey, endpoint, idempotencyKey)
+	hash := hashPayload(payload)
+
+	// a retry of a request that has already been started
+	// finds its ID without reserving the key again
+	record, ok, derr := m.retrieveIdempotencyRecord(ctx, key)
+	if derr != nil {
+		return 0, derr
+	}
+	if ok {
+		return idempotentID(record, hash)
+	}
+
+	offset, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: key})
 	if err != nil {
 		return 0, errors.New(errors.ErrQueueNext, err)
 	}
 
-	go m.doRequest(ctx, req.SessionKey, id, func() (Event, errors.Err) { return m.client.DeployService(ctx, id, req) })
+	if offset > 0 {
+		return m.getIdempotentID(ctx, key, hash)
+	}
+
+	id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: sessionKey})
+	if err != nil {
+		// release the key so that a retry can start the request
+		if err := m.mqueue.Remove(ctx, mqueue.RemoveRequest{Key: key}); err != nil {
+			m.logger.Debug(ctx, "failed to release idempotency key", log.MapFields{
+				"call_type": "StartRequestFailure",
+				"err":       err.Error(),
+			})
+		}
+		return 0, errors.New(errors.ErrQueueNext, err)
+	}
+
+	start(id)
+
+	p, err := json.Marshal(idempotencyRecord{ID: id, PayloadHash: hash})
+	if err != nil {
+		panic("failed to marshal idempotency record")
+	}
+
+	if err := m.mqueue.Insert(ctx, mqueue.InsertRequest{
+		Key: key,
+		Element: mqueue.Element{
+			Offset: 0,
+			Value:  string(p),
+			Type:   idempotencyElementType,
+		},
+		Retention: idempotencyRetention,
+	}); err != nil {
+		// the request has already been started, so the ID is returned
+		// to the client even if retries will not find it
+		m.logger.Debug(ctx, "failed to store idempotency key", log.MapFields{
+			"call_type": "StartRequestFailure",
+			"id":        id,
+			"err":       err.Error(),
+		})
+	}
 
 	return id, nil
 }
 
+// getIdempotentID returns the ID of the request started with the
+// idempotency key. If the request has not been started yet, it waits
+// a limited time for it to be started
+func (m *RequestManager) getIdempotentID(ctx context.Context, key string, hash string) (uint64, errors.Err) {
+	record, ok, err := m.retrieveIdempotencyRecord(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ok {
+		waitCtx, cancel := context.WithTimeout(ctx, idempotencyWait)
+		err := m.mqueue.Wait(waitCtx, mqueue.WaitRequest{Key: key, Offset: 0})
+		cancel()
+		if err != nil {
+			return 0, errors.New(errors.ErrQueueWait, err)
+		}
+
+		var derr errors.Err
+		if record, ok, derr = m.retrieveIdempotencyRecord(ctx, key); derr != nil {
+			return 0, derr
+		}
+	}
+
+	if !ok {
+		return 0, errors.New(errors.ErrIdempotencyKeyInProgress, nil)
+	}
+
+	return idempotentID(record, hash)
+}
+
+// retrieveIdempotencyRecord retrieves the record stored for the
+// request started with the idempotency key. It returns false if
+// the request has not been started yet
+func (m *RequestManager) retrieveIdempotencyRecord(
+	ctx context.Context,
+	key string,
+) (idempotencyRecord, bool, errors.Err) {
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{Key: key, Offset: 0, Count: 1})
+	if err != nil {
+		return idempotencyRecord{}, false, errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	if len(els.Elements) == 0 || els.Elements[0].Offset != 0 {
+		return idempotencyRecord{}, false, nil
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(els.Elements[0].Value), &record); err != nil {
+		return idempotencyRecord{}, false, errors.New(errors.ErrInternalError, err)
+	}
+
+	return record, true, nil
+}
+
+// idempotentID returns the ID of the request of the record if
+// the request was started with the same payload
+func idempotentID(record idempotencyRecord, hash string) (uint64, errors.Err) {
+	if record.PayloadHash != hash {
+		return 0, errors.New(errors.ErrIdempotencyKeyMismatch, nil)
+	}
+
+	return record.ID, nil
+}
+
+// hashPayload returns the hash that identifies the payload of a
+// request. Each value is prefixed with its length so that different
+// payloads cannot be concatenated into the same input
+func hashPayload(values []string) string {
+	h := sha256.New()
+	for _, value := range values {
+		_, _ = fmt.Fprintf(h, "%d:%s", len(value), value)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ExecuteServiceSync starts an execute service request and waits for its
+// outcome until the provided context is done. The request itself is not
+// bound to the context, so if the wait expires the request carries on
+// and its result can be retrieved later on with the returned ID
+func (m *RequestManager) ExecuteServiceSync(
+	ctx context.Context,
+	req ExecuteServiceRequest,
+) (SyncResponse, errors.Err) {
+	if len(req.Address) == 0 {
+		return SyncResponse{}, errors.New(errors.ErrInvalidAddress, nil)
+	}
+
+	id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: req.SessionKey})
+	if err != nil {
+		return SyncResponse{}, errors.New(errors.ErrQueueNext, err)
+	}
+
+	return m.waitRequest(ctx, req.SessionKey, id, req.AAD, m.requestTimeout(req.Timeout),
+		func(ctx context.Context) (Event, errors.Err) {
+			return m.client.ExecuteService(ctx, id, req)
+		}), nil
+}
+
+// DeployServiceSync starts a deploy service request and waits for its
+// outcome until the provided context is done. The request itself is not
+// bound to the context, so if the wait expires the request carries on
+// and its result can be retrieved later on with the returned ID
+func (m *RequestManager) DeployServiceSync(
+	ctx context.Context,
+	req DeployServiceRequest,
+) (SyncResponse, errors.Err) {
+	id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: req.SessionKey})
+	if err != nil {
+		return SyncResponse{}, errors.New(errors.ErrQueueNext, err)
+	}
+
+	return m.waitRequest(ctx, req.SessionKey, id, req.AAD, m.requestTimeout(req.Timeout),
+		func(ctx context.Context) (Event, errors.Err) {
+			return m.client.DeployService(ctx, id, req)
+		}), nil
+}
+
+// waitRequest runs the request in the background and waits either for
+// its result or for the context to be done, whatever happens first
+func (m *RequestManager) waitRequest(
+	ctx context.Context,
+	key string,
+	id uint64,
+	aad string,
+	timeout time.Duration,
+	fn func(context.Context) (Event, errors.Err),
+) SyncResponse {
+	// the request needs to complete and be stored in the queue even if the
+	// caller stops waiting, so it cannot derive from the caller's context
+	reqCtx := context.Background()
+	out := make(chan Event, 1)
+	go func() {
+		out <- m.doRequest(reqCtx, key, id, aad, timeout, fn)
+	}()
+
+	select {
+	case ev := <-out:
+		return SyncResponse{ID: id, Event: ev}
+	case <-ctx.Done():
+		return SyncResponse{ID: id}
+	}
+}
+
 // Unsubscribe from an existing subscription freeing all the associated
 // resources. After this operation all events from the subscription stream
 // will be lost.
@@ -168,14 +587,17 @@ func (m *RequestManager) Unsubscribe(ctx context.Context, req UnsubscribeRequest
 	}
 
 	subID := SubID(req.SessionKey, req.ID)
-	if !m.subman.Exists(ctx, subID) {
+	sub, ok := m.subman.Get(ctx, subID)
+	if !ok {
 		return errors.New(errors.ErrSubscriptionNotFound, stderr.New("cannot unsubscribe from subscription that does not exist"))
 	}
 
-	if err := m.client.UnsubscribeRequest(ctx, DestroySubscriptionRequest{
-		SubID: subID,
-	}); err != nil {
-		return err
+	if len(clientEvents(sub.Events)) > 0 {
+		if err := m.client.UnsubscribeRequest(ctx, DestroySubscriptionRequest{
+			SubID: subID,
+		}); err != nil {
+			return err
+		}
 	}
 
 	return m.subman.Destroy(ctx, subID)
@@ -188,6 +610,15 @@ func (m *RequestManager) Subscribe(ctx context.Context, req SubscribeRequest) (u
 		return 0, errors.New(errors.ErrInvalidKey, stderr.New("key cannot be empty"))
 	}
 
+	if err := validateEvents(req.Events); err != nil {
+		return 0, err
+	}
+
+	if req.Confirmations > maxConfirmations {
+		return 0, errors.New(errors.ErrInvalidConfirmations,
+			fmt.Errorf("confirmations cannot be more than %d", maxConfirmations))
+	}
+
 	// use a queue per subscription to manage the number of queues created. This
 	// also helps us with managing the resources a specific client is using
 	key := SubinfoID(req.SessionKey)
@@ -196,50 +627,752 @@ func (m *RequestManager) Subscribe(ctx context.Context, req SubscribeRequest) (u
 		return 0, errors.New(errors.ErrQueueNext, err)
 	}
 
+	if err := m.insertSubinfo(ctx, key, id, req); err != nil {
+		return 0, err
+	}
+
 	if err := m.subscribe(ctx, id, req); err != nil {
+		if err := m.mqueue.Discard(ctx, mqueue.DiscardRequest{
+			KeepPrevious: true,
+			Count:        1,
+			Offset:       id,
+			Key:          key,
+		}); err != nil {
+			m.logger.Debug(ctx, "failed to discard subscription info", log.MapFields{
+				"call_type": "SubscribeFailure",
+				"id":        id,
+			}, errors.New(errors.ErrQueueDiscard, err))
+		}
 		return 0, err
 	}
 
 	return id, nil
 }
 
+// insertSubinfo sets the element reserved for the subscription in the
+// subinfo queue of the session with the description of the subscription
+func (m *RequestManager) insertSubinfo(ctx context.Context, key string, id uint64, req SubscribeRequest) errors.Err {
+	p, err := json.Marshal(subinfoRecord{Request: req, CreatedAt: time.Now()})
+	if err != nil {
+		return errors.New(errors.ErrInternalError, err)
+	}
+
+	if err := m.mqueue.Insert(ctx, mqueue.InsertRequest{
+		Key: key,
+		Element: mqueue.Element{
+			Offset: id,
+			Type:   subinfoElementType,
+			Value:  string(p),
+		},
+	}); err != nil {
+		return errors.New(errors.ErrQueueInsert, err)
+	}
+
+	return nil
+}
+
 func (m *RequestManager) subscribe(ctx context.Context, id uint64, req SubscribeRequest) errors.Err {
 	subID := SubID(req.SessionKey, id)
 	// TODO(stan): a request manager should have a context from which the subscription contexts
 	// should derive
 	c := make(chan interface{}, 64)
-	if err := m.subman.Create(ctx, subID, c); err != nil {
+	if err := m.subman.Create(ctx, subID, req, c); err != nil {
 		return err
 	}
 
-	if err := m.client.SubscribeRequest(ctx, CreateSubscriptionRequest{
-		Event:   req.Event,
-		Address: req.Address,
-		SubID:   subID,
-		Topics:  req.Topics,
-	}, c); err != nil {
+	if err := m.subscribeClient(ctx, subID, req, nil, c); err != nil {
+		if err := m.subman.Destroy(ctx, subID); err != nil {
+			m.logger.Debug(ctx, "failed to destroy subscription", log.MapFields{
+				"call_type": "SubscribeFailure",
+				"key":       subID,
+			}, err)
+		}
 		return err
 	}
 
 	return nil
 }
 
-func (m *RequestManager) doRequest(ctx context.Context, key string, id uint64, fn func() (Event, errors.Err)) {
-	// TODO(stan): we should handle the case in which the request takes too long
-	ev, err := fn()
+// subscribeClient creates the subscription to the events that are
+// provided by the client, if the request subscribes to any
+func (m *RequestManager) subscribeClient(
+	ctx context.Context,
+	subID string,
+	req SubscribeRequest,
+	checkpoint *Checkpoint,
+	c chan<- interface{},
+) errors.Err {
+	// the transactions of the session are published by the manager
+	// itself, the rest of the events are provided by the client
+	events := clientEvents(req.Events)
+	if len(events) == 0 {
+		return nil
+	}
+
+	return m.client.SubscribeRequest(ctx, CreateSubscriptionRequest{
+		Events:        events,
+		Addresses:     req.Addresses,
+		SubID:         subID,
+		Topics:        req.Topics,
+		FromBlock:     req.FromBlock,
+		Checkpoint:    checkpoint,
+		Confirmations: req.Confirmations,
+	}, c)
+}
+
+// ListSubscriptions returns the subscriptions owned by the session, as
+// described in its subinfo queue. The last block a subscription delivered
+// an event for is taken from its stored checkpoint, so that it is reported
+// regardless of the instance that runs the subscription
+func (m *RequestManager) ListSubscriptions(
+	ctx context.Context,
+	req ListSubscriptionsRequest,
+) (ListSubscriptionsResponse, errors.Err) {
+	if len(req.SessionKey) == 0 {
+		return ListSubscriptionsResponse{}, errors.New(errors.ErrInvalidKey, stderr.New("key cannot be empty"))
+	}
+
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    SubinfoID(req.SessionKey),
+		Offset: 0,
+		Count:  maxListedSubscriptions,
+	})
 	if err != nil {
+		return ListSubscriptionsResponse{}, errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	subs := make([]SubscriptionInfo, 0, len(els.Elements))
+	for _, el := range els.Elements {
+		if el.Type != subinfoElementType {
+			continue
+		}
+
+		var record subinfoRecord
+		if err := json.Unmarshal([]byte(el.Value), &record); err != nil {
+			m.logger.Warn(ctx, "failed to deserialize subscription info", log.MapFields{
+				"call_type": "ListSubscriptionsFailure",
+				"offset":    el.Offset,
+				"err":       err.Error(),
+			})
+			continue
+		}
+
+		subID := SubID(req.SessionKey, el.Offset)
+		info := SubscriptionInfo{
+			ID:        el.Offset,
+			Events:    record.Request.Events,
+			Addresses: record.Request.Addresses,
+			Topics:    record.Request.Topics,
+			FromBlock: record.Request.FromBlock,
+			CreatedAt: record.CreatedAt,
+		}
+
+		stored, derr := m.loadCheckpoint(ctx, subID)
+		if derr != nil {
+			return ListSubscriptionsResponse{}, derr
+		}
+		if stored.Checkpoint != nil {
+			info.LastBlockNumber = stored.Checkpoint.BlockNumber
+		}
+
+		depth, err := m.mqueue.Count(ctx, mqueue.CountRequest{Key: subID})
+		if err != nil {
+			return ListSubscriptionsResponse{}, errors.New(errors.ErrQueueCount, err)
+		}
+
+		info.QueueDepth = depth
+		subs = append(subs, info)
+	}
+
+	return ListSubscriptionsResponse{Subscriptions: subs}, nil
+}
+
+// RestoreSubscriptions re-creates the subscriptions whose definitions
+// are stored in the mailbox by instances that stopped, so that they
+// resume delivering logs from their checkpoints. The definitions whose
+// lease has not expired are skipped, since their instances still run
+// them. It does nothing unless the manager restores subscriptions
+func (m *RequestManager) RestoreSubscriptions(ctx context.Context) errors.Err {
+	if len(m.instance) == 0 {
+		return nil
+	}
+
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    SubscriptionsID(),
+		Offset: 0,
+		Count:  maxSubscriptionRecords,
+	})
+	if err != nil {
+		return errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	var keys []string
+	records := make(map[string]subscriptionRecord)
+	offsets := make(map[string]uint64)
+	for _, el := range els.Elements {
+		if el.Type != subscriptionElementType {
+			continue
+		}
+
+		var record subscriptionRecord
+		if err := json.Unmarshal([]byte(el.Value), &record); err != nil {
+			m.logger.Warn(ctx, "failed to deserialize subscription", log.MapFields{
+				"call_type": "RestoreSubscriptionFailure",
+				"offset":    el.Offset,
+				"err":       err.Error(),
+			})
+			continue
+		}
+
+		// a subscription stores its new definition before it discards the
+		// previous one, so if it stopped in between only the latest is kept
+		if offset, ok := offsets[record.Key]; ok {
+			m.discardSubscriptionRecord(ctx, offset)
+		} else {
+			keys = append(keys, record.Key)
+		}
+
+		records[record.Key] = record
+		offsets[record.Key] = el.Offset
+	}
+
+	now := time.Now()
+	for _, key := range keys {
+		if records[key].Expiry.After(now) || m.subman.Exists(ctx, key) {
+			continue
+		}
+
+		if !m.claimSubscription(ctx, key, offsets[key]) {
+			continue
+		}
+
+		checkpoint, err := m.loadCheckpoint(ctx, key)
+		if err != nil {
+			m.logger.Warn(ctx, "failed to load subscription checkpoint", log.MapFields{
+				"call_type": "RestoreSubscriptionFailure",
+				"key":       key,
+				"err":       err.Error(),
+			})
+			m.releaseSubscription(ctx, key, offsets[key])
+			continue
+		}
+
+		if err := m.restoreSubscription(ctx, records[key], offsets[key], checkpoint); err != nil {
+			m.logger.Warn(ctx, "failed to restore subscription", log.MapFields{
+				"call_type": "RestoreSubscriptionFailure",
+				"key":       key,
+				"err":       err.Error(),
+			})
+			m.releaseSubscription(ctx, key, offsets[key])
+			continue
+		}
+
+		m.logger.Debug(ctx, "", log.MapFields{
+			"call_type": "RestoreSubscriptionSuccess",
+			"key":       key,
+		})
+	}
+
+	return nil
+}
+
+// claimSubscription reserves the restoration of the subscription from
+// the definition stored at the offset. It returns false if another
+// instance reserved it first
+func (m *RequestManager) claimSubscription(ctx context.Context, key string, offset uint64) bool {
+	claim, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: SubscriptionClaimID(key, offset)})
+	if err != nil {
+		m.logger.Warn(ctx, "failed to claim subscription", log.MapFields{
+			"call_type": "RestoreSubscriptionFailure",
+			"key":       key,
+			"err":       err.Error(),
+		})
+		return false
+	}
+
+	return claim == 0
+}
+
+// releaseSubscription removes the claim on a subscription that
+// failed to be restored, so that it can be restored later on
+func (m *RequestManager) releaseSubscription(ctx context.Context, key string, offset uint64) {
+	if err := m.mqueue.Remove(ctx, mqueue.RemoveRequest{Key: SubscriptionClaimID(key, offset)}); err != nil {
+		m.logger.Warn(ctx, "failed to release subscription claim", log.MapFields{
+			"call_type": "RestoreSubscriptionFailure",
+			"key":       key,
+			"err":       err.Error(),
+		})
+	}
+}
+
+// restoreSubscription re-creates a subscription from its stored
+// definition. The client subscription is created first, so that if it
+// fails the definition is kept and it can be restored later on
+func (m *RequestManager) restoreSubscription(
+	ctx context.Context,
+	record subscriptionRecord,
+	offset uint64,
+	checkpoint storedCheckpoint,
+) errors.Err {
+	c := make(chan interface{}, 64)
+	if err := m.subscribeClient(ctx, record.Key, record.Request, checkpoint.Checkpoint, c); err != nil {
+		return err
+	}
+
+	if err := m.subman.restore(ctx, record, offset, checkpoint, c); err != nil {
+		if len(clientEvents(record.Request.Events)) > 0 {
+			if err := m.client.UnsubscribeRequest(ctx, DestroySubscriptionRequest{
+				SubID: record.Key,
+			}); err != nil {
+				m.logger.Debug(ctx, "failed to destroy client subscription", log.MapFields{
+					"call_type": "RestoreSubscriptionFailure",
+					"key":       record.Key,
+				}, err)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// storedCheckpoint is the checkpoint stored by a subscription
+// along with the offset of the element that keeps it
+type storedCheckpoint struct {
+	Checkpoint *Checkpoint
+	Delivered  []deliveredRecord
+	Offset     *uint64
+}
+
+// loadCheckpoint retrieves the checkpoint stored last by the
+// subscription. A subscription stores its new checkpoint before it
+// discards the previous one, so if it stopped in between the previous
+// one is discarded. If no checkpoint is stored, the returned checkpoint
+// is empty
+func (m *RequestManager) loadCheckpoint(ctx context.Context, key string) (storedCheckpoint, errors.Err) {
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    CheckpointID(key),
+		Offset: 0,
+		Count:  maxCheckpointRecords,
+	})
+	if err != nil {
+		return storedCheckpoint{}, errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	var stored storedCheckpoint
+	for _, el := range els.Elements {
+		if el.Type != checkpointElementType {
+			continue
+		}
+
+		var record checkpointRecord
+		if err := json.Unmarshal([]byte(el.Value), &record); err != nil {
+			m.logger.Warn(ctx, "failed to deserialize checkpoint", log.MapFields{
+				"call_type": "LoadCheckpointFailure",
+				"key":       key,
+				"offset":    el.Offset,
+				"err":       err.Error(),
+			})
+			continue
+		}
+
+		if stored.Offset != nil {
+			m.discardCheckpointRecord(ctx, key, *stored.Offset)
+		}
+
+		offset := el.Offset
+		stored = storedCheckpoint{
+			Checkpoint: record.Checkpoint,
+			Delivered:  record.Delivered,
+			Offset:     &offset,
+		}
+	}
+
+	return stored, nil
+}
+
+// discardCheckpointRecord discards a stored checkpoint
+// that has been replaced by a later one
+func (m *RequestManager) discardCheckpointRecord(ctx context.Context, key string, offset uint64) {
+	if err := m.mqueue.Discard(ctx, mqueue.DiscardRequest{
+		Key:          CheckpointID(key),
+		KeepPrevious: true,
+		Count:        1,
+		Offset:       offset,
+	}); err != nil {
+		m.logger.Warn(ctx, "failed to discard checkpoint", log.MapFields{
+			"call_type": "LoadCheckpointFailure",
+			"key":       key,
+			"offset":    offset,
+			"err":       err.Error(),
+		})
+	}
+}
+
+// discardSubscriptionRecord discards a stored subscription
+// definition that has been replaced by a later one
+func (m *RequestManager) discardSubscriptionRecord(ctx context.Context, offset uint64) {
+	if err := m.mqueue.Discard(ctx, mqueue.DiscardRequest{
+		Key:          SubscriptionsID(),
+		KeepPrevious: true,
+		Count:        1,
+		Offset:       offset,
+	}); err != nil {
+		m.logger.Warn(ctx, "failed to discard subscription", log.MapFields{
+			"call_type": "RestoreSubscriptionFailure",
+			"offset":    offset,
+			"err":       err.Error(),
+		})
+	}
+}
+
+// LoadAbis loads the ABIs registered in the mailbox, so that the
+// logs are decoded from startup. Afterwards, they are reloaded
+// periodically to pick up the ABIs registered by other instances
+func (m *RequestManager) LoadAbis(ctx context.Context) errors.Err {
+	return m.abis.Load(ctx)
+}
+
+// RegisterAbi registers the ABI of the contract at an address, so that
+// the logs it emits are delivered to subscriptions decoded
+func (m *RequestManager) RegisterAbi(ctx context.Context, req RegisterAbiRequest) errors.Err {
+	return m.abis.Register(ctx, req.Address, req.ABI)
+}
+
+// GetAbi returns the ABI registered for an address
+func (m *RequestManager) GetAbi(ctx context.Context, req GetAbiRequest) (GetAbiResponse, errors.Err) {
+	raw, err := m.abis.Get(ctx, req.Address)
+	if err != nil {
+		return GetAbiResponse{}, err
+	}
+
+	return GetAbiResponse{Address: req.Address, ABI: raw}, nil
+}
+
+// RemoveAbi removes the ABI registered for an address. The logs
+// it emits are no longer decoded
+func (m *RequestManager) RemoveAbi(ctx context.Context, req RemoveAbiRequest) errors.Err {
+	return m.abis.Remove(ctx, req.Address)
+}
+
+// validateEvents checks that the event types of a subscription are
+// supported and that none of them is set more than once
+func validateEvents(events []string) errors.Err {
+	if len(events) == 0 {
+		return errors.New(errors.ErrEmptyInput, stderr.New("no events set on request"))
+	}
+
+	seen := make(map[string]bool, len(events))
+	for _, event := range events {
+		switch event {
+		case LogsEvent, NewHeadsEvent, TransactionsEvent:
+		default:
+			return errors.New(errors.ErrEventTypeNotSupported, fmt.Errorf("unsupported event type %s", event))
+		}
+
+		if seen[event] {
+			return errors.New(errors.ErrDuplicateEventType, fmt.Errorf("event type %s set more than once", event))
+		}
+		seen[event] = true
+	}
+
+	return nil
+}
+
+// clientEvents returns the event types of a subscription
+// that are provided by the client
+func clientEvents(events []string) []string {
+	var res []string
+	for _, event := range events {
+		if event != TransactionsEvent {
+			res = append(res, event)
+		}
+	}
+
+	return res
+}
+
+// publishTransaction delivers the transaction of a committed request to
+// the subscriptions of the session to TransactionsEvent. The subscriptions
+// are found in the subinfo queue of the session and the event is inserted
+// in their queues directly, so that it is delivered regardless of the
+// instance that runs them
+func (m *RequestManager) publishTransaction(ctx context.Context, key string, ev Event) {
+	var data DataEvent
+	switch ev := ev.(type) {
+	case ExecuteServiceResponse:
+		data = DataEvent{Data: ev.TransactionHash, BlockNumber: ev.BlockNumber, TransactionHash: ev.TransactionHash}
+	case DeployServiceResponse:
+		data = DataEvent{Data: ev.TransactionHash, BlockNumber: ev.BlockNumber, TransactionHash: ev.TransactionHash}
+	default:
+		return
+	}
+
+	if len(data.TransactionHash) == 0 {
+		return
+	}
+	data.Type = TransactionsEvent
+
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    SubinfoID(key),
+		Offset: 0,
+		Count:  maxListedSubscriptions,
+	})
+	if err != nil {
+		m.logger.Warn(ctx, "failed to retrieve subscriptions of session", log.MapFields{
+			"call_type": "PublishTransactionFailure",
+			"hash":      data.TransactionHash,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	for _, el := range els.Elements {
+		if el.Type != subinfoElementType {
+			continue
+		}
+
+		var record subinfoRecord
+		if err := json.Unmarshal([]byte(el.Value), &record); err != nil {
+			m.logger.Warn(ctx, "failed to deserialize subscription info", log.MapFields{
+				"call_type": "PublishTransactionFailure",
+				"offset":    el.Offset,
+				"err":       err.Error(),
+			})
+			continue
+		}
+
+		if !subscribedTo(record.Request.Events, TransactionsEvent) {
+			continue
+		}
+
+		m.insertDataEvent(ctx, SubID(key, el.Offset), data)
+	}
+}
+
+// insertDataEvent inserts the event at the next offset of the
+// queue of a subscription
+func (m *RequestManager) insertDataEvent(ctx context.Context, subID string, data DataEvent) {
+	id, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: subID})
+	if err != nil {
+		m.logger.Warn(ctx, "failed to find next resource for event", log.MapFields{
+			"call_type": "PublishTransactionFailure",
+			"key":       subID,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	data.ID = id
+	el, err := makeElement(data, id)
+	if err != nil {
+		m.logger.Warn(ctx, "failed to serialize event", log.MapFields{
+			"call_type": "PublishTransactionFailure",
+			"key":       subID,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	if err := m.mqueue.Insert(ctx, mqueue.InsertRequest{Key: subID, Element: el}); err != nil {
+		m.logger.Warn(ctx, "failed to insert event to resource", log.MapFields{
+			"call_type": "PublishTransactionFailure",
+			"key":       subID,
+			"err":       err.Error(),
+		})
+	}
+}
+
+// subscribedTo returns true if the event type is
+// one of the event types of a subscription
+func subscribedTo(events []string, event string) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestTimeout returns the timeout of a request, which is the default
+// timeout of the manager if the request does not set one
+func (m *RequestManager) requestTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+
+	return m.timeout
+}
+
+// requestOutcome is the outcome of the execution of a request
+type requestOutcome struct {
+	ev  Event
+	err errors.Err
+}
+
+// doRequest executes the request and stores its outcome in the queue.
+// The status transitions of the request are recorded as it progresses,
+// along with the AAD for which its transaction is submitted.
+//
+// If the request does not complete before its timeout, an ErrorEvent is
+// stored as its outcome, so that the session can poll past its ID. The
+// request carries on and, if its transaction commits, its result is
+// stored as a LateResultEvent
+func (m *RequestManager) doRequest(
+	ctx context.Context,
+	key string,
+	id uint64,
+	aad string,
+	timeout time.Duration,
+	fn func(context.Context) (Event, errors.Err),
+) Event {
+	statusKey := StatusID(key, id)
+	reqCtx, cancel := context.WithCancel(ctx)
+	pending := m.addPending(statusKey, CancelID(key, id), cancel)
+
+	reporter := &statusRecorder{
+		logger:  m.logger,
+		mqueue:  m.mqueue,
+		key:     statusKey,
+		aad:     aad,
+		pending: pending,
+	}
+	reporter.start(ctx)
+	reporter.ReportStatus(ctx, RequestStatus{State: RequestQueued})
+
+	// the request remains pending, and can be cancelled, until it
+	// completes, even if it completes after its timeout. Its status
+	// transitions are all recorded by then
+	done := func() {
+		reporter.stop()
+		m.removePending(statusKey)
+		cancel()
+	}
+
+	out := make(chan requestOutcome, 1)
+	go func() {
+		ev, err := fn(WithStatusReporter(reqCtx, reporter))
+		out <- requestOutcome{ev: ev, err: err}
+	}()
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	select {
+	case outcome := <-out:
+		defer done()
+
+		if outcome.err != nil && pending.isCancelled() {
+			outcome.err = errors.New(errors.ErrRequestCancelled, outcome.err)
+		}
+
+		return m.storeOutcome(ctx, key, id, reporter, outcome)
+	case <-expired:
+		m.logger.Debug(ctx, "request did not complete before its timeout", log.MapFields{
+			"call_type": "RequestTimeout",
+			"id":        id,
+			"timeout":   timeout.String(),
+		})
+
+		ev := m.storeOutcome(ctx, key, id, reporter, requestOutcome{
+			err: errors.New(errors.ErrRequestTimeout, nil),
+		})
+
+		go func() {
+			defer done()
+			m.storeLateResult(ctx, key, id, reporter, <-out)
+		}()
+
+		return ev
+	}
+}
+
+// storeOutcome stores the outcome of a request in the queue at the ID
+// of the request and reports its final state
+func (m *RequestManager) storeOutcome(
+	ctx context.Context,
+	key string,
+	id uint64,
+	reporter StatusReporter,
+	outcome requestOutcome,
+) Event {
+	ev := outcome.ev
+	state := RequestCommitted
+	if outcome.err != nil {
+		state = RequestFailed
 		ev = ErrorEvent{
 			ID: id,
 			Cause: rpc.Error{
-				ErrorCode:   err.ErrorCode().Code(),
-				Description: err.ErrorCode().Desc(),
+				ErrorCode:   outcome.err.ErrorCode().Code(),
+				Description: outcome.err.ErrorCode().Desc(),
 			},
 		}
 	}
 
-	el, derr := makeElement(ev, id)
-	if derr != nil {
-		panic(fmt.Sprintf("failed to marshal event %s", derr.Error()))
+	m.insertEvent(ctx, key, id, ev)
+
+	// the final state is reported once the outcome of the request
+	// is available in the queue
+	reporter.ReportStatus(ctx, RequestStatus{State: state})
+
+	if state == RequestCommitted {
+		m.publishTransaction(ctx, key, ev)
+	}
+
+	return ev
+}
+
+// storeLateResult stores the result of a request that completed after
+// its timeout. The ID of the request already has an ErrorEvent, so the
+// result is stored at a new ID as a LateResultEvent that refers to it
+func (m *RequestManager) storeLateResult(
+	ctx context.Context,
+	key string,
+	id uint64,
+	reporter StatusReporter,
+	outcome requestOutcome,
+) {
+	if outcome.err != nil {
+		m.logger.Debug(ctx, "request failed after its timeout", log.MapFields{
+			"call_type": "LateResultFailure",
+			"id":        id,
+		}, outcome.err)
+		return
+	}
+
+	lateID, err := m.mqueue.Next(ctx, mqueue.NextRequest{Key: key})
+	if err != nil {
+		m.logger.Debug(ctx, "failed to reserve id for late result", log.MapFields{
+			"call_type": "LateResultFailure",
+			"id":        id,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	late := LateResultEvent{ID: lateID, RequestID: id}
+	switch ev := outcome.ev.(type) {
+	case ExecuteServiceResponse:
+		late.Execute = &ev
+	case DeployServiceResponse:
+		late.Deploy = &ev
+	}
+
+	m.insertEvent(ctx, key, lateID, late)
+	reporter.ReportStatus(ctx, RequestStatus{State: RequestCommitted})
+	m.publishTransaction(ctx, key, outcome.ev)
+}
+
+// insertEvent inserts the event in the queue at the provided offset
+func (m *RequestManager) insertEvent(ctx context.Context, key string, offset uint64, ev Event) {
+	el, err := makeElement(ev, offset)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal event %s", err.Error()))
 	}
 
 	if err := m.mqueue.Insert(ctx, mqueue.InsertRequest{Key: key, Element: el}); err != nil {
@@ -247,10 +1380,41 @@ func (m *RequestManager) doRequest(ctx context.Context, key string, id uint64, f
 	}
 }
 
+// DiscardService discards the events of the service queue with an
+// offset lower than the provided offset, so that the resources can be
+// freed once the client has acknowledged the events
+func (m *RequestManager) DiscardService(ctx context.Context, req DiscardServiceRequest) errors.Err {
+	if len(req.SessionKey) == 0 {
+		return errors.New(errors.ErrInvalidKey, stderr.New("key cannot be empty"))
+	}
+
+	if err := m.mqueue.Discard(ctx, mqueue.DiscardRequest{Key: req.SessionKey, Offset: req.Offset}); err != nil {
+		return errors.New(errors.ErrQueueDiscard, err)
+	}
+
+	return nil
+}
+
+// DiscardEvent discards the events of a subscription with an offset
+// lower than the provided offset, so that the resources can be
+// freed once the client has acknowledged the events
+func (m *RequestManager) DiscardEvent(ctx context.Context, req DiscardEventRequest) errors.Err {
+	if len(req.SessionKey) == 0 {
+		return errors.New(errors.ErrInvalidKey, stderr.New("key cannot be empty"))
+	}
+
+	subID := SubID(req.SessionKey, req.ID)
+	if err := m.mqueue.Discard(ctx, mqueue.DiscardRequest{Key: subID, Offset: req.Offset}); err != nil {
+		return errors.New(errors.ErrQueueDiscard, err)
+	}
+
+	return nil
+}
+
 // PollService retrieves the responses the RequestManager already got
 // from the asynchronous requests.
 func (m *RequestManager) PollService(ctx context.Context, req PollServiceRequest) (Events, errors.Err) {
-	events, err := m.poll(ctx, req.SessionKey, req.Offset, req.Count, req.DiscardPrevious)
+	events, err := m.poll(ctx, req.SessionKey, req.Offset, req.Count, req.DiscardPrevious, req.Wait)
 	return events, err
 }
 
@@ -260,7 +1424,7 @@ func (m *RequestManager) PollEvent(ctx context.Context, req PollEventRequest) (E
 	subID := SubID(req.SessionKey, req.ID)
 	subinfoID := SubinfoID(req.SessionKey)
 
-	evs, err := m.poll(ctx, subID, req.Offset, req.Count, req.DiscardPrevious)
+	evs, err := m.poll(ctx, subID, req.Offset, req.Count, req.DiscardPrevious, req.Wait)
 	if err != nil {
 		return Events{}, err
 	}
@@ -291,12 +1455,33 @@ func (m *RequestManager) PollEvent(ctx context.Context, req PollEventRequest) (E
 	return evs, nil
 }
 
-func (m *RequestManager) poll(ctx context.Context, key string, offset uint64, count uint, discardPrevious bool) (Events, errors.Err) {
+func (m *RequestManager) poll(
+	ctx context.Context,
+	key string,
+	offset uint64,
+	count uint,
+	discardPrevious bool,
+	wait time.Duration,
+) (Events, errors.Err) {
 	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{Key: key, Offset: offset, Count: count})
 	if err != nil {
 		return Events{}, errors.New(errors.ErrQueueRetrieve, err)
 	}
 
+	if len(els.Elements) == 0 && wait > 0 {
+		waitCtx, cancel := context.WithTimeout(ctx, wait)
+		err := m.mqueue.Wait(waitCtx, mqueue.WaitRequest{Key: key, Offset: offset})
+		cancel()
+		if err != nil {
+			return Events{}, errors.New(errors.ErrQueueWait, err)
+		}
+
+		els, err = m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{Key: key, Offset: offset, Count: count})
+		if err != nil {
+			return Events{}, errors.New(errors.ErrQueueRetrieve, err)
+		}
+	}
+
 	if discardPrevious {
 		if err := m.mqueue.Discard(ctx, mqueue.DiscardRequest{Key: key, Offset: offset}); err != nil {
 			return Events{}, errors.New(errors.ErrQueueDiscard, err)
@@ -2,14 +2,20 @@ package core
 
 import (
 	"context"
+	"encoding/json"
+	stderr "errors"
 	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/oasislabs/oasis-gateway/errors"
 	"github.com/oasislabs/oasis-gateway/log"
 	"github.com/oasislabs/oasis-gateway/mqueue/core"
 	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
 	"github.com/oasislabs/oasis-gateway/mqueue/mailboxtest"
+	"github.com/oasislabs/oasis-gateway/rpc"
 	"github.com/oasislabs/oasis-gateway/stats"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,6 +39,42 @@ func (c *MockClient) Stats() stats.Metrics {
 	return nil
 }
 
+func (c *MockClient) CallContract(
+	ctx context.Context,
+	req CallContractRequest,
+) (CallContractResponse, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return CallContractResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(CallContractResponse), nil
+}
+
+func (c *MockClient) EstimateGas(
+	ctx context.Context,
+	req EstimateGasRequest,
+) (EstimateGasResponse, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return EstimateGasResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(EstimateGasResponse), nil
+}
+
+func (c *MockClient) GetReceipt(
+	ctx context.Context,
+	req GetReceiptRequest,
+) (GetReceiptResponse, errors.Err) {
+	args := c.Called(ctx, req)
+	if args.Get(1) != nil {
+		return GetReceiptResponse{}, args.Get(1).(errors.Err)
+	}
+
+	return args.Get(0).(GetReceiptResponse), nil
+}
+
 func (c *MockClient) GetCode(
 	ctx context.Context,
 	req GetCodeRequest,
@@ -129,13 +171,31 @@ func createRequestManager() *RequestManager {
 
 }
 
+func createRequestManagerWithRestore() *RequestManager {
+	return NewRequestManager(RequestManagerProperties{
+		MQueue:               &mailboxtest.Mailbox{},
+		Client:               &MockClient{},
+		Logger:               Logger,
+		RestoreSubscriptions: true,
+	})
+}
+
+func createRequestManagerWithCheckpointInterval(interval time.Duration) *RequestManager {
+	return NewRequestManager(RequestManagerProperties{
+		MQueue:             &mailboxtest.Mailbox{},
+		Client:             &MockClient{},
+		Logger:             Logger,
+		CheckpointInterval: interval,
+	})
+}
+
 func TestSubscribeErrNoSessionKey(t *testing.T) {
 	manager := createRequestManager()
 
 	_, err := manager.Subscribe(Context, SubscribeRequest{
-		Event:   "event",
-		Address: "address",
-		Topics:  []string{"topic1", "topic2"},
+		Events:    []string{"logs"},
+		Addresses: []string{"address"},
+		Topics:    [][]string{{"topic1"}, {"topic2"}},
 	})
 
 	assert.Equal(t, "[2011] error code InputError with desc Provided invalid key. with cause key cannot be empty", err.Error())
@@ -146,15 +206,17 @@ func TestSubscribeOK(t *testing.T) {
 
 	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
 		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
 
 	manager.client.(*MockClient).On("SubscribeRequest",
 		mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	id, err := manager.Subscribe(Context, SubscribeRequest{
-		Event:      "event",
-		Address:    "address",
+		Events:     []string{"logs", "transactions"},
+		Addresses:  []string{"address"},
 		SessionKey: "session",
-		Topics:     []string{"topic1", "topic2"},
+		Topics:     [][]string{{"topic1"}, {"topic2"}},
 	})
 
 	assert.Nil(t, err)
@@ -166,88 +228,1520 @@ func TestSubscribeOK(t *testing.T) {
 		})
 	manager.client.(*MockClient).AssertCalled(t, "SubscribeRequest",
 		mock.Anything, CreateSubscriptionRequest{
-			Event:   "event",
-			Address: "address",
-			SubID:   "session:sub:0",
-			Topics:  []string{"topic1", "topic2"},
+			Events:    []string{"logs"},
+			Addresses: []string{"address"},
+			SubID:     "session:sub:0",
+			Topics:    [][]string{{"topic1"}, {"topic2"}},
 		}, mock.Anything)
+
+	// the definition is not stored unless subscriptions are restored
+	for _, call := range manager.mqueue.(*mailboxtest.Mailbox).Calls {
+		if call.Method == "Next" {
+			assert.NotEqual(t, "subscriptions", call.Arguments.Get(1).(mqueue.NextRequest).Key)
+		}
+	}
 }
 
-func TestPollEventOKNoDiscard(t *testing.T) {
-	manager := createRequestManager()
+func TestSubscribeStoresSubscription(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+	inserted := make(chan mqueue.InsertRequest, 1)
 
-	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
-		mock.Anything, mqueue.RetrieveRequest{
-			Key:    "session:sub:0",
-			Offset: 0,
-			Count:  1,
-		}).Return(mqueue.Elements{
-		Offset: 0,
-		Elements: []core.Element{
-			{
-				Offset: 0,
-				Value:  "{\"ID\": 1, \"Data\": \"value\"}",
-				Type:   DataEventType.String(),
-			},
-		},
-	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(mqueue.InsertRequest)
+			if req.Key == "subscriptions" {
+				inserted <- req
+			}
+		}).
+		Return(nil)
 
-	evs, err := manager.PollEvent(Context, PollEventRequest{
-		Offset:          0,
-		Count:           1,
-		DiscardPrevious: false,
-		ID:              0,
-		SessionKey:      "session",
+	_, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:     []string{"transactions"},
+		SessionKey: "session",
 	})
 	assert.Nil(t, err)
-	assert.Equal(t, uint64(0), evs.Offset)
-	assert.Equal(t, DataEvent{
-		Data:   "value",
-		ID:     1,
-		Topics: nil,
-	}, evs.Events[0])
+
+	req := <-inserted
+	assert.Equal(t, uint64(0), req.Element.Offset)
+	assert.Equal(t, "subscription", req.Element.Type)
+
+	var record subscriptionRecord
+	assert.Nil(t, json.Unmarshal([]byte(req.Element.Value), &record))
+	assert.Equal(t, "session:sub:0", record.Key)
+	assert.Equal(t, SubscribeRequest{
+		Events:     []string{"transactions"},
+		SessionKey: "session",
+	}, record.Request)
+	assert.Equal(t, manager.instance, record.Instance)
+	assert.True(t, record.Expiry.After(time.Now()))
 }
 
-func TestPollEventOKDiscardSubinfo(t *testing.T) {
+func TestSubscribeErrEventType(t *testing.T) {
+	manager := createRequestManager()
+
+	_, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:     []string{"logs", "blocks"},
+		SessionKey: "session",
+	})
+
+	assert.Equal(t, errors.ErrEventTypeNotSupported, err.ErrorCode())
+}
+
+func TestSubscribeErrDuplicateEventType(t *testing.T) {
+	manager := createRequestManager()
+
+	_, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:     []string{"logs", "logs"},
+		SessionKey: "session",
+	})
+
+	assert.Equal(t, errors.ErrDuplicateEventType, err.ErrorCode())
+}
+
+func TestSubscribeTransactions(t *testing.T) {
 	manager := createRequestManager()
+	inserted := make(chan mqueue.InsertRequest, 1)
 
+	// the subscriptions of the session are only found in its subinfo
+	// queue, since they may be run by another instance
 	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
-		mock.Anything, mqueue.RetrieveRequest{
-			Key:    "session:sub:0",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:subinfo", Count: maxListedSubscriptions}).
+		Return(mqueue.Elements{Elements: []mqueue.Element{
+			{Offset: 0, Type: "subinfo", Value: "{\"Request\":{\"Events\":[\"transactions\"]," +
+				"\"SessionKey\":\"session\"},\"CreatedAt\":\"2019-01-01T00:00:00Z\"}"},
+			{Offset: 1, Type: "subinfo", Value: "{\"Request\":{\"Events\":[\"logs\"]," +
+				"\"SessionKey\":\"session\"},\"CreatedAt\":\"2019-01-01T00:00:00Z\"}"},
+		}}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(mqueue.InsertRequest)
+			if strings.HasPrefix(req.Key, "session:sub:") {
+				inserted <- req
+			}
+		}).
+		Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(0), mock.Anything).
+		Return(ExecuteServiceResponse{ID: 0, TransactionHash: "0x01", BlockNumber: 2}, nil)
+
+	_, err := manager.ExecuteServiceSync(Context, ExecuteServiceRequest{
+		Address:    "address",
+		SessionKey: "session",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session:sub:0",
+		Element: mqueue.Element{
 			Offset: 0,
-			Count:  1,
-		}).Return(mqueue.Elements{
-		Offset:   0,
-		Elements: nil,
-	}, nil)
-	manager.mqueue.(*mailboxtest.Mailbox).On("Exists",
-		mock.Anything, mqueue.ExistsRequest{Key: "session:sub:0"}).
-		Return(false, nil)
+			Type:   DataEventType.String(),
+			Value: "{\"ID\":0,\"Data\":\"0x01\",\"Topics\":null," +
+				"\"Type\":\"transactions\",\"BlockNumber\":2,\"BlockHash\":\"\"," +
+				"\"TransactionHash\":\"0x01\",\"TransactionIndex\":0,\"LogIndex\":0," +
+				"\"Address\":\"\",\"Event\":\"\",\"Args\":null}",
+		},
+	}, <-inserted)
+	assert.Empty(t, inserted)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:1"})
+}
+
+func TestSubscribeCheckpoint(t *testing.T) {
+	manager := createRequestManagerWithCheckpointInterval(time.Millisecond)
+	c := make(chan chan<- interface{}, 1)
+	inserted := make(chan mqueue.InsertRequest, 2)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:subinfo"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0"}).Return(uint64(0), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0"}).Return(uint64(1), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0:checkpoint"}).Return(uint64(0), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0:checkpoint"}).Return(uint64(1), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(mqueue.InsertRequest)
+			if req.Key == "session:sub:0:checkpoint" {
+				inserted <- req
+			}
+		}).
+		Return(nil)
 	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("SubscribeRequest",
+		mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			c <- args.Get(2).(chan<- interface{})
+		}).
+		Return(nil)
+
+	_, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:     []string{"logs"},
+		SessionKey: "session",
+	})
+	assert.Nil(t, err)
+
+	sub := <-c
+	sub <- types.Log{BlockNumber: 2, Index: 3}
+
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session:sub:0:checkpoint",
+		Element: mqueue.Element{
+			Offset: 0,
+			Type:   "checkpoint",
+			Value: "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":3,\"Retracted\":false}," +
+				"\"Delivered\":[{\"BlockHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"Index\":3,\"ID\":0,\"BlockNumber\":2}]}",
+		},
+	}, <-inserted)
+
+	sub <- types.Log{BlockNumber: 2, Index: 4}
+
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session:sub:0:checkpoint",
+		Element: mqueue.Element{
+			Offset: 1,
+			Type:   "checkpoint",
+			Value: "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":4,\"Retracted\":false}," +
+				"\"Delivered\":[{\"BlockHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"Index\":3,\"ID\":0,\"BlockNumber\":2}," +
+				"{\"BlockHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"Index\":4,\"ID\":1,\"BlockNumber\":2}]}",
+		},
+	}, <-inserted)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
 		mock.Anything, mqueue.DiscardRequest{
+			Key:          "session:sub:0:checkpoint",
 			KeepPrevious: true,
 			Count:        1,
 			Offset:       0,
-			Key:          "session:subinfo",
+		})
+
+	// the calls are for the subinfo, the two logs and the two checkpoints,
+	// the definition is not stored since subscriptions are not restored
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNumberOfCalls(t, "Next", 5)
+}
+
+func TestSubscribeErrConfirmations(t *testing.T) {
+	manager := createRequestManager()
+
+	_, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:        []string{"logs"},
+		SessionKey:    "session",
+		Confirmations: maxConfirmations + 1,
+	})
+
+	assert.Equal(t, errors.ErrInvalidConfirmations, err.ErrorCode())
+}
+
+func TestSubscribeRetractLog(t *testing.T) {
+	manager := createRequestManager()
+	c := make(chan chan<- interface{}, 1)
+	inserted := make(chan mqueue.InsertRequest, 2)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:subinfo"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0"}).Return(uint64(0), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0"}).Return(uint64(1), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0:checkpoint"}).Return(uint64(0), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(mqueue.InsertRequest)
+			if (req.Key == "session:sub:0" && req.Element.Offset == 1) ||
+				req.Key == "session:sub:0:checkpoint" {
+				inserted <- req
+			}
+		}).
+		Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("SubscribeRequest",
+		mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			c <- args.Get(2).(chan<- interface{})
 		}).
 		Return(nil)
 
-	evs, err := manager.PollEvent(Context, PollEventRequest{
-		Offset:          0,
-		Count:           1,
-		DiscardPrevious: false,
-		ID:              0,
-		SessionKey:      "session",
+	_, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:     []string{"logs"},
+		SessionKey: "session",
 	})
 	assert.Nil(t, err)
-	assert.Equal(t, uint64(0), evs.Offset)
-	assert.Equal(t, 0, len(evs.Events))
+
+	sub := <-c
+	sub <- types.Log{BlockNumber: 2, Index: 3}
+	sub <- types.Log{BlockNumber: 2, Index: 3, Removed: true}
+
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session:sub:0",
+		Element: mqueue.Element{
+			Offset: 1,
+			Type:   RetractedEventType.String(),
+			Value:  "{\"ID\":1,\"RetractedID\":0,\"BlockNumber\":2}",
+		},
+	}, <-inserted)
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session:sub:0:checkpoint",
+		Element: mqueue.Element{
+			Offset: 0,
+			Type:   "checkpoint",
+			Value:  "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":3,\"Retracted\":true}}",
+		},
+	}, <-inserted)
+}
+
+func TestUnsubscribeForgetsSubscription(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Remove",
+		mock.Anything, mock.Anything).Return(nil)
+
+	id, err := manager.Subscribe(Context, SubscribeRequest{
+		Events:     []string{"transactions"},
+		SessionKey: "session",
+	})
+	assert.Nil(t, err)
+
+	err = manager.Unsubscribe(Context, UnsubscribeRequest{ID: id, SessionKey: "session"})
+	assert.Nil(t, err)
 
 	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
 		mock.Anything, mqueue.DiscardRequest{
+			Key:          "subscriptions",
 			KeepPrevious: true,
 			Count:        1,
 			Offset:       0,
-			Key:          "session:subinfo",
+		})
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Remove",
+		mock.Anything, mqueue.RemoveRequest{Key: "session:sub:0"})
+}
+
+func TestListSubscriptionsErrNoSessionKey(t *testing.T) {
+	manager := createRequestManager()
+
+	_, err := manager.ListSubscriptions(Context, ListSubscriptionsRequest{})
+
+	assert.Equal(t, errors.ErrInvalidKey, err.ErrorCode())
+}
+
+func TestListSubscriptionsOK(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:subinfo",
+			Offset: 0,
+			Count:  maxListedSubscriptions,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []mqueue.Element{
+			{
+				Offset: 0,
+				Type:   "subinfo",
+				Value: "{\"Request\":{\"Events\":[\"logs\"],\"Addresses\":[\"address\"]," +
+					"\"SessionKey\":\"session\",\"Topics\":[[\"topic\"]],\"FromBlock\":1}," +
+					"\"CreatedAt\":\"2019-07-01T00:00:00Z\"}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:0:checkpoint",
+			Offset: 0,
+			Count:  maxCheckpointRecords,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []mqueue.Element{
+			{
+				Offset: 0,
+				Type:   "checkpoint",
+				Value:  "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":0,\"Retracted\":false}}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Count",
+		mock.Anything, mqueue.CountRequest{Key: "session:sub:0"}).Return(uint64(2), nil)
+
+	res, err := manager.ListSubscriptions(Context, ListSubscriptionsRequest{SessionKey: "session"})
+	assert.Nil(t, err)
+	assert.Equal(t, ListSubscriptionsResponse{Subscriptions: []SubscriptionInfo{
+		{
+			ID:              0,
+			Events:          []string{"logs"},
+			Addresses:       []string{"address"},
+			Topics:          [][]string{{"topic"}},
+			FromBlock:       1,
+			CreatedAt:       time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC),
+			LastBlockNumber: 2,
+			QueueDepth:      2,
+		},
+	}}, res)
+}
+
+func TestListSubscriptionsNoCheckpoint(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:subinfo",
+			Offset: 0,
+			Count:  maxListedSubscriptions,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []mqueue.Element{
+			{
+				Offset: 0,
+				Type:   "subinfo",
+				Value: "{\"Request\":{\"Events\":[\"logs\"],\"SessionKey\":\"session\"}," +
+					"\"CreatedAt\":\"2019-07-01T00:00:00Z\"}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:0:checkpoint",
+			Offset: 0,
+			Count:  maxCheckpointRecords,
+		}).Return(mqueue.Elements{Offset: 0}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Count",
+		mock.Anything, mqueue.CountRequest{Key: "session:sub:0"}).Return(uint64(0), nil)
+
+	res, err := manager.ListSubscriptions(Context, ListSubscriptionsRequest{SessionKey: "session"})
+	assert.Nil(t, err)
+	assert.Equal(t, ListSubscriptionsResponse{Subscriptions: []SubscriptionInfo{
+		{
+			ID:        0,
+			Events:    []string{"logs"},
+			CreatedAt: time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}}, res)
+}
+
+func TestRestoreSubscriptionsOK(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "subscriptions",
+			Offset: 0,
+			Count:  maxSubscriptionRecords,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Type:   "subscription",
+				Value: "{\"Key\":\"session:sub:0\",\"Request\":{\"Events\":[\"logs\"]," +
+					"\"SessionKey\":\"session\",\"FromBlock\":1}}",
+			},
+			{
+				Offset: 1,
+				Type:   "subscription",
+				Value: "{\"Key\":\"session:sub:1\",\"Request\":{\"Events\":[\"transactions\"]," +
+					"\"SessionKey\":\"session\"}}",
+			},
+			{
+				Offset: 2,
+				Type:   "subscription",
+				Value: "{\"Key\":\"session:sub:0\",\"Request\":{\"Events\":[\"logs\"]," +
+					"\"SessionKey\":\"session\",\"FromBlock\":1}," +
+					"\"Instance\":\"instance\",\"Expiry\":\"2019-01-01T00:00:00Z\"}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:0:checkpoint",
+			Offset: 0,
+			Count:  maxCheckpointRecords,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 4,
+				Type:   "checkpoint",
+				Value:  "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":2}}",
+			},
+			{
+				Offset: 5,
+				Type:   "checkpoint",
+				Value:  "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":3}}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:1:checkpoint",
+			Offset: 0,
+			Count:  maxCheckpointRecords,
+		}).Return(mqueue.Elements{Offset: 0}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0:claim:2"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:1:claim:1"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(3), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Remove",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("SubscribeRequest",
+		mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	err := manager.RestoreSubscriptions(Context)
+	assert.Nil(t, err)
+
+	assert.True(t, manager.subman.Exists(Context, "session:sub:0"))
+	assert.True(t, manager.subman.Exists(Context, "session:sub:1"))
+
+	// the subscriptions are stopped so that they have stored
+	// their definitions and discarded the restored ones
+	assert.Nil(t, manager.subman.Destroy(Context, "session:sub:0"))
+	assert.Nil(t, manager.subman.Destroy(Context, "session:sub:1"))
+
+	manager.client.(*MockClient).AssertCalled(t, "SubscribeRequest",
+		mock.Anything, CreateSubscriptionRequest{
+			Events:     []string{"logs"},
+			SubID:      "session:sub:0",
+			FromBlock:  1,
+			Checkpoint: &Checkpoint{BlockNumber: 2, Index: 3},
+		}, mock.Anything)
+	manager.client.(*MockClient).AssertNumberOfCalls(t, "SubscribeRequest", 1)
+	for _, offset := range []uint64{0, 1, 2} {
+		manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
+			mock.Anything, mqueue.DiscardRequest{
+				Key:          "subscriptions",
+				KeepPrevious: true,
+				Count:        1,
+				Offset:       offset,
+			})
+	}
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
+		mock.Anything, mqueue.DiscardRequest{
+			Key:          "session:sub:0:checkpoint",
+			KeepPrevious: true,
+			Count:        1,
+			Offset:       4,
+		})
+}
+
+func TestRestoreSubscriptionsRetractLog(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+	c := make(chan chan<- interface{}, 1)
+	inserted := make(chan mqueue.InsertRequest, 1)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "subscriptions",
+			Offset: 0,
+			Count:  maxSubscriptionRecords,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Type:   "subscription",
+				Value: "{\"Key\":\"session:sub:0\",\"Request\":{\"Events\":[\"logs\"]," +
+					"\"SessionKey\":\"session\"}}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:0:checkpoint",
+			Offset: 0,
+			Count:  maxCheckpointRecords,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Type:   "checkpoint",
+				Value: "{\"Checkpoint\":{\"BlockNumber\":2,\"Index\":3}," +
+					"\"Delivered\":[{\"BlockHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"Index\":3,\"ID\":7,\"BlockNumber\":2}]}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0"}).Return(uint64(8), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0:claim:0"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(1), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(mqueue.InsertRequest)
+			if req.Key == "session:sub:0" {
+				inserted <- req
+			}
+		}).
+		Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("SubscribeRequest",
+		mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			c <- args.Get(2).(chan<- interface{})
+		}).
+		Return(nil)
+
+	err := manager.RestoreSubscriptions(Context)
+	assert.Nil(t, err)
+
+	// the log was delivered before the subscription was restored
+	(<-c) <- types.Log{BlockNumber: 2, Index: 3, Removed: true}
+
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session:sub:0",
+		Element: mqueue.Element{
+			Offset: 8,
+			Type:   RetractedEventType.String(),
+			Value:  "{\"ID\":8,\"RetractedID\":7,\"BlockNumber\":2}",
+		},
+	}, <-inserted)
+}
+
+func TestRestoreSubscriptionsClientErr(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mock.Anything).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Type:   "subscription",
+				Value:  "{\"Key\":\"session:sub:0\",\"Request\":{\"Events\":[\"logs\"],\"SessionKey\":\"session\"}}",
+			},
+		},
+	}, nil)
+	manager.client.(*MockClient).On("SubscribeRequest",
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New(errors.ErrInternalError, nil))
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Remove",
+		mock.Anything, mock.Anything).Return(nil)
+
+	err := manager.RestoreSubscriptions(Context)
+	assert.Nil(t, err)
+
+	// the definition is kept and the claim is released
+	// so that it can be restored later on
+	assert.False(t, manager.subman.Exists(Context, "session:sub:0"))
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Discard",
+		mock.Anything, mock.Anything)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Remove",
+		mock.Anything, mqueue.RemoveRequest{Key: "session:sub:0:claim:0"})
+}
+
+func TestRestoreSubscriptionsLeaseHeld(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mock.Anything).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Type:   "subscription",
+				Value: "{\"Key\":\"session:sub:0\",\"Request\":{\"Events\":[\"logs\"],\"SessionKey\":\"session\"}," +
+					"\"Instance\":\"instance\",\"Expiry\":\"2999-01-01T00:00:00Z\"}",
+			},
+		},
+	}, nil)
+
+	err := manager.RestoreSubscriptions(Context)
+	assert.Nil(t, err)
+
+	// the instance that runs the subscription still holds it
+	assert.False(t, manager.subman.Exists(Context, "session:sub:0"))
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Next",
+		mock.Anything, mock.Anything)
+}
+
+func TestRestoreSubscriptionsClaimLost(t *testing.T) {
+	manager := createRequestManagerWithRestore()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mock.Anything).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Type:   "subscription",
+				Value: "{\"Key\":\"session:sub:0\",\"Request\":{\"Events\":[\"logs\"],\"SessionKey\":\"session\"}," +
+					"\"Instance\":\"instance\",\"Expiry\":\"2019-01-01T00:00:00Z\"}",
+			},
+		},
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:sub:0:claim:0"}).Return(uint64(1), nil)
+
+	err := manager.RestoreSubscriptions(Context)
+	assert.Nil(t, err)
+
+	// another instance claimed the subscription first
+	assert.False(t, manager.subman.Exists(Context, "session:sub:0"))
+	manager.client.(*MockClient).AssertNotCalled(t, "SubscribeRequest",
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRestoreSubscriptionsDisabled(t *testing.T) {
+	manager := createRequestManager()
+
+	err := manager.RestoreSubscriptions(Context)
+	assert.Nil(t, err)
+
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Retrieve",
+		mock.Anything, mock.Anything)
+}
+
+func TestPollEventOKNoDiscard(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:0",
+			Offset: 0,
+			Count:  1,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Value:  "{\"ID\": 1, \"Data\": \"value\"}",
+				Type:   DataEventType.String(),
+			},
+		},
+	}, nil)
+
+	evs, err := manager.PollEvent(Context, PollEventRequest{
+		Offset:          0,
+		Count:           1,
+		DiscardPrevious: false,
+		ID:              0,
+		SessionKey:      "session",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), evs.Offset)
+	assert.Equal(t, DataEvent{
+		Data:   "value",
+		ID:     1,
+		Topics: nil,
+	}, evs.Events[0])
+}
+
+func TestPollServiceOKWait(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session",
+			Offset: 0,
+			Count:  1,
+		}).Return(mqueue.Elements{Offset: 0}, nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Wait",
+		mock.Anything, mqueue.WaitRequest{
+			Key:    "session",
+			Offset: 0,
+		}).Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session",
+			Offset: 0,
+			Count:  1,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []core.Element{
+			{
+				Offset: 0,
+				Value:  "{\"ID\": 0, \"Address\": \"0x00\"}",
+				Type:   DeployServiceEventType.String(),
+			},
+		},
+	}, nil).Once()
+
+	evs, err := manager.PollService(Context, PollServiceRequest{
+		Offset:     0,
+		Count:      1,
+		Wait:       time.Second,
+		SessionKey: "session",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, DeployServiceResponse{ID: 0, Address: "0x00"}, evs.Events[0])
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNumberOfCalls(t, "Retrieve", 2)
+}
+
+func TestPollServiceErrWait(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve", mock.Anything, mock.Anything).
+		Return(mqueue.Elements{Offset: 0}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Wait", mock.Anything, mock.Anything).
+		Return(stderr.New("error"))
+
+	_, err := manager.PollService(Context, PollServiceRequest{
+		Offset:     0,
+		Count:      1,
+		Wait:       time.Second,
+		SessionKey: "session",
+	})
+	assert.Equal(t, errors.ErrQueueWait, err.ErrorCode())
+}
+
+func TestPollEventOKDiscardSubinfo(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:sub:0",
+			Offset: 0,
+			Count:  1,
+		}).Return(mqueue.Elements{
+		Offset:   0,
+		Elements: nil,
+	}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Exists",
+		mock.Anything, mqueue.ExistsRequest{Key: "session:sub:0"}).
+		Return(false, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mqueue.DiscardRequest{
+			KeepPrevious: true,
+			Count:        1,
+			Offset:       0,
+			Key:          "session:subinfo",
+		}).
+		Return(nil)
+
+	evs, err := manager.PollEvent(Context, PollEventRequest{
+		Offset:          0,
+		Count:           1,
+		DiscardPrevious: false,
+		ID:              0,
+		SessionKey:      "session",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), evs.Offset)
+	assert.Equal(t, 0, len(evs.Events))
+
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
+		mock.Anything, mqueue.DiscardRequest{
+			KeepPrevious: true,
+			Count:        1,
+			Offset:       0,
+			Key:          "session:subinfo",
+		})
+}
+
+func TestExecuteServiceSyncOK(t *testing.T) {
+	manager := createRequestManager()
+	req := ExecuteServiceRequest{
+		AAD:        "aad",
+		Data:       "data",
+		Address:    "address",
+		SessionKey: "session",
+	}
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(0), req).
+		Return(ExecuteServiceResponse{ID: 0, Address: "address", Output: "output"}, nil)
+
+	res, err := manager.ExecuteServiceSync(Context, req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{
+		ID:    0,
+		Event: ExecuteServiceResponse{ID: 0, Address: "address", Output: "output"},
+	}, res)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "session",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   ExecuteServiceEventType.String(),
+				Value: "{\"ID\":0,\"Address\":\"address\",\"Output\":\"output\"," +
+					"\"TransactionHash\":\"\",\"BlockNumber\":0,\"GasUsed\":0}",
+			},
+		})
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "session:status:0",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   statusElementType,
+				Value:  "{\"state\":\"queued\"}",
+			},
+		})
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "session:status:0",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   statusElementType,
+				Value:  "{\"state\":\"committed\"}",
+			},
+		})
+}
+
+func TestExecuteServiceSyncTimeout(t *testing.T) {
+	manager := createRequestManager()
+	done := make(chan struct{})
+	defer close(done)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(3), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(3), mock.Anything).
+		Run(func(mock.Arguments) { <-done }).
+		Return(ExecuteServiceResponse{ID: 3}, nil)
+
+	ctx, cancel := context.WithTimeout(Context, 10*time.Millisecond)
+	defer cancel()
+
+	res, err := manager.ExecuteServiceSync(ctx, ExecuteServiceRequest{
+		Address:    "address",
+		SessionKey: "session",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{ID: 3}, res)
+}
+
+func TestExecuteServiceSyncRequestTimeout(t *testing.T) {
+	manager := NewRequestManager(RequestManagerProperties{
+		MQueue:         &mailboxtest.Mailbox{},
+		Client:         &MockClient{},
+		Logger:         Logger,
+		RequestTimeout: 10 * time.Millisecond,
+	})
+	done := make(chan struct{})
+	late := make(chan mqueue.InsertRequest, 1)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(3), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(4), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(mqueue.InsertRequest)
+			if req.Element.Type == LateResultEventType.String() {
+				late <- req
+			}
+		}).
+		Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(3), mock.Anything).
+		Run(func(mock.Arguments) { <-done }).
+		Return(ExecuteServiceResponse{ID: 3, Address: "address", Output: "output"}, nil)
+
+	res, err := manager.ExecuteServiceSync(Context, ExecuteServiceRequest{
+		Address:    "address",
+		SessionKey: "session",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{
+		ID: 3,
+		Event: ErrorEvent{
+			ID: 3,
+			Cause: rpc.Error{
+				ErrorCode:   errors.ErrRequestTimeout.Code(),
+				Description: errors.ErrRequestTimeout.Desc(),
+			},
+		},
+	}, res)
+
+	close(done)
+	req := <-late
+	assert.Equal(t, mqueue.InsertRequest{
+		Key: "session",
+		Element: mqueue.Element{
+			Offset: 4,
+			Type:   LateResultEventType.String(),
+			Value: "{\"ID\":4,\"RequestID\":3,\"Execute\":{\"ID\":3,\"Address\":\"address\"," +
+				"\"Output\":\"output\",\"TransactionHash\":\"\",\"BlockNumber\":0,\"GasUsed\":0}}",
+		},
+	}, req)
+}
+
+func TestDeployServiceSyncErr(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(1), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("DeployService",
+		mock.Anything, uint64(1), mock.Anything).
+		Return(nil, errors.New(errors.ErrInternalError, nil))
+
+	res, err := manager.DeployServiceSync(Context, DeployServiceRequest{
+		Data:       "data",
+		SessionKey: "session",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{
+		ID: 1,
+		Event: ErrorEvent{
+			ID: 1,
+			Cause: rpc.Error{
+				ErrorCode:   errors.ErrInternalError.Code(),
+				Description: errors.ErrInternalError.Desc(),
+			},
+		},
+	}, res)
+}
+
+func TestDiscardEventOK(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Discard",
+		mock.Anything, mock.Anything).Return(nil)
+
+	err := manager.DiscardEvent(Context, DiscardEventRequest{
+		Offset:     5,
+		ID:         1,
+		SessionKey: "session",
+	})
+
+	assert.Nil(t, err)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Discard",
+		mock.Anything, mqueue.DiscardRequest{
+			Key:    "session:sub:1",
+			Offset: 5,
+		})
+}
+
+func TestDiscardServiceErrNoSessionKey(t *testing.T) {
+	manager := createRequestManager()
+
+	err := manager.DiscardService(Context, DiscardServiceRequest{Offset: 5})
+
+	assert.Equal(t, errors.ErrInvalidKey, err.ErrorCode())
+}
+
+func TestExecuteServiceAsyncIdempotencyKeyFirst(t *testing.T) {
+	manager := createRequestManager()
+	req := ExecuteServiceRequest{
+		AAD:            "aad",
+		Data:           "data",
+		Address:        "address",
+		SessionKey:     "session",
+		IdempotencyKey: "key",
+	}
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:idempotency:execute:key", Offset: 0, Count: 1}).
+		Return(mqueue.Elements{}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:idempotency:execute:key"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session"}).Return(uint64(3), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:status:3"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(3), req).
+		Return(ExecuteServiceResponse{ID: 3, Address: "address", Output: "output"}, nil)
+
+	id, err := manager.ExecuteServiceAsync(Context, req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), id)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "session:idempotency:execute:key",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   idempotencyElementType,
+				Value:  "{\"ID\":3,\"PayloadHash\":\"" + hashPayload([]string{"aad", "address", "data"}) + "\"}",
+			},
+			Retention: idempotencyRetention,
+		})
+}
+
+func TestExecuteServiceAsyncIdempotencyKeyRetry(t *testing.T) {
+	manager := createRequestManager()
+	hash := hashPayload([]string{"aad", "address", "data"})
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:idempotency:execute:key", Offset: 0, Count: 1}).
+		Return(mqueue.Elements{
+			Offset: 0,
+			Elements: []core.Element{{
+				Offset: 0,
+				Type:   idempotencyElementType,
+				Value:  "{\"ID\":3,\"PayloadHash\":\"" + hash + "\"}",
+			}},
+		}, nil)
+
+	id, err := manager.ExecuteServiceAsync(Context, ExecuteServiceRequest{
+		AAD:            "aad",
+		Data:           "data",
+		Address:        "address",
+		SessionKey:     "session",
+		IdempotencyKey: "key",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), id)
+
+	// the retry finds the ID without reserving anything
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Next", mock.Anything, mock.Anything)
+}
+
+func TestExecuteServiceAsyncIdempotencyKeyMismatch(t *testing.T) {
+	manager := createRequestManager()
+	hash := hashPayload([]string{"aad", "address", "data"})
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:idempotency:execute:key", Offset: 0, Count: 1}).
+		Return(mqueue.Elements{
+			Offset: 0,
+			Elements: []core.Element{{
+				Offset: 0,
+				Type:   idempotencyElementType,
+				Value:  "{\"ID\":3,\"PayloadHash\":\"" + hash + "\"}",
+			}},
+		}, nil)
+
+	_, err := manager.ExecuteServiceAsync(Context, ExecuteServiceRequest{
+		AAD:            "aad",
+		Data:           "other",
+		Address:        "address",
+		SessionKey:     "session",
+		IdempotencyKey: "key",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrIdempotencyKeyMismatch, err.ErrorCode())
+}
+
+func TestDeployServiceAsyncIdempotencyKeyScopedByEndpoint(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:idempotency:deploy:key", Offset: 0, Count: 1}).
+		Return(mqueue.Elements{}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:idempotency:deploy:key"}).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(4), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("DeployService",
+		mock.Anything, uint64(4), mock.Anything).
+		Return(DeployServiceResponse{ID: 4}, nil)
+
+	id, err := manager.DeployServiceAsync(Context, DeployServiceRequest{
+		AAD:            "aad",
+		Data:           "data",
+		SessionKey:     "session",
+		IdempotencyKey: "key",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), id)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:idempotency:execute:key", Offset: 0, Count: 1})
+}
+
+func TestDeployServiceAsyncIdempotencyKeyInProgress(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:idempotency:deploy:key"}).Return(uint64(1), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mock.Anything).Return(mqueue.Elements{Offset: 0}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Wait",
+		mock.Anything, mqueue.WaitRequest{Key: "session:idempotency:deploy:key", Offset: 0}).Return(nil)
+
+	_, err := manager.DeployServiceAsync(Context, DeployServiceRequest{
+		AAD:            "aad",
+		Data:           "data",
+		SessionKey:     "session",
+		IdempotencyKey: "key",
+	})
+
+	assert.Equal(t, errors.ErrIdempotencyKeyInProgress, err.ErrorCode())
+}
+
+func TestGetRequestStatusNotFound(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:status:1",
+			Offset: 0,
+			Count:  maxStatusTransitions,
+		}).Return(mqueue.Elements{}, nil)
+
+	_, err := manager.GetRequestStatus(Context, GetRequestStatusRequest{ID: 1, SessionKey: "session"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrRequestStatusNotFound, err.ErrorCode())
+}
+
+func TestGetRequestStatusOK(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{
+			Key:    "session:status:1",
+			Offset: 0,
+			Count:  maxStatusTransitions,
+		}).Return(mqueue.Elements{
+		Offset: 0,
+		Elements: []mqueue.Element{
+			{Offset: 0, Type: statusElementType, Value: "{\"state\":\"queued\"}"},
+			{Offset: 2, Type: statusElementType, Value: "{\"state\":\"submitted\",\"transactionHash\":\"0x01\"}"},
+			{Offset: 1, Type: statusElementType, Value: "{\"state\":\"estimating_gas\"}"},
+		},
+	}, nil)
+
+	status, err := manager.GetRequestStatus(Context, GetRequestStatusRequest{ID: 1, SessionKey: "session"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, RequestStatus{State: RequestSubmitted, TransactionHash: "0x01"}, status)
+}
+
+func TestReportStatusSubmittedRecordsOwner(t *testing.T) {
+	manager := createRequestManager()
+	reporter := &statusRecorder{
+		logger:  manager.logger,
+		mqueue:  manager.mqueue,
+		key:     "session:status:1",
+		aad:     "aad",
+		pending: &pendingRequest{mqueue: manager.mqueue, key: "session:cancel:1", cancel: func() {}},
+	}
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+
+	reporter.start(Context)
+	reporter.ReportStatus(Context, RequestStatus{State: RequestSubmitted, TransactionHash: "0xAB"})
+	reporter.stop()
+
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "receipt:0xab",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   ownerElementType,
+				Value:  "aad",
+			},
+			Retention: receiptRetention,
+		})
+}
+
+func TestReportStatusRecordedInBackground(t *testing.T) {
+	manager := createRequestManager()
+	reporter := &statusRecorder{
+		logger:  manager.logger,
+		mqueue:  manager.mqueue,
+		key:     "session:status:1",
+		pending: &pendingRequest{mqueue: manager.mqueue, key: "session:cancel:1", cancel: func() {}},
+	}
+
+	release := make(chan time.Time)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:status:1"}).
+		WaitUntil(release).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+
+	reporter.start(Context)
+	reporter.ReportStatus(Context, RequestStatus{State: RequestEstimatingGas})
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+
+	close(release)
+	reporter.stop()
+
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "session:status:1",
+			Element: mqueue.Element{
+				Offset: 0,
+				Type:   statusElementType,
+				Value:  "{\"state\":\"estimating_gas\"}",
+			},
+		})
+}
+
+func TestReportStatusSubmittingCancelled(t *testing.T) {
+	manager := createRequestManager()
+	ctx, cancel := context.WithCancel(Context)
+	defer cancel()
+
+	reporter := &statusRecorder{
+		logger:  manager.logger,
+		mqueue:  manager.mqueue,
+		key:     "session:status:1",
+		pending: &pendingRequest{mqueue: manager.mqueue, key: "session:cancel:1", cancel: cancel},
+	}
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:cancel:1"}).Return(uint64(1), nil)
+
+	reporter.Submitting(ctx)
+
+	assert.Error(t, ctx.Err())
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
+
+func TestGetReceiptInvalidHash(t *testing.T) {
+	manager := createRequestManager()
+
+	_, err := manager.GetReceipt(Context, GetReceiptRequest{Hash: "0x01", AAD: "aad"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrInvalidTransactionHash, err.ErrorCode())
+}
+
+func TestGetReceiptNotFound(t *testing.T) {
+	manager := createRequestManager()
+	hash := "0x" + strings.Repeat("0", 64)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "receipt:" + hash, Offset: 0, Count: 1}).
+		Return(mqueue.Elements{}, nil)
+
+	_, err := manager.GetReceipt(Context, GetReceiptRequest{Hash: hash, AAD: "aad"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrReceiptNotFound, err.ErrorCode())
+}
+
+func TestGetReceiptNotOwned(t *testing.T) {
+	manager := createRequestManager()
+	hash := "0x" + strings.Repeat("0", 64)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "receipt:" + hash, Offset: 0, Count: 1}).
+		Return(mqueue.Elements{
+			Elements: []mqueue.Element{{Offset: 0, Type: ownerElementType, Value: "other"}},
+		}, nil)
+
+	_, err := manager.GetReceipt(Context, GetReceiptRequest{Hash: hash, AAD: "aad"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrTransactionNotOwned, err.ErrorCode())
+	manager.client.(*MockClient).AssertNotCalled(t, "GetReceipt", mock.Anything, mock.Anything)
+}
+
+func TestGetReceiptOK(t *testing.T) {
+	manager := createRequestManager()
+	hash := "0x" + strings.Repeat("0", 64)
+	req := GetReceiptRequest{Hash: hash, AAD: "aad"}
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "receipt:" + hash, Offset: 0, Count: 1}).
+		Return(mqueue.Elements{
+			Elements: []mqueue.Element{{Offset: 0, Type: ownerElementType, Value: "aad"}},
+		}, nil)
+	manager.client.(*MockClient).On("GetReceipt", mock.Anything, req).
+		Return(GetReceiptResponse{Hash: hash, Status: 1, GasUsed: 21000, BlockNumber: 1}, nil)
+
+	res, err := manager.GetReceipt(Context, req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, GetReceiptResponse{Hash: hash, Status: 1, GasUsed: 21000, BlockNumber: 1}, res)
+}
+
+func TestCancelServiceNotPending(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:status:1", Count: maxStatusTransitions}).
+		Return(mqueue.Elements{}, nil)
+
+	err := manager.CancelService(Context, CancelServiceRequest{ID: 1, SessionKey: "session"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrRequestNotCancellable, err.ErrorCode())
+}
+
+func TestCancelServiceSubmittedState(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:status:1", Count: maxStatusTransitions}).
+		Return(mqueue.Elements{Elements: []mqueue.Element{
+			{Offset: 0, Type: statusElementType, Value: "{\"state\":\"submitted\",\"transactionHash\":\"0x01\"}"},
+		}}, nil)
+
+	err := manager.CancelService(Context, CancelServiceRequest{ID: 1, SessionKey: "session"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrRequestNotCancellable, err.ErrorCode())
+	manager.mqueue.(*mailboxtest.Mailbox).AssertNotCalled(t, "Next", mock.Anything, mock.Anything)
+}
+
+func TestCancelServiceSubmissionDecided(t *testing.T) {
+	manager := createRequestManager()
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:status:1", Count: maxStatusTransitions}).
+		Return(mqueue.Elements{Elements: []mqueue.Element{
+			{Offset: 0, Type: statusElementType, Value: "{\"state\":\"estimating_gas\"}"},
+		}}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session:cancel:1"}).Return(uint64(1), nil)
+
+	err := manager.CancelService(Context, CancelServiceRequest{ID: 1, SessionKey: "session"})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrRequestNotCancellable, err.ErrorCode())
+}
+
+func TestCancelServiceSubmitted(t *testing.T) {
+	mq := &mailboxtest.Mailbox{}
+	mq.On("Next", mock.Anything, mqueue.NextRequest{Key: "session:cancel:1"}).Return(uint64(0), nil).Once()
+	pending := &pendingRequest{mqueue: mq, key: "session:cancel:1", cancel: func() {}}
+
+	assert.True(t, pending.submit(Context))
+	assert.True(t, pending.submit(Context))
+	assert.False(t, pending.cancelRequest())
+	assert.False(t, pending.isCancelled())
+}
+
+func TestCancelServiceCancelledByOtherInstance(t *testing.T) {
+	cancelled := false
+	mq := &mailboxtest.Mailbox{}
+	mq.On("Next", mock.Anything, mqueue.NextRequest{Key: "session:cancel:1"}).Return(uint64(1), nil)
+	pending := &pendingRequest{mqueue: mq, key: "session:cancel:1", cancel: func() { cancelled = true }}
+
+	assert.False(t, pending.submit(Context))
+	assert.True(t, pending.isCancelled())
+	assert.True(t, cancelled)
+}
+
+func TestCancelServiceOK(t *testing.T) {
+	manager := createRequestManager()
+	req := ExecuteServiceRequest{
+		AAD:        "aad",
+		Data:       "data",
+		Address:    "address",
+		SessionKey: "session",
+	}
+	started := make(chan struct{})
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Retrieve",
+		mock.Anything, mqueue.RetrieveRequest{Key: "session:status:0", Count: maxStatusTransitions}).
+		Return(mqueue.Elements{Elements: []mqueue.Element{
+			{Offset: 0, Type: statusElementType, Value: "{\"state\":\"queued\"}"},
+		}}, nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(0), req).
+		Run(func(args mock.Arguments) {
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return(ExecuteServiceResponse{}, errors.New(errors.ErrSendTransaction, nil))
+
+	go func() {
+		<-started
+		assert.Nil(t, manager.CancelService(Context, CancelServiceRequest{ID: 0, SessionKey: "session"}))
+	}()
+
+	res, err := manager.ExecuteServiceSync(Context, req)
+
+	assert.Nil(t, err)
+	assert.Equal(t, SyncResponse{
+		ID: 0,
+		Event: ErrorEvent{
+			ID: 0,
+			Cause: rpc.Error{
+				ErrorCode:   errors.ErrRequestCancelled.Code(),
+				Description: errors.ErrRequestCancelled.Desc(),
+			},
+		},
+	}, res)
+}
+
+func TestExecuteServiceBatchAsyncOK(t *testing.T) {
+	manager := createRequestManager()
+	req := ExecuteServiceRequest{
+		AAD:        "aad",
+		Data:       "data",
+		Address:    "address",
+		SessionKey: "session",
+	}
+	done := make(chan struct{}, 2)
+
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session"}).Return(uint64(3), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mqueue.NextRequest{Key: "session"}).Return(uint64(4), nil).Once()
+	manager.mqueue.(*mailboxtest.Mailbox).On("Next",
+		mock.Anything, mock.Anything).Return(uint64(0), nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.MatchedBy(func(req mqueue.InsertRequest) bool {
+			return req.Key == "session"
+		})).Run(func(mock.Arguments) { done <- struct{}{} }).Return(nil)
+	manager.mqueue.(*mailboxtest.Mailbox).On("Insert",
+		mock.Anything, mock.Anything).Return(nil)
+	manager.client.(*MockClient).On("ExecuteService",
+		mock.Anything, uint64(3), req).
+		Return(ExecuteServiceResponse{ID: 3, Address: "address", Output: "output"}, nil)
+
+	ids, err := manager.ExecuteServiceBatchAsync(Context, ExecuteServiceBatchRequest{
+		Items: []ExecuteServiceBatchItem{
+			{Request: req},
+			{Err: errors.New(errors.ErrInvalidAddress, nil)},
+		},
+		SessionKey: "session",
+	})
+	<-done
+	<-done
+
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{3, 4}, ids)
+	manager.client.(*MockClient).AssertNumberOfCalls(t, "ExecuteService", 1)
+	manager.mqueue.(*mailboxtest.Mailbox).AssertCalled(t, "Insert",
+		mock.Anything, mqueue.InsertRequest{
+			Key: "session",
+			Element: mqueue.Element{
+				Offset: 4,
+				Type:   ErrorEventType.String(),
+				Value: "{\"ID\":4,\"Cause\":{\"errorCode\":2006," +
+					"\"description\":\"Provided invalid address.\"}}",
+			},
 		})
 }
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/oasislabs/oasis-gateway/errors"
+	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
+)
+
+const (
+	// ownerElementType is the type of the element that keeps the
+	// AAD that submitted a transaction
+	ownerElementType = "transactionOwner"
+
+	// transactionHashLength is the length of a hex encoded
+	// transaction hash including the 0x prefix
+	transactionHashLength = 66
+
+	// receiptRetention is the time for which the AAD that submitted
+	// a transaction is kept, and so the time for which its receipt
+	// can be retrieved through the gateway
+	receiptRetention = 7 * 24 * time.Hour
+)
+
+// recordTransactionOwner stores the AAD for which a transaction was
+// submitted, so that only the same AAD can retrieve its receipt
+func recordTransactionOwner(ctx context.Context, mq mqueue.MQueue, hash, aad string) error {
+	key := ReceiptID(strings.ToLower(hash))
+	offset, err := mq.Next(ctx, mqueue.NextRequest{Key: key})
+	if err != nil {
+		return err
+	}
+
+	return mq.Insert(ctx, mqueue.InsertRequest{
+		Key: key,
+		Element: mqueue.Element{
+			Offset: offset,
+			Value:  aad,
+			Type:   ownerElementType,
+		},
+		Retention: receiptRetention,
+	})
+}
+
+// GetReceipt retrieves the receipt of a transaction submitted by the
+// gateway. The receipt is only returned to the AAD for which the
+// transaction was submitted
+func (m *RequestManager) GetReceipt(ctx context.Context, req GetReceiptRequest) (GetReceiptResponse, errors.Err) {
+	hash := strings.ToLower(req.Hash)
+	if len(hash) != transactionHashLength {
+		return GetReceiptResponse{}, errors.New(errors.ErrInvalidTransactionHash, nil)
+	}
+	if _, err := hexutil.Decode(hash); err != nil {
+		return GetReceiptResponse{}, errors.New(errors.ErrInvalidTransactionHash, err)
+	}
+
+	if len(req.AAD) == 0 {
+		return GetReceiptResponse{}, errors.New(errors.ErrInvalidAAD, nil)
+	}
+
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    ReceiptID(hash),
+		Offset: 0,
+		Count:  1,
+	})
+	if err != nil {
+		return GetReceiptResponse{}, errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	if len(els.Elements) == 0 {
+		return GetReceiptResponse{}, errors.New(errors.ErrReceiptNotFound, nil)
+	}
+
+	if els.Elements[0].Value != req.AAD {
+		return GetReceiptResponse{}, errors.New(errors.ErrTransactionNotOwned, nil)
+	}
+
+	return m.client.GetReceipt(ctx, GetReceiptRequest{Hash: hash, AAD: req.AAD})
+}
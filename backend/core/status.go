@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	mqueue "github.com/oasislabs/oasis-gateway/mqueue/core"
+)
+
+const (
+	// statusElementType is the type of the elements that keep the
+	// status transitions of a request
+	statusElementType = "requestStatus"
+
+	// maxStatusTransitions is the maximum number of status transitions
+	// retrieved for a request
+	maxStatusTransitions = 32
+)
+
+// StatusReporter is provided in the context of an asynchronous request
+// so that the clients executing the request can report its progress
+type StatusReporter interface {
+	// ReportStatus records the new status of the request
+	ReportStatus(ctx context.Context, status RequestStatus)
+
+	// Submitting is called right before the request is submitted.
+	// If the request was cancelled, the context is done once
+	// Submitting returns and the request must not be submitted
+	Submitting(ctx context.Context)
+}
+
+type statusReporterKey struct{}
+
+// WithStatusReporter returns a context that carries the provided reporter
+func WithStatusReporter(ctx context.Context, reporter StatusReporter) context.Context {
+	return context.WithValue(ctx, statusReporterKey{}, reporter)
+}
+
+// GetStatusReporter returns the reporter carried by the context. If the
+// context does not carry one, a reporter that discards the status is
+// returned
+func GetStatusReporter(ctx context.Context) StatusReporter {
+	reporter, ok := ctx.Value(statusReporterKey{}).(StatusReporter)
+	if !ok {
+		return nopStatusReporter{}
+	}
+
+	return reporter
+}
+
+type nopStatusReporter struct{}
+
+// ReportStatus is the implementation of StatusReporter for nopStatusReporter
+func (nopStatusReporter) ReportStatus(context.Context, RequestStatus) {}
+
+// Submitting is the implementation of StatusReporter for nopStatusReporter
+func (nopStatusReporter) Submitting(context.Context) {}
+
+// statusRecorder records the status transitions of a request in the
+// mailbox, so that they can be retrieved from any gateway instance.
+// The transitions are recorded in the background in the order in which
+// they are reported, so that the client that executes the request, like
+// the worker of a wallet, is not held by the mailbox
+type statusRecorder struct {
+	logger      log.Logger
+	mqueue      mqueue.MQueue
+	key         string
+	aad         string
+	hash        string
+	pending     *pendingRequest
+	transitions chan RequestStatus
+	stopped     chan struct{}
+}
+
+// start starts recording the status transitions that are reported
+func (r *statusRecorder) start(ctx context.Context) {
+	r.transitions = make(chan RequestStatus, maxStatusTransitions)
+	r.stopped = make(chan struct{})
+
+	go func() {
+		defer close(r.stopped)
+
+		for status := range r.transitions {
+			r.record(ctx, status)
+		}
+	}()
+}
+
+// stop waits until all the status transitions reported are recorded.
+// No status can be reported once the recorder is stopped
+func (r *statusRecorder) stop() {
+	close(r.transitions)
+	<-r.stopped
+}
+
+// Submitting is the implementation of StatusReporter for statusRecorder.
+// Once the request is submitted it cannot be cancelled anymore, and if it
+// was already cancelled its context is done so that it is not submitted
+func (r *statusRecorder) Submitting(ctx context.Context) {
+	r.pending.submit(ctx)
+}
+
+// ReportStatus is the implementation of StatusReporter for statusRecorder.
+// The status is recorded in the background
+func (r *statusRecorder) ReportStatus(ctx context.Context, status RequestStatus) {
+	r.transitions <- status
+}
+
+// record records the status in the mailbox. Recording the status is best
+// effort and it never fails the request. Once a transaction hash is
+// reported it is kept for later transitions
+func (r *statusRecorder) record(ctx context.Context, status RequestStatus) {
+	if status.State == RequestSubmitted && len(status.TransactionHash) > 0 && len(r.aad) > 0 {
+		if err := recordTransactionOwner(ctx, r.mqueue, status.TransactionHash, r.aad); err != nil {
+			r.logger.Debug(ctx, "failed to record transaction owner", log.MapFields{
+				"call_type": "RecordTransactionOwnerFailure",
+				"key":       r.key,
+				"hash":      status.TransactionHash,
+				"err":       err.Error(),
+			})
+		}
+	}
+
+	if len(status.TransactionHash) == 0 {
+		status.TransactionHash = r.hash
+	}
+	r.hash = status.TransactionHash
+
+	p, err := json.Marshal(status)
+	if err != nil {
+		panic("failed to marshal request status")
+	}
+
+	offset, err := r.mqueue.Next(ctx, mqueue.NextRequest{Key: r.key})
+	if err == nil {
+		err = r.mqueue.Insert(ctx, mqueue.InsertRequest{Key: r.key, Element: mqueue.Element{
+			Offset: offset,
+			Value:  string(p),
+			Type:   statusElementType,
+		}})
+	}
+
+	if err != nil {
+		r.logger.Debug(ctx, "failed to record request status", log.MapFields{
+			"call_type": "ReportStatusFailure",
+			"key":       r.key,
+			"state":     string(status.State),
+			"err":       err.Error(),
+		})
+	}
+}
+
+// GetRequestStatus returns the last status recorded for an
+// asynchronous request
+func (m *RequestManager) GetRequestStatus(ctx context.Context, req GetRequestStatusRequest) (RequestStatus, errors.Err) {
+	if len(req.SessionKey) == 0 {
+		return RequestStatus{}, errors.New(errors.ErrInvalidKey, nil)
+	}
+
+	els, err := m.mqueue.Retrieve(ctx, mqueue.RetrieveRequest{
+		Key:    StatusID(req.SessionKey, req.ID),
+		Offset: 0,
+		Count:  maxStatusTransitions,
+	})
+	if err != nil {
+		return RequestStatus{}, errors.New(errors.ErrQueueRetrieve, err)
+	}
+
+	if len(els.Elements) == 0 {
+		return RequestStatus{}, errors.New(errors.ErrRequestStatusNotFound, nil)
+	}
+
+	last := els.Elements[0]
+	for _, el := range els.Elements {
+		if el.Offset > last.Offset {
+			last = el
+		}
+	}
+
+	var status RequestStatus
+	if err := json.Unmarshal([]byte(last.Value), &status); err != nil {
+		return RequestStatus{}, errors.New(errors.ErrInternalError, err)
+	}
+
+	return status, nil
+}
@@ -2,10 +2,14 @@ package core
 
 import (
 	"context"
+	"encoding/json"
 	stderr "errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/oasislabs/oasis-gateway/errors"
@@ -14,24 +18,133 @@ import (
 	"github.com/oasislabs/oasis-gateway/stats"
 )
 
+const (
+	// subscriptionElementType is the type of the element that keeps
+	// the definition of a subscription
+	subscriptionElementType = "subscription"
+
+	// checkpointElementType is the type of the element that keeps
+	// the checkpoint of a subscription
+	checkpointElementType = "checkpoint"
+
+	// persistInterval is the interval at which a subscription stores
+	// its definition and its checkpoint again even if it does not deliver
+	// any log. It must be lower than the time after which the mailbox
+	// expires idle queues
+	persistInterval = 5 * time.Minute
+
+	// subscriptionLease is the time for which a stored definition holds
+	// the subscription for the instance that runs it. A definition whose
+	// lease has expired belongs to an instance that stopped, so another
+	// instance can restore it
+	subscriptionLease = 3 * persistInterval
+
+	// defaultCheckpointInterval is the interval at which a subscription
+	// stores its checkpoint if it has delivered logs since it last
+	// stored it
+	defaultCheckpointInterval = 10 * time.Second
+
+	// maxCheckpointRecords is the maximum number of elements retrieved
+	// from the checkpoint queue of a subscription. A subscription keeps
+	// a single checkpoint, or two if it stopped while replacing it
+	maxCheckpointRecords = 16
+
+	// retractionDepth is the number of blocks for which a subscription
+	// remembers the logs it delivered, so that it can retract them if
+	// they are removed by a reorg
+	retractionDepth = 256
+)
+
+// logKey identifies a log within the chain
+type logKey struct {
+	BlockHash common.Hash
+	Index     uint
+}
+
+// deliveredLog is a log for which a subscription delivered an event
+type deliveredLog struct {
+	ID          uint64
+	BlockNumber uint64
+}
+
+// subscriptionRecord is the definition of a subscription that is kept
+// in the mailbox so that the subscription can be restored once the
+// instance that runs it stops. Instance identifies that instance and
+// Expiry is the time until which it holds the subscription
+type subscriptionRecord struct {
+	Key      string
+	Request  SubscribeRequest
+	Instance string
+	Expiry   time.Time
+}
+
+// deliveredRecord is a log for which a subscription delivered an
+// event as it is kept along with the checkpoint of the subscription
+type deliveredRecord struct {
+	BlockHash   common.Hash
+	Index       uint
+	ID          uint64
+	BlockNumber uint64
+}
+
+// checkpointRecord is the checkpoint of a subscription that is kept in
+// the mailbox so that a restored subscription resumes from it. It also
+// keeps the logs delivered within the last retractionDepth blocks, so
+// that a restored subscription can retract them
+type checkpointRecord struct {
+	Checkpoint *Checkpoint
+	Delivered  []deliveredRecord `json:",omitempty"`
+}
+
 type subscription struct {
-	ctx    context.Context
-	logger log.Logger
-	c      <-chan interface{}
-	done   chan<- subscriptionEndEvent
-	stop   chan interface{}
-	key    string
-	mqueue mqueue.MQueue
-	wg     sync.WaitGroup
+	ctx        context.Context
+	logger     log.Logger
+	c          chan interface{}
+	done       chan<- subscriptionEndEvent
+	stop       chan interface{}
+	key        string
+	req        SubscribeRequest
+	mqueue     mqueue.MQueue
+	abis       *AbiRegistry
+	wg         sync.WaitGroup
+	checkpoint *Checkpoint
+
+	// instance identifies the instance that runs the subscription
+	// in the stored definition. If empty, it is not stored
+	instance string
+
+	// record is the offset of the element that keeps the
+	// definition of the subscription, if it has been stored
+	record *uint64
+
+	// checkpointRecord is the offset of the element that keeps
+	// the checkpoint of the subscription, if it has been stored.
+	// dirty is true if the checkpoint has changed since then
+	checkpointRecord   *uint64
+	checkpointInterval time.Duration
+	dirty              bool
+
+	// delivered are the logs delivered within the last
+	// retractionDepth blocks up to the head
+	delivered map[logKey]deliveredLog
+	head      uint64
 }
 
 type subscriptionProps struct {
-	Context context.Context
-	Logger  log.Logger
-	MQueue  mqueue.MQueue
-	Key     string
-	Done    chan<- subscriptionEndEvent
-	C       <-chan interface{}
+	Context            context.Context
+	Logger             log.Logger
+	MQueue             mqueue.MQueue
+	Key                string
+	Request            SubscribeRequest
+	Done               chan<- subscriptionEndEvent
+	C                  chan interface{}
+	Checkpoint         *Checkpoint
+	Record             *uint64
+	CheckpointRecord   *uint64
+	CheckpointInterval time.Duration
+	Delivered          []deliveredRecord
+	Abis               *AbiRegistry
+	Instance           string
 }
 
 func newSubscription(props subscriptionProps) *subscription {
@@ -51,16 +164,210 @@ func newSubscription(props subscriptionProps) *subscription {
 		panic("mqueue must be set")
 	}
 
+	checkpointInterval := props.CheckpointInterval
+	if checkpointInterval == 0 {
+		checkpointInterval = defaultCheckpointInterval
+	}
+
+	delivered := make(map[logKey]deliveredLog, len(props.Delivered))
+	var head uint64
+	for _, record := range props.Delivered {
+		delivered[logKey{BlockHash: record.BlockHash, Index: record.Index}] = deliveredLog{
+			ID:          record.ID,
+			BlockNumber: record.BlockNumber,
+		}
+		if record.BlockNumber > head {
+			head = record.BlockNumber
+		}
+	}
+
 	return &subscription{
-		ctx:    props.Context,
-		logger: props.Logger.ForClass("backend/core", "subscription"),
-		c:      props.C,
-		done:   props.Done,
-		stop:   make(chan interface{}),
-		key:    props.Key,
-		mqueue: props.MQueue,
-		wg:     sync.WaitGroup{},
+		ctx:                props.Context,
+		logger:             props.Logger.ForClass("backend/core", "subscription"),
+		c:                  props.C,
+		done:               props.Done,
+		stop:               make(chan interface{}),
+		key:                props.Key,
+		req:                props.Request,
+		mqueue:             props.MQueue,
+		abis:               props.Abis,
+		wg:                 sync.WaitGroup{},
+		checkpoint:         props.Checkpoint,
+		instance:           props.Instance,
+		record:             props.Record,
+		checkpointRecord:   props.CheckpointRecord,
+		checkpointInterval: checkpointInterval,
+		delivered:          delivered,
+		head:               head,
+	}
+}
+
+// persist stores the definition of the subscription with a renewed
+// lease and discards the one stored previously. Elements can only be
+// set once, so every update is stored as a new element
+func (s *subscription) persist() {
+	if len(s.instance) == 0 {
+		return
+	}
+
+	p, err := json.Marshal(subscriptionRecord{
+		Key:      s.key,
+		Request:  s.req,
+		Instance: s.instance,
+		Expiry:   time.Now().Add(subscriptionLease),
+	})
+	if err != nil {
+		s.logger.Warn(s.ctx, "failed to serialize subscription", log.MapFields{
+			"call_type": "PersistSubscriptionFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	key := SubscriptionsID()
+	offset, err := s.mqueue.Next(s.ctx, mqueue.NextRequest{Key: key})
+	if err != nil {
+		s.logger.Warn(s.ctx, "failed to find next resource for subscription", log.MapFields{
+			"call_type": "PersistSubscriptionFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
 	}
+
+	if err := s.mqueue.Insert(s.ctx, mqueue.InsertRequest{
+		Key: key,
+		Element: mqueue.Element{
+			Offset: offset,
+			Type:   subscriptionElementType,
+			Value:  string(p),
+		},
+	}); err != nil {
+		s.logger.Warn(s.ctx, "failed to insert subscription to resource", log.MapFields{
+			"call_type": "PersistSubscriptionFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	s.forget()
+	s.record = &offset
+}
+
+// forget discards the stored definition of the subscription
+// so that it is not restored
+func (s *subscription) forget() {
+	if s.record == nil {
+		return
+	}
+
+	if err := s.mqueue.Discard(s.ctx, mqueue.DiscardRequest{
+		Key:          SubscriptionsID(),
+		KeepPrevious: true,
+		Count:        1,
+		Offset:       *s.record,
+	}); err != nil {
+		s.logger.Warn(s.ctx, "failed to discard subscription from resource", log.MapFields{
+			"call_type": "ForgetSubscriptionFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	s.record = nil
+}
+
+// storeCheckpoint stores the checkpoint of the subscription in its
+// own queue and discards the one stored previously, so that storing
+// checkpoints does not contend on the queue shared by all the
+// subscriptions
+func (s *subscription) storeCheckpoint() {
+	if s.checkpoint == nil {
+		return
+	}
+
+	p, err := json.Marshal(checkpointRecord{
+		Checkpoint: s.checkpoint,
+		Delivered:  s.deliveredRecords(),
+	})
+	if err != nil {
+		s.logger.Warn(s.ctx, "failed to serialize checkpoint", log.MapFields{
+			"call_type": "StoreCheckpointFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	key := CheckpointID(s.key)
+	offset, err := s.mqueue.Next(s.ctx, mqueue.NextRequest{Key: key})
+	if err != nil {
+		s.logger.Warn(s.ctx, "failed to find next resource for checkpoint", log.MapFields{
+			"call_type": "StoreCheckpointFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	if err := s.mqueue.Insert(s.ctx, mqueue.InsertRequest{
+		Key: key,
+		Element: mqueue.Element{
+			Offset: offset,
+			Type:   checkpointElementType,
+			Value:  string(p),
+		},
+	}); err != nil {
+		s.logger.Warn(s.ctx, "failed to insert checkpoint to resource", log.MapFields{
+			"call_type": "StoreCheckpointFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return
+	}
+
+	if s.checkpointRecord != nil {
+		if err := s.mqueue.Discard(s.ctx, mqueue.DiscardRequest{
+			Key:          key,
+			KeepPrevious: true,
+			Count:        1,
+			Offset:       *s.checkpointRecord,
+		}); err != nil {
+			// the stale checkpoint is discarded when the
+			// subscription is restored
+			s.logger.Warn(s.ctx, "failed to discard checkpoint from resource", log.MapFields{
+				"call_type": "StoreCheckpointFailure",
+				"key":       s.key,
+				"err":       err.Error(),
+			})
+		}
+	}
+
+	s.checkpointRecord = &offset
+	s.dirty = false
+}
+
+// deliveredRecords returns the logs the subscription remembers
+// ordered by the ID of their events
+func (s *subscription) deliveredRecords() []deliveredRecord {
+	records := make([]deliveredRecord, 0, len(s.delivered))
+	for key, delivered := range s.delivered {
+		records = append(records, deliveredRecord{
+			BlockHash:   key.BlockHash,
+			Index:       key.Index,
+			ID:          delivered.ID,
+			BlockNumber: delivered.BlockNumber,
+		})
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+
+	return records
 }
 
 func (s *subscription) Stop() {
@@ -69,7 +376,23 @@ func (s *subscription) Stop() {
 }
 
 func (s *subscription) Start() {
+	ticker := time.NewTicker(persistInterval)
+	checkpointTicker := time.NewTicker(s.checkpointInterval)
+
 	defer func() {
+		ticker.Stop()
+		checkpointTicker.Stop()
+		s.forget()
+
+		if err := s.mqueue.Remove(context.Background(), mqueue.RemoveRequest{
+			Key: CheckpointID(s.key),
+		}); err != nil {
+			s.logger.Warn(s.ctx, "failed to remove checkpoint queue", log.MapFields{
+				"call_type": "SubscriptionExitFailure",
+				"key":       s.key,
+			})
+		}
+
 		err := s.mqueue.Remove(context.Background(), mqueue.RemoveRequest{Key: s.key})
 		if err != nil {
 			s.logger.Warn(s.ctx, "failed to remove messaging queue", log.MapFields{
@@ -86,6 +409,10 @@ func (s *subscription) Start() {
 		s.wg.Done()
 	}()
 
+	// the definition is stored once the subscription starts rather than
+	// by the manager, so that the manager does not wait for the mailbox
+	s.persist()
+
 	for {
 		select {
 		case <-s.ctx.Done():
@@ -94,6 +421,13 @@ func (s *subscription) Start() {
 			if !ok {
 				return
 			}
+		case <-ticker.C:
+			s.persist()
+			s.storeCheckpoint()
+		case <-checkpointTicker.C:
+			if s.dirty {
+				s.storeCheckpoint()
+			}
 		case ev, ok := <-s.c:
 			if !ok {
 				return
@@ -103,17 +437,12 @@ func (s *subscription) Start() {
 			// the queue, the subscription should be closed. In that case,
 			// we should define a mechanism to report the errors back to the client
 
-			id, err := s.mqueue.Next(s.ctx, mqueue.NextRequest{Key: s.key})
-			if err != nil {
-				s.logger.Warn(s.ctx, "failed to find next resource for event", log.MapFields{
-					"call_type": "InsertSubscriptionEventFailure",
-					"key":       s.key,
-					"err":       err.Error(),
-				})
+			if l, ok := ev.(types.Log); ok && l.Removed {
+				s.retract(l)
 				continue
 			}
 
-			data, ok := ev.(types.Log)
+			data, ok := makeDataEvent(ev)
 			if !ok {
 				s.logger.Warn(s.ctx, "received event of unexpected type", log.MapFields{
 					"call_type": "InsertSubscriptionEventFailure",
@@ -123,47 +452,183 @@ func (s *subscription) Start() {
 				continue
 			}
 
-			var topics []string
-			for _, topic := range data.Topics {
-				topics = append(topics, topic.Hex())
+			if l, ok := ev.(types.Log); ok && s.abis != nil {
+				if event, args, ok := s.abis.Decode(l); ok {
+					data.Event = event
+					data.Args = args
+				}
 			}
 
-			el, err := makeElement(DataEvent{
-				ID:     id,
-				Data:   hexutil.Encode(data.Data),
-				Topics: topics,
-			}, id)
-			if err != nil {
-				s.logger.Warn(s.ctx, "failed to serialize event", log.MapFields{
-					"call_type": "InsertSubscriptionEventFailure",
-					"key":       s.key,
-					"type":      fmt.Sprintf("%+v", ev),
-					"err":       err.Error(),
-				})
+			id, ok := s.insert(func(id uint64) Event {
+				data.ID = id
+				return data
+			})
+			if !ok {
 				continue
 			}
 
-			if err := s.mqueue.Insert(s.ctx, mqueue.InsertRequest{Key: s.key, Element: el}); err != nil {
-				s.logger.Warn(s.ctx, "failed to insert event to resource", log.MapFields{
-					"call_type": "InsertSubscriptionEventFailure",
-					"key":       s.key,
-					"err":       err.Error(),
-				})
+			// the checkpoint moves once the log is delivered, so that
+			// a restored subscription resumes from the log that follows.
+			// It is stored periodically rather than for every log, so a
+			// restored subscription may deliver some logs again
+			if l, ok := ev.(types.Log); ok {
+				s.remember(l, id)
+				s.checkpoint = &Checkpoint{BlockNumber: l.BlockNumber, Index: l.Index}
+				s.dirty = true
 			}
 		}
 	}
 }
 
+// insert inserts the event created for the next offset of the
+// queue of the subscription. It returns false if it fails
+func (s *subscription) insert(makeEvent func(id uint64) Event) (uint64, bool) {
+	id, err := s.mqueue.Next(s.ctx, mqueue.NextRequest{Key: s.key})
+	if err != nil {
+		s.logger.Warn(s.ctx, "failed to find next resource for event", log.MapFields{
+			"call_type": "InsertSubscriptionEventFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return 0, false
+	}
+
+	ev := makeEvent(id)
+	el, err := makeElement(ev, id)
+	if err != nil {
+		s.logger.Warn(s.ctx, "failed to serialize event", log.MapFields{
+			"call_type": "InsertSubscriptionEventFailure",
+			"key":       s.key,
+			"type":      fmt.Sprintf("%+v", ev),
+			"err":       err.Error(),
+		})
+		return 0, false
+	}
+
+	if err := s.mqueue.Insert(s.ctx, mqueue.InsertRequest{Key: s.key, Element: el}); err != nil {
+		s.logger.Warn(s.ctx, "failed to insert event to resource", log.MapFields{
+			"call_type": "InsertSubscriptionEventFailure",
+			"key":       s.key,
+			"err":       err.Error(),
+		})
+		return 0, false
+	}
+
+	return id, true
+}
+
+// remember keeps the ID of the event delivered for the log, so that
+// the event can be retracted. The logs delivered for blocks that are
+// more than retractionDepth blocks behind the head are forgotten
+func (s *subscription) remember(l types.Log, id uint64) {
+	s.delivered[logKey{BlockHash: l.BlockHash, Index: l.Index}] = deliveredLog{
+		ID:          id,
+		BlockNumber: l.BlockNumber,
+	}
+
+	if l.BlockNumber <= s.head {
+		return
+	}
+
+	s.head = l.BlockNumber
+	for key, delivered := range s.delivered {
+		if delivered.BlockNumber+retractionDepth < s.head {
+			delete(s.delivered, key)
+		}
+	}
+}
+
+// retract delivers a RetractedEvent for a log removed by a reorg that
+// references the event delivered for the log. Logs the subscription
+// does not remember are ignored, since either they were not delivered
+// or they were delivered more than retractionDepth blocks ago
+func (s *subscription) retract(l types.Log) {
+	key := logKey{BlockHash: l.BlockHash, Index: l.Index}
+	delivered, ok := s.delivered[key]
+	if !ok {
+		s.logger.Debug(s.ctx, "ignored removed log that was not delivered", log.MapFields{
+			"call_type": "RetractSubscriptionEventFailure",
+			"key":       s.key,
+			"block":     l.BlockNumber,
+			"index":     l.Index,
+		})
+		return
+	}
+
+	if _, ok := s.insert(func(id uint64) Event {
+		return RetractedEvent{ID: id, RetractedID: delivered.ID, BlockNumber: l.BlockNumber}
+	}); !ok {
+		return
+	}
+
+	delete(s.delivered, key)
+	s.dirty = true
+
+	// the checkpoint moves back to the removed log, so that a restored
+	// subscription delivers the logs that replace it in the new chain.
+	// Reorgs are rare, so it is stored right away
+	if s.checkpoint == nil || l.BlockNumber < s.checkpoint.BlockNumber ||
+		(l.BlockNumber == s.checkpoint.BlockNumber && l.Index <= s.checkpoint.Index) {
+		s.checkpoint = &Checkpoint{BlockNumber: l.BlockNumber, Index: l.Index, Retracted: true}
+		s.storeCheckpoint()
+	}
+}
+
+// makeDataEvent creates the DataEvent for an event received by a
+// subscription from any of the sources it is subscribed to
+func makeDataEvent(ev interface{}) (DataEvent, bool) {
+	switch ev := ev.(type) {
+	case types.Log:
+		var topics []string
+		for _, topic := range ev.Topics {
+			topics = append(topics, topic.Hex())
+		}
+
+		return DataEvent{
+			Type:             LogsEvent,
+			Data:             hexutil.Encode(ev.Data),
+			Topics:           topics,
+			BlockNumber:      ev.BlockNumber,
+			BlockHash:        ev.BlockHash.Hex(),
+			TransactionHash:  ev.TxHash.Hex(),
+			TransactionIndex: ev.TxIndex,
+			LogIndex:         ev.Index,
+			Address:          ev.Address.Hex(),
+		}, true
+	case *types.Header:
+		hash := ev.Hash().Hex()
+		return DataEvent{
+			Type:        NewHeadsEvent,
+			Data:        hash,
+			BlockNumber: ev.Number.Uint64(),
+			BlockHash:   hash,
+		}, true
+	default:
+		return DataEvent{}, false
+	}
+}
+
 type subscriptionEndEvent struct {
 	Key   string
 	Error error
 }
 
 type createSubscriptionRequest struct {
-	Context context.Context
-	Key     string
-	Err     chan<- errors.Err
-	C       <-chan interface{}
+	Context    context.Context
+	Key        string
+	Request    SubscribeRequest
+	Err        chan<- errors.Err
+	C          chan interface{}
+	Checkpoint *Checkpoint
+	Record     *uint64
+
+	// CheckpointRecord is the offset of the element that keeps
+	// the checkpoint of a restored subscription
+	CheckpointRecord *uint64
+
+	// Delivered are the logs a restored subscription
+	// delivered before it was restored
+	Delivered []deliveredRecord
 }
 
 type destroySubscriptionRequest struct {
@@ -178,6 +643,17 @@ type existsSubscriptionRequest struct {
 	Out     chan<- bool
 }
 
+type getSubscriptionRequest struct {
+	Context context.Context
+	Key     string
+	Out     chan<- getSubscriptionResponse
+}
+
+type getSubscriptionResponse struct {
+	Request SubscribeRequest
+	Exists  bool
+}
+
 type statsRequest struct {
 	Context context.Context
 	Out     chan<- stats.Metrics
@@ -197,6 +673,19 @@ type SubscriptionManagerProps struct {
 	// stream of events so that the client can retrieve
 	// those events later on
 	MQueue mqueue.MQueue
+
+	// Abis are the registered ABIs used to decode the logs
+	// delivered to the subscriptions. If nil, logs are not decoded
+	Abis *AbiRegistry
+
+	// CheckpointInterval is the interval at which the subscriptions
+	// store their checkpoints. If zero, defaultCheckpointInterval is used
+	CheckpointInterval time.Duration
+
+	// Instance identifies the instance in the definitions the
+	// subscriptions store so that they can be restored. If empty,
+	// the subscriptions do not store their definitions
+	Instance string
 }
 
 // SubscriptionManager manages the lifetime
@@ -208,7 +697,11 @@ type SubscriptionManager struct {
 	req     chan interface{}
 	subs    map[string]*subscription
 	mqueue  mqueue.MQueue
+	abis    *AbiRegistry
 	metrics SubscriptionMetrics
+
+	checkpointInterval time.Duration
+	instance           string
 }
 
 type SubscriptionMetrics struct {
@@ -226,7 +719,11 @@ func NewSubscriptionManager(props SubscriptionManagerProps) *SubscriptionManager
 		req:     make(chan interface{}),
 		subs:    make(map[string]*subscription),
 		mqueue:  props.MQueue,
+		abis:    props.Abis,
 		metrics: SubscriptionMetrics{},
+
+		checkpointInterval: props.CheckpointInterval,
+		instance:           props.Instance,
 	}
 
 	go m.startLoop()
@@ -272,6 +769,8 @@ func (m *SubscriptionManager) handleRequest(req interface{}) {
 		m.destroy(req)
 	case existsSubscriptionRequest:
 		m.exists(req)
+	case getSubscriptionRequest:
+		m.get(req)
 	case statsRequest:
 		m.stats(req)
 	default:
@@ -296,6 +795,17 @@ func (m *SubscriptionManager) exists(req existsSubscriptionRequest) {
 	req.Out <- ok
 }
 
+func (m *SubscriptionManager) get(req getSubscriptionRequest) {
+	defer close(req.Out)
+	sub, ok := m.subs[req.Key]
+	if !ok {
+		req.Out <- getSubscriptionResponse{}
+		return
+	}
+
+	req.Out <- getSubscriptionResponse{Request: sub.req, Exists: true}
+}
+
 func (m *SubscriptionManager) create(req createSubscriptionRequest) {
 	defer close(req.Err)
 
@@ -307,12 +817,20 @@ func (m *SubscriptionManager) create(req createSubscriptionRequest) {
 	}
 
 	m.subs[req.Key] = newSubscription(subscriptionProps{
-		Context: m.ctx,
-		Logger:  m.logger,
-		Key:     req.Key,
-		Done:    m.done,
-		MQueue:  m.mqueue,
-		C:       req.C,
+		Context:            m.ctx,
+		Logger:             m.logger,
+		Key:                req.Key,
+		Request:            req.Request,
+		Done:               m.done,
+		MQueue:             m.mqueue,
+		C:                  req.C,
+		Checkpoint:         req.Checkpoint,
+		Record:             req.Record,
+		CheckpointRecord:   req.CheckpointRecord,
+		CheckpointInterval: m.checkpointInterval,
+		Delivered:          req.Delivered,
+		Abis:               m.abis,
+		Instance:           m.instance,
 	})
 
 	m.incrSubscriptions()
@@ -363,23 +881,68 @@ func (m *SubscriptionManager) Exists(
 	return <-out
 }
 
+// Get returns the request with which the subscription identified
+// by the specified key was created, if it exists
+func (m *SubscriptionManager) Get(
+	ctx context.Context,
+	key string,
+) (SubscribeRequest, bool) {
+	out := make(chan getSubscriptionResponse)
+	m.req <- getSubscriptionRequest{
+		Context: ctx,
+		Key:     key,
+		Out:     out,
+	}
+	res := <-out
+	return res.Request, res.Exists
+}
+
 // Create a new subscription identified by the
-// specified key
+// specified key for the request. The events received
+// on the channel are delivered to the subscription
 func (m *SubscriptionManager) Create(
 	ctx context.Context,
 	key string,
+	req SubscribeRequest,
 	c chan interface{},
 ) errors.Err {
 	err := make(chan errors.Err)
 	m.req <- createSubscriptionRequest{
 		Context: ctx,
 		Key:     key,
+		Request: req,
 		C:       c,
 		Err:     err,
 	}
 	return <-err
 }
 
+// restore re-creates a subscription from the definition stored
+// at the record offset and the checkpoint it stored last. The
+// subscription stores its definition again and discards the one
+// it is restored from
+func (m *SubscriptionManager) restore(
+	ctx context.Context,
+	record subscriptionRecord,
+	offset uint64,
+	checkpoint storedCheckpoint,
+	c chan interface{},
+) errors.Err {
+	err := make(chan errors.Err)
+	m.req <- createSubscriptionRequest{
+		Context:          ctx,
+		Key:              record.Key,
+		Request:          record.Request,
+		C:                c,
+		Err:              err,
+		Checkpoint:       checkpoint.Checkpoint,
+		Record:           &offset,
+		CheckpointRecord: checkpoint.Offset,
+		Delivered:        checkpoint.Delivered,
+	}
+	return <-err
+}
+
 // Destroy an existing subscription identified by
 // the specified key
 func (m *SubscriptionManager) Destroy(
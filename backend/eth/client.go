@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	stderr "errors"
 	"fmt"
+	"math/big"
 	"net/url"
 
 	ethereum "github.com/ethereum/go-ethereum"
@@ -22,7 +23,10 @@ import (
 )
 
 const (
+	callContract       string = "CallContract"
+	estimateGas        string = "EstimateGas"
 	getCode            string = "GetCode"
+	getReceipt         string = "GetReceipt"
 	getExpiry          string = "GetExpiry"
 	getPublicKey       string = "GetPublicKey"
 	deployService      string = "DeployService"
@@ -41,9 +45,37 @@ type executeTransactionRequest struct {
 }
 
 type executeTransactionResponse struct {
-	ID      uint64
-	Address string
-	Output  string
+	ID          uint64
+	Address     string
+	Output      string
+	Hash        string
+	BlockNumber uint64
+	GasUsed     uint64
+	CodeHash    string
+}
+
+// statusListener reports the progress of the execution of a
+// transaction as the status of the request that triggered it
+type statusListener struct {
+	reporter backend.StatusReporter
+}
+
+// EstimatingGas is the implementation of tx.ExecuteListener for statusListener
+func (l statusListener) EstimatingGas(ctx context.Context) {
+	l.reporter.ReportStatus(ctx, backend.RequestStatus{State: backend.RequestEstimatingGas})
+}
+
+// Submitting is the implementation of tx.ExecuteListener for statusListener
+func (l statusListener) Submitting(ctx context.Context) {
+	l.reporter.Submitting(ctx)
+}
+
+// Submitted is the implementation of tx.ExecuteListener for statusListener
+func (l statusListener) Submitted(ctx context.Context, hash string) {
+	l.reporter.ReportStatus(ctx, backend.RequestStatus{
+		State:           backend.RequestSubmitted,
+		TransactionHash: hash,
+	})
 }
 
 type ClientProps struct {
@@ -122,6 +154,200 @@ func (c *Client) GetCode(
 	return v.(backend.GetCodeResponse), nil
 }
 
+func (c *Client) callContract(
+	ctx context.Context,
+	req backend.CallContractRequest,
+) (backend.CallContractResponse, errors.Err) {
+	c.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "CallContractAttempt",
+		"address":   req.Address,
+	})
+
+	if err := c.verifyAddress(req.Address); err != nil {
+		return backend.CallContractResponse{}, err
+	}
+
+	data, err := c.decodeBytes(req.Data)
+	if err != nil {
+		return backend.CallContractResponse{}, err
+	}
+
+	address := common.HexToAddress(req.Address)
+	output, cerr := c.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &address,
+		Data: data,
+	})
+	if cerr != nil {
+		err := errors.New(errors.ErrCallContract, cerr)
+		c.logger.Debug(ctx, "client call failed", log.MapFields{
+			"call_type": "CallContractFailure",
+			"address":   req.Address,
+		}, err)
+		return backend.CallContractResponse{}, err
+	}
+
+	c.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "CallContractSuccess",
+		"address":   req.Address,
+	})
+
+	return backend.CallContractResponse{
+		Address: req.Address,
+		Output:  hexutil.Encode(output),
+	}, nil
+}
+
+// CallContract runs a read-only call against a service without
+// sending a transaction
+func (c *Client) CallContract(
+	ctx context.Context,
+	req backend.CallContractRequest,
+) (backend.CallContractResponse, errors.Err) {
+	v, err := c.tracker.Instrument(callContract, func() (interface{}, error) {
+		return c.callContract(ctx, req)
+	})
+
+	if err != nil {
+		return backend.CallContractResponse{}, err.(errors.Err)
+	}
+
+	return v.(backend.CallContractResponse), nil
+}
+
+func (c *Client) estimateGas(
+	ctx context.Context,
+	req backend.EstimateGasRequest,
+) (backend.EstimateGasResponse, errors.Err) {
+	c.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "EstimateGasAttempt",
+		"address":   req.Address,
+	})
+
+	if len(req.Address) > 0 {
+		if err := c.verifyAddress(req.Address); err != nil {
+			return backend.EstimateGasResponse{}, err
+		}
+	}
+
+	data, err := c.decodeBytes(req.Data)
+	if err != nil {
+		return backend.EstimateGasResponse{}, err
+	}
+
+	res, err := c.executor.EstimateGas(ctx, tx.EstimateGasRequest{
+		Address: req.Address,
+		Data:    data,
+	})
+	if err != nil {
+		c.logger.Debug(ctx, "failed to estimate gas", log.MapFields{
+			"call_type": "EstimateGasFailure",
+			"address":   req.Address,
+		}, err)
+		return backend.EstimateGasResponse{}, err
+	}
+
+	c.logger.Debug(ctx, "", log.MapFields{
+		"call_type":            "EstimateGasSuccess",
+		"address":              req.Address,
+		"gas":                  res.GasLimit,
+		"confidentialFallback": res.ConfidentialFallback,
+	})
+
+	return backend.EstimateGasResponse{
+		GasLimit:             res.GasLimit,
+		GasPrice:             hexutil.EncodeBig(res.GasPrice),
+		Fee:                  hexutil.EncodeBig(res.Fee),
+		ConfidentialFallback: res.ConfidentialFallback,
+	}, nil
+}
+
+// EstimateGas estimates the gas and fee the gateway would use to
+// send the transaction for a deploy or execute request
+func (c *Client) EstimateGas(
+	ctx context.Context,
+	req backend.EstimateGasRequest,
+) (backend.EstimateGasResponse, errors.Err) {
+	v, err := c.tracker.Instrument(estimateGas, func() (interface{}, error) {
+		return c.estimateGas(ctx, req)
+	})
+
+	if err != nil {
+		return backend.EstimateGasResponse{}, err.(errors.Err)
+	}
+
+	return v.(backend.EstimateGasResponse), nil
+}
+
+func (c *Client) getReceipt(
+	ctx context.Context,
+	req backend.GetReceiptRequest,
+) (backend.GetReceiptResponse, errors.Err) {
+	c.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "GetReceiptAttempt",
+		"hash":      req.Hash,
+	})
+
+	receipt, rerr := c.client.TransactionReceipt(ctx, common.HexToHash(req.Hash))
+	if rerr != nil {
+		err := errors.New(errors.ErrTransactionReceipt, rerr)
+		c.logger.Debug(ctx, "client call failed", log.MapFields{
+			"call_type": "GetReceiptFailure",
+			"hash":      req.Hash,
+		}, err)
+		return backend.GetReceiptResponse{}, err
+	}
+
+	var contractAddress string
+	if receipt.ContractAddress != (common.Address{}) {
+		contractAddress = receipt.ContractAddress.Hex()
+	}
+
+	logs := make([]backend.ReceiptLog, 0, len(receipt.Logs))
+	for _, l := range receipt.Logs {
+		topics := make([]string, 0, len(l.Topics))
+		for _, topic := range l.Topics {
+			topics = append(topics, topic.Hex())
+		}
+
+		logs = append(logs, backend.ReceiptLog{
+			Address: l.Address.Hex(),
+			Topics:  topics,
+			Data:    hexutil.Encode(l.Data),
+		})
+	}
+
+	c.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "GetReceiptSuccess",
+		"hash":      req.Hash,
+		"status":    receipt.Status,
+	})
+
+	return backend.GetReceiptResponse{
+		Hash:            req.Hash,
+		Status:          receipt.Status,
+		GasUsed:         receipt.GasUsed,
+		BlockNumber:     receipt.BlockNumber,
+		ContractAddress: contractAddress,
+		Logs:            logs,
+	}, nil
+}
+
+// GetReceipt retrieves the receipt of a transaction
+func (c *Client) GetReceipt(
+	ctx context.Context,
+	req backend.GetReceiptRequest,
+) (backend.GetReceiptResponse, errors.Err) {
+	v, err := c.tracker.Instrument(getReceipt, func() (interface{}, error) {
+		return c.getReceipt(ctx, req)
+	})
+
+	if err != nil {
+		return backend.GetReceiptResponse{}, err.(errors.Err)
+	}
+
+	return v.(backend.GetReceiptResponse), nil
+}
+
 func (c *Client) getExpiry(
 	ctx context.Context,
 	req backend.GetExpiryRequest,
@@ -234,6 +460,19 @@ func (c *Client) verifyAddress(addr string) errors.Err {
 	return nil
 }
 
+func (c *Client) decodeTopic(topic string) (common.Hash, errors.Err) {
+	p, err := hexutil.Decode(topic)
+	if err != nil {
+		return common.Hash{}, errors.New(errors.ErrInvalidTopic, err)
+	}
+
+	if len(p) != common.HashLength {
+		return common.Hash{}, errors.New(errors.ErrInvalidTopic, nil)
+	}
+
+	return common.BytesToHash(p), nil
+}
+
 func (c *Client) DeployService(
 	ctx context.Context,
 	id uint64,
@@ -270,8 +509,12 @@ func (c *Client) deployService(
 	}
 
 	return backend.DeployServiceResponse{
-		ID:      res.ID,
-		Address: res.Address,
+		ID:              res.ID,
+		Address:         res.Address,
+		TransactionHash: res.Hash,
+		BlockNumber:     res.BlockNumber,
+		GasUsed:         res.GasUsed,
+		CodeHash:        res.CodeHash,
 	}, nil
 }
 
@@ -315,9 +558,12 @@ func (c *Client) executeService(
 	}
 
 	return backend.ExecuteServiceResponse{
-		ID:      res.ID,
-		Address: res.Address,
-		Output:  res.Output,
+		ID:              res.ID,
+		Address:         res.Address,
+		Output:          res.Output,
+		TransactionHash: res.Hash,
+		BlockNumber:     res.BlockNumber,
+		GasUsed:         res.GasUsed,
 	}, nil
 }
 
@@ -341,35 +587,99 @@ func (c *Client) subscribeRequest(
 	req backend.CreateSubscriptionRequest,
 	ch chan<- interface{},
 ) errors.Err {
-	if req.Event != "logs" {
-		return errors.New(errors.ErrTopicLogsSupported, nil)
+	var subscribers []eth.Subscriber
+	for _, event := range req.Events {
+		switch event {
+		case backend.LogsEvent:
+			subscriber, err := c.newLogSubscriber(req)
+			if err != nil {
+				c.logger.Debug(ctx, "invalid subscription filter", log.MapFields{
+					"call_type": "SubscribeRequestFailure",
+				}, err)
+				return err
+			}
+			subscribers = append(subscribers, subscriber)
+		case backend.NewHeadsEvent:
+			subscribers = append(subscribers, &eth.HeadSubscriber{})
+		default:
+			return errors.New(errors.ErrEventTypeNotSupported, nil)
+		}
+	}
+
+	if len(subscribers) == 0 {
+		return errors.New(errors.ErrEmptyInput, nil)
+	}
+
+	// a single subscriber is used directly so that it
+	// is recreated on its own when it fails
+	subscriber := subscribers[0]
+	if len(subscribers) > 1 {
+		subscriber = &eth.MultiSubscriber{Subscribers: subscribers}
+	}
+
+	if err := c.subman.Create(ctx, req.SubID, subscriber, ch); err != nil {
+		err := errors.New(errors.ErrInternalError, err)
+		c.logger.Debug(ctx, "failed to create subscription", log.MapFields{
+			"call_type": "SubscribeRequestFailure",
+			"addresses": req.Addresses,
+		}, err)
+		return err
 	}
 
-	var topics [][]common.Hash
-	for _, topic := range req.Topics {
-		topics = append(topics, []common.Hash{common.HexToHash(topic)})
-	}
+	return nil
+}
 
+// newLogSubscriber creates the subscriber for the logs that match
+// the filter of the request, with the same semantics as an
+// ethereum.FilterQuery
+func (c *Client) newLogSubscriber(req backend.CreateSubscriptionRequest) (*eth.LogSubscriber, errors.Err) {
 	var addresses = []common.Address{}
-	if req.Address != "" {
-		addresses = []common.Address{common.HexToAddress(req.Address)}
+	for _, address := range req.Addresses {
+		if err := c.verifyAddress(address); err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, common.HexToAddress(address))
 	}
 
-	if err := c.subman.Create(ctx, req.SubID, &eth.LogSubscriber{
+	var topics [][]common.Hash
+	for _, position := range req.Topics {
+		// an empty position is a wildcard and matches any topic
+		var hashes []common.Hash
+		for _, topic := range position {
+			hash, err := c.decodeTopic(topic)
+			if err != nil {
+				return nil, err
+			}
+
+			hashes = append(hashes, hash)
+		}
+
+		topics = append(topics, hashes)
+	}
+
+	subscriber := &eth.LogSubscriber{
 		FilterQuery: ethereum.FilterQuery{
 			Addresses: addresses,
 			Topics:    topics,
 		},
-	}, ch); err != nil {
-		err := errors.New(errors.ErrInternalError, err)
-		c.logger.Debug(ctx, "failed to create subscription", log.MapFields{
-			"call_type": "SubscribeRequestFailure",
-			"address":   req.Address,
-		}, err)
-		return err
+		Confirmations: req.Confirmations,
 	}
 
-	return nil
+	if req.FromBlock > 0 {
+		subscriber.FilterQuery.FromBlock = new(big.Int).SetUint64(req.FromBlock)
+	}
+
+	// a restored subscription resumes from the log that follows the
+	// last one it delivered, or from the log removed by a reorg
+	if req.Checkpoint != nil {
+		subscriber.FilterQuery.FromBlock = new(big.Int).SetUint64(req.Checkpoint.BlockNumber)
+		subscriber.BlockNumber = req.Checkpoint.BlockNumber
+		subscriber.Index = req.Checkpoint.Index
+		subscriber.Delivered = !req.Checkpoint.Retracted
+	}
+
+	return subscriber, nil
 }
 
 func (c *Client) UnsubscribeRequest(
@@ -412,10 +722,11 @@ func (c *Client) executeTransaction(
 	})
 
 	res, err := c.executor.Execute(ctx, tx.ExecuteRequest{
-		AAD:     req.AAD,
-		ID:      req.ID,
-		Address: req.Address,
-		Data:    req.Data,
+		AAD:      req.AAD,
+		ID:       req.ID,
+		Address:  req.Address,
+		Data:     req.Data,
+		Listener: statusListener{reporter: backend.GetStatusReporter(ctx)},
 	})
 	if err != nil {
 		c.logger.Debug(ctx, "failure to retrieve transaction receipt", log.MapFields{
@@ -435,9 +746,13 @@ func (c *Client) executeTransaction(
 	})
 
 	return &executeTransactionResponse{
-		ID:      req.ID,
-		Address: res.Address,
-		Output:  res.Output,
+		ID:          req.ID,
+		Address:     res.Address,
+		Output:      res.Output,
+		Hash:        res.Hash,
+		BlockNumber: res.BlockNumber,
+		GasUsed:     res.GasUsed,
+		CodeHash:    res.CodeHash,
 	}, nil
 }
 
@@ -468,7 +783,10 @@ func NewClientWithDeps(ctx context.Context, deps *ClientDeps) *Client {
 		logger:   deps.Logger.ForClass("eth", "Client"),
 		client:   deps.Client,
 		executor: deps.Executor,
-		tracker: stats.NewMethodTracker(getPublicKey,
+		tracker: stats.NewMethodTracker(callContract,
+			estimateGas,
+			getReceipt,
+			getPublicKey,
 			deployService,
 			executeService,
 			subscribeRequest,
@@ -10,6 +10,8 @@ import (
 	"sync/atomic"
 	"testing"
 
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/oasislabs/oasis-gateway/backend/core"
@@ -115,6 +117,157 @@ func TestGetCodeOK(t *testing.T) {
 	}, pk)
 }
 
+func TestCallContractErr(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"CallContract": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return:    []interface{}{nil, errors.New("error")},
+			},
+		})
+
+	_, err = client.CallContract(Context, backend.CallContractRequest{
+		Address: "0x0000000000000000000000000000000000000000",
+		Data:    "0x00",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "[1046] error code InternalError with desc Internal Error. Please check the status of the service. with cause error", err.Error())
+}
+
+func TestCallContractOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMock(client.client.(*ethtest.MockClient))
+
+	res, err := client.CallContract(Context, backend.CallContractRequest{
+		Address: "0x0000000000000000000000000000000000000000",
+		Data:    "0x00",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, core.CallContractResponse{
+		Address: "0x0000000000000000000000000000000000000000",
+		Output:  "0x73756363657373",
+	}, res)
+	client.client.(*ethtest.MockClient).AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+}
+
+func TestEstimateGasDeployOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"EstimateGas": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return:    []interface{}{uint64(21000), nil},
+			},
+		})
+
+	res, err := client.EstimateGas(Context, backend.EstimateGasRequest{
+		Data: "0x00",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, core.EstimateGasResponse{
+		GasLimit:             21000,
+		GasPrice:             "0x3b9aca00",
+		Fee:                  "0x1319718a5000",
+		ConfidentialFallback: false,
+	}, res)
+}
+
+func TestEstimateGasExecuteConfidentialFallback(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMock(client.client.(*ethtest.MockClient))
+
+	res, err := client.EstimateGas(Context, backend.EstimateGasRequest{
+		Address: "0x0000000000000000000000000000000000000000",
+		Data:    "0x00",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, core.EstimateGasResponse{
+		GasLimit:             15177522,
+		GasPrice:             "0x3b9aca00",
+		Fee:                  "0x35ebe037617400",
+		ConfidentialFallback: true,
+	}, res)
+	client.client.(*ethtest.MockClient).AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+}
+
+func TestGetReceiptErr(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"TransactionReceipt": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return:    []interface{}{(*eth.Receipt)(nil), errors.New("error")},
+			},
+		})
+
+	_, err = client.GetReceipt(Context, backend.GetReceiptRequest{
+		Hash: "0x0000000000000000000000000000000000000000000000000000000000000001",
+		AAD:  "aad",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "[1005] error code InternalError with desc Internal Error. Please check the status of the service. with cause error", err.Error())
+}
+
+func TestGetReceiptOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"TransactionReceipt": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return: []interface{}{&eth.Receipt{
+					Receipt: &types.Receipt{
+						Status:          1,
+						GasUsed:         21000,
+						ContractAddress: common.HexToAddress("0x0000000000000000000000000000000000000002"),
+						Logs: []*types.Log{{
+							Address: common.HexToAddress("0x0000000000000000000000000000000000000002"),
+							Topics:  []common.Hash{common.HexToHash("0x03")},
+							Data:    []byte{4},
+						}},
+					},
+					BlockNumber: 7,
+				}, nil},
+			},
+		})
+
+	res, err := client.GetReceipt(Context, backend.GetReceiptRequest{
+		Hash: "0x0000000000000000000000000000000000000000000000000000000000000001",
+		AAD:  "aad",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, core.GetReceiptResponse{
+		Hash:            "0x0000000000000000000000000000000000000000000000000000000000000001",
+		Status:          1,
+		GasUsed:         21000,
+		BlockNumber:     7,
+		ContractAddress: "0x0000000000000000000000000000000000000002",
+		Logs: []core.ReceiptLog{{
+			Address: "0x0000000000000000000000000000000000000002",
+			Topics:  []string{"0x0000000000000000000000000000000000000000000000000000000000000003"},
+			Data:    "0x04",
+		}},
+	}, res)
+}
+
 func TestGetExpiryInvalidAddress(t *testing.T) {
 	client, err := NewClient()
 	assert.Nil(t, err)
@@ -244,8 +397,12 @@ func TestDeployServiceOK(t *testing.T) {
 
 	assert.Nil(t, err)
 	assert.Equal(t, backend.DeployServiceResponse{
-		ID:      uint64(1),
-		Address: "0x0000000000000000000000000000000000000000",
+		ID:              uint64(1),
+		Address:         "0x0000000000000000000000000000000000000000",
+		TransactionHash: "0x00000000000000000000000000000000000000000000000000000000000000000",
+		BlockNumber:     1,
+		GasUsed:         21000,
+		CodeHash:        "0x5380c7b7ae81a58eb98d9c78de4a1fd7fd9535fc953ed2be602daaa41767312a",
 	}, res)
 }
 
@@ -281,9 +438,12 @@ func TestExecuteServiceOK(t *testing.T) {
 
 	assert.Nil(t, err)
 	assert.Equal(t, backend.ExecuteServiceResponse{
-		ID:      uint64(1),
-		Address: "0x5d352cf2160f79CBF3554534cF25A4b42C43D502",
-		Output:  "0x73756363657373",
+		ID:              uint64(1),
+		Address:         "0x5d352cf2160f79CBF3554534cF25A4b42C43D502",
+		Output:          "0x73756363657373",
+		TransactionHash: "0x00000000000000000000000000000000000000000000000000000000000000000",
+		BlockNumber:     1,
+		GasUsed:         21000,
 	}, res)
 }
 
@@ -323,12 +483,28 @@ func TestSubscribeInvalidTopicErr(t *testing.T) {
 
 	c := make(chan interface{})
 	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
-		Event:   "topic",
-		Address: "address",
-		SubID:   "subID",
+		Events:    []string{"topic"},
+		Addresses: []string{"0x0000000000000000000000000000000000000000"},
+		SubID:     "subID",
 	}, c)
 
-	assert.Equal(t, "[2012] error code InputError with desc Only logs topic supported for subscriptions.", err.Error())
+	assert.Equal(t, "[2012] error code InputError with desc Event type not supported for subscriptions.", err.Error())
+}
+
+func TestSubscribeInvalidFilterTopicErr(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMock(client.client.(*ethtest.MockClient))
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events: []string{"logs"},
+		SubID:  "subID",
+		Topics: [][]string{nil, {"0x01"}},
+	}, c)
+
+	assert.Equal(t, "[2019] error code InputError with desc Provided invalid topic.", err.Error())
 }
 
 func TestSubscribeErr(t *testing.T) {
@@ -345,9 +521,9 @@ func TestSubscribeErr(t *testing.T) {
 
 	c := make(chan interface{})
 	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
-		Event:   "logs",
-		Address: "address",
-		SubID:   "subID",
+		Events:    []string{"logs"},
+		Addresses: []string{"0x0000000000000000000000000000000000000000"},
+		SubID:     "subID",
 	}, c)
 
 	assert.Equal(t, "[1000] error code InternalError with desc Internal Error. Please check the status of the service. with cause error", err.Error())
@@ -374,9 +550,9 @@ func TestSubscribeOK(t *testing.T) {
 
 	c := make(chan interface{})
 	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
-		Event:   "logs",
-		Address: "address",
-		SubID:   "subID",
+		Events:    []string{"logs"},
+		Addresses: []string{"0x0000000000000000000000000000000000000000"},
+		SubID:     "subID",
 	}, c)
 	assert.Nil(t, err)
 
@@ -384,6 +560,211 @@ func TestSubscribeOK(t *testing.T) {
 	close(c)
 }
 
+func TestSubscribeMultipleEventsOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	header := &types.Header{Number: big.NewInt(1)}
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"SubscribeNewHead": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return:    []interface{}{&ethtest.MockSubscription{ErrC: make(chan error)}, nil},
+				Run: func(args mock.Arguments) {
+					c := args.Get(1).(chan<- *types.Header)
+					c <- header
+				},
+			},
+		})
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events: []string{"logs", "newHeads"},
+		SubID:  "subID",
+	}, c)
+	assert.Nil(t, err)
+
+	assert.Equal(t, header, <-c)
+	client.client.(*ethtest.MockClient).AssertNumberOfCalls(t, "SubscribeFilterLogs", 1)
+	client.client.(*ethtest.MockClient).AssertNumberOfCalls(t, "SubscribeNewHead", 1)
+}
+
+func TestSubscribeFromBlockOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"FilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return: []interface{}{[]types.Log{
+					{BlockNumber: 1, Index: 0},
+					{BlockNumber: 2, Index: 0},
+				}, nil},
+			},
+			"SubscribeFilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything, mock.Anything},
+				Return:    []interface{}{&ethtest.MockSubscription{ErrC: make(chan error)}, nil},
+				Run: func(args mock.Arguments) {
+					c := args.Get(2).(chan<- types.Log)
+					c <- types.Log{BlockNumber: 2, Index: 0}
+					c <- types.Log{BlockNumber: 2, Index: 1}
+				},
+			},
+		})
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events:    []string{"logs"},
+		SubID:     "subID",
+		FromBlock: 1,
+	}, c)
+	assert.Nil(t, err)
+
+	assert.Equal(t, types.Log{BlockNumber: 1, Index: 0}, <-c)
+	assert.Equal(t, types.Log{BlockNumber: 2, Index: 0}, <-c)
+	assert.Equal(t, types.Log{BlockNumber: 2, Index: 1}, <-c)
+	client.client.(*ethtest.MockClient).AssertCalled(t, "FilterLogs", mock.Anything, ethereum.FilterQuery{
+		FromBlock: big.NewInt(1),
+		Addresses: []common.Address{},
+	})
+}
+
+func TestSubscribeCheckpointOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"FilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return: []interface{}{[]types.Log{
+					{BlockNumber: 2, Index: 0},
+					{BlockNumber: 2, Index: 1},
+					{BlockNumber: 3, Index: 0},
+				}, nil},
+			},
+			"SubscribeFilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything, mock.Anything},
+				Return:    []interface{}{&ethtest.MockSubscription{ErrC: make(chan error)}, nil},
+			},
+		})
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events:     []string{"logs"},
+		SubID:      "subID",
+		FromBlock:  1,
+		Checkpoint: &backend.Checkpoint{BlockNumber: 2, Index: 1},
+	}, c)
+	assert.Nil(t, err)
+
+	assert.Equal(t, types.Log{BlockNumber: 3, Index: 0}, <-c)
+	client.client.(*ethtest.MockClient).AssertCalled(t, "FilterLogs", mock.Anything, ethereum.FilterQuery{
+		FromBlock: big.NewInt(2),
+		Addresses: []common.Address{},
+	})
+}
+
+func TestSubscribeRemovedLogOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"SubscribeFilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything, mock.Anything},
+				Return:    []interface{}{&ethtest.MockSubscription{ErrC: make(chan error)}, nil},
+				Run: func(args mock.Arguments) {
+					c := args.Get(2).(chan<- types.Log)
+					c <- types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x01")}
+					c <- types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x01"), Removed: true}
+					c <- types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x02")}
+				},
+			},
+		})
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events: []string{"logs"},
+		SubID:  "subID",
+	}, c)
+	assert.Nil(t, err)
+
+	// the log that replaces the removed log is delivered even
+	// though it has the same position
+	assert.Equal(t, types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x01")}, <-c)
+	assert.Equal(t, types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x01"), Removed: true}, <-c)
+	assert.Equal(t, types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x02")}, <-c)
+}
+
+func TestSubscribeConfirmationsOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	heads := make(chan chan<- *types.Header, 1)
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"FilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return: []interface{}{[]types.Log{
+					{BlockNumber: 2, Index: 0},
+					{BlockNumber: 3, Index: 0},
+				}, nil},
+			},
+			"SubscribeNewHead": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return:    []interface{}{&ethtest.MockSubscription{ErrC: make(chan error)}, nil},
+				Run: func(args mock.Arguments) {
+					c := args.Get(1).(chan<- *types.Header)
+					c <- &types.Header{Number: big.NewInt(4)}
+					heads <- c
+				},
+			},
+		})
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events:        []string{"logs"},
+		SubID:         "subID",
+		FromBlock:     1,
+		Confirmations: 2,
+	}, c)
+	assert.Nil(t, err)
+
+	assert.Equal(t, types.Log{BlockNumber: 2, Index: 0}, <-c)
+
+	// the second log is only delivered once its block is deep enough
+	(<-heads) <- &types.Header{Number: big.NewInt(5)}
+	assert.Equal(t, types.Log{BlockNumber: 3, Index: 0}, <-c)
+}
+
+func TestSubscribeRetractedCheckpointOK(t *testing.T) {
+	client, err := NewClient()
+	assert.Nil(t, err)
+
+	ethtest.ImplementMockWithOverwrite(client.client.(*ethtest.MockClient),
+		ethtest.MockMethods{
+			"FilterLogs": ethtest.MockMethod{
+				Arguments: []interface{}{mock.Anything, mock.Anything},
+				Return: []interface{}{[]types.Log{
+					{BlockNumber: 2, Index: 0},
+					{BlockNumber: 2, Index: 1},
+				}, nil},
+			},
+		})
+
+	c := make(chan interface{})
+	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
+		Events:     []string{"logs"},
+		SubID:      "subID",
+		Checkpoint: &backend.Checkpoint{BlockNumber: 2, Index: 1, Retracted: true},
+	}, c)
+	assert.Nil(t, err)
+
+	assert.Equal(t, types.Log{BlockNumber: 2, Index: 1}, <-c)
+}
+
 func TestSubscribeSubscriptionErr(t *testing.T) {
 	client, err := NewClient()
 	assert.Nil(t, err)
@@ -410,9 +791,9 @@ func TestSubscribeSubscriptionErr(t *testing.T) {
 
 	c := make(chan interface{})
 	err = client.SubscribeRequest(Context, backend.CreateSubscriptionRequest{
-		Event:   "logs",
-		Address: "address",
-		SubID:   "subID",
+		Events:    []string{"logs"},
+		Addresses: []string{"0x0000000000000000000000000000000000000000"},
+		SubID:     "subID",
 	}, c)
 	assert.Nil(t, err)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/oasislabs/oasis-gateway/backend/core"
@@ -17,6 +18,14 @@ type Deps struct {
 	Logger log.Logger
 	MQueue mqueue.MQueue
 	Client core.Client
+
+	// RequestTimeout is the default timeout for asynchronous requests
+	RequestTimeout time.Duration
+
+	// RestoreSubscriptions if set stores the definitions of the
+	// subscriptions in the mailbox and restores the ones of the
+	// instances that stopped, on startup and periodically
+	RestoreSubscriptions bool
 }
 
 type ClientServices struct {
@@ -45,11 +54,28 @@ func (f RequestManagerFactoryFunc) New(ctx context.Context, deps *Deps) (*core.R
 }
 
 var NewRequestManagerWithDeps = RequestManagerFactoryFunc(func(ctx context.Context, deps *Deps) (*core.RequestManager, error) {
-	return core.NewRequestManager(core.RequestManagerProperties{
-		MQueue: deps.MQueue,
-		Client: deps.Client,
-		Logger: deps.Logger,
-	}), nil
+	manager := core.NewRequestManager(core.RequestManagerProperties{
+		MQueue:         deps.MQueue,
+		Client:         deps.Client,
+		Logger:         deps.Logger,
+		RequestTimeout: deps.RequestTimeout,
+
+		RestoreSubscriptions: deps.RestoreSubscriptions,
+	})
+
+	// the ABIs are loaded first so that the logs of the
+	// restored subscriptions are decoded
+	if err := manager.LoadAbis(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load abis with error %s", err.Error())
+	}
+
+	if deps.RestoreSubscriptions {
+		if err := manager.RestoreSubscriptions(ctx); err != nil {
+			return nil, fmt.Errorf("failed to restore subscriptions with error %s", err.Error())
+		}
+	}
+
+	return manager, nil
 })
 
 var NewBackendClient = ClientFactoryFunc(func(ctx context.Context, services *ClientServices, config *Config) (core.Client, error) {
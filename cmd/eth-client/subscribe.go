@@ -57,8 +57,8 @@ server.`,
 
 	subscribeCmd.PersistentFlags().StringVar(&props.ClientProps.PrivateKey, "privateKey", "", "the hex encoded wallet's private key")
 	subscribeCmd.PersistentFlags().StringVar(&props.ClientProps.URL, "url", "", "the websocket endpoint to the web3 server")
-	subscribeCmd.PersistentFlags().StringVar(&props.Request.Event, "event", "", "event type to subscribe to")
-	subscribeCmd.PersistentFlags().StringVar(&props.Request.Address, "address", "", "service's address")
+	subscribeCmd.PersistentFlags().StringSliceVar(&props.Request.Events, "events", nil, "event types to subscribe to")
+	subscribeCmd.PersistentFlags().StringSliceVar(&props.Request.Addresses, "addresses", nil, "addresses of the services that emit the logs")
 	subscribeCmd.PersistentFlags().StringVar(&props.Request.SubID, "subid", "subscription", "subscription id set by the client. "+
 		"It is an optional value that should not affect the behavour fo the client in any way")
 
@@ -2,15 +2,19 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"runtime"
 	"sync"
 	"time"
 
+	"github.com/oasislabs/oasis-gateway/api/v0/version"
 	"github.com/oasislabs/oasis-gateway/config"
 	"github.com/oasislabs/oasis-gateway/gateway"
 	"github.com/oasislabs/oasis-gateway/log"
 	"github.com/oasislabs/oasis-gateway/rpc"
+	"google.golang.org/grpc"
 )
 
 func publicServer(config *gateway.BindPublicConfig, router *rpc.HttpRouter) {
@@ -55,6 +59,29 @@ func publicServer(config *gateway.BindPublicConfig, router *rpc.HttpRouter) {
 	}
 }
 
+func grpcServer(config *gateway.BindGrpcConfig, server *grpc.Server) {
+	gateway.RootLogger.Info(gateway.RootContext, "listening to port", log.MapFields{
+		"call_type": "GrpcPublicListenAttempt",
+		"port":      config.Port,
+		"interface": config.Interface,
+	})
+
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", config.Interface, config.Port))
+	if err == nil {
+		err = server.Serve(lis)
+	}
+
+	if err != nil {
+		gateway.RootLogger.Fatal(gateway.RootContext, "grpc server failed to listen", log.MapFields{
+			"call_type": "GrpcPublicListenFailure",
+			"port":      config.Port,
+			"interface": config.Interface,
+			"err":       err.Error(),
+		})
+		os.Exit(1)
+	}
+}
+
 func privateServer(config *gateway.BindPrivateConfig, router *rpc.HttpRouter) {
 	httpInterface := config.HttpInterface
 	httpPort := config.HttpPort
@@ -116,6 +143,14 @@ func main() {
 	config := parser.Config.(*gateway.Config)
 	gateway.InitLogger(&config.LoggingConfig)
 
+	gateway.RootLogger.Info(gateway.RootContext, "starting gateway", log.MapFields{
+		"call_type":  "GatewayStartAttempt",
+		"version":    version.Version,
+		"git_commit": version.GitCommit,
+		"build_time": version.BuildTime,
+		"go_version": runtime.Version(),
+	})
+
 	gateway.RootLogger.Info(gateway.RootContext, "bind public configuration parsed", log.MapFields{
 		"callType": "BindPublicConfigParseSuccess",
 	}, &config.BindPublicConfig)
@@ -159,5 +194,13 @@ func main() {
 		wg.Done()
 	}()
 
+	if config.BindPublicConfig.Grpc.Enabled {
+		wg.Add(1)
+		go func() {
+			grpcServer(&config.BindPublicConfig.Grpc, gateway.NewPublicGrpcServer(config, group))
+			wg.Done()
+		}()
+	}
+
 	wg.Wait()
 }
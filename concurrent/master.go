@@ -492,6 +492,7 @@ func (m *Master) createWorker(ctx context.Context, key string, value interface{}
 	ch := make(chan workerRequest, 64)
 	m.workers[key] = NewWorker(m.ctx, WorkerProps{
 		MaxInactivity: props.MaxInactivity,
+		KeepAlive:     props.KeepAlive,
 		Key:           key,
 		DoneC:         m.doneCh,
 		WorkerHandler: props.WorkerHandler,
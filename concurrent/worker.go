@@ -19,6 +19,10 @@ type Worker struct {
 	// should destroy itself
 	maxInactivity time.Duration
 
+	// keepAlive is called when maxInactivity expires to decide
+	// whether the worker is kept even if it is inactive
+	keepAlive func() bool
+
 	// key is the string that uniquely identifies a worker
 	key string
 
@@ -112,6 +116,11 @@ type CreateWorkerProps struct {
 	// without serving any request. When this time expires the worker
 	// should destroy itself
 	MaxInactivity time.Duration
+
+	// KeepAlive is called from the worker's goroutine when MaxInactivity
+	// expires. If it is set and it returns true the worker is kept even
+	// if it is inactive
+	KeepAlive func() bool
 }
 
 // CreateWorkerEvent is triggered by a master when a new worker
@@ -171,6 +180,11 @@ type WorkerProps struct {
 	// without serving any request. When this time expires the worker
 	// should destroy itself
 	MaxInactivity time.Duration
+
+	// KeepAlive is called from the worker's goroutine when MaxInactivity
+	// expires. If it is set and it returns true the worker is kept even
+	// if it is inactive
+	KeepAlive func() bool
 }
 
 // workerDestroyed is the event sent by a worker to the
@@ -234,6 +248,7 @@ func NewWorker(ctx context.Context, props WorkerProps) *Worker {
 	w := &Worker{
 		lastEventTimestamp: time.Now().Unix(),
 		maxInactivity:      props.MaxInactivity,
+		keepAlive:          props.KeepAlive,
 		key:                props.Key,
 		handler:            props.WorkerHandler,
 		SharedC:            props.SharedC,
@@ -271,7 +286,8 @@ func (w *Worker) startLoop(ctx context.Context) {
 			return
 		case <-timer.C:
 			current := time.Now().Unix()
-			if time.Duration(current-w.lastEventTimestamp) > w.maxInactivity {
+			if time.Duration(current-w.lastEventTimestamp) > w.maxInactivity &&
+				(w.keepAlive == nil || !w.keepAlive()) {
 				return
 
 			} else {
@@ -284,6 +284,24 @@ var (
 		desc:     "Internal Error. Please check the status of the service.",
 	}
 
+	ErrQueueWait = ErrorCode{
+		category: InternalError,
+		code:     1045,
+		desc:     "Internal Error. Please check the status of the service.",
+	}
+
+	ErrCallContract = ErrorCode{
+		category: InternalError,
+		code:     1046,
+		desc:     "Internal Error. Please check the status of the service.",
+	}
+
+	ErrQueueCount = ErrorCode{
+		category: InternalError,
+		code:     1047,
+		desc:     "Internal Error. Please check the status of the service.",
+	}
+
 	ErrOutOfRange = ErrorCode{
 		category: InputError,
 		code:     2001,
@@ -350,10 +368,10 @@ var (
 		desc:     "Provided invalid key.",
 	}
 
-	ErrTopicLogsSupported = ErrorCode{
+	ErrEventTypeNotSupported = ErrorCode{
 		category: InputError,
 		code:     2012,
-		desc:     "Only logs topic supported for subscriptions.",
+		desc:     "Event type not supported for subscriptions.",
 	}
 
 	ErrStringNotHex = ErrorCode{
@@ -362,6 +380,60 @@ var (
 		desc:     "Provided string is not a valid hex encoding.",
 	}
 
+	ErrUnknownMessageType = ErrorCode{
+		category: InputError,
+		code:     2014,
+		desc:     "Unknown message type.",
+	}
+
+	ErrIdempotencyKeyTooLong = ErrorCode{
+		category: InputError,
+		code:     2015,
+		desc:     "Idempotency key exceeds the maximum length.",
+	}
+
+	ErrBatchSize = ErrorCode{
+		category: InputError,
+		code:     2016,
+		desc:     "Batch must contain between 1 and 256 calls.",
+	}
+
+	ErrInvalidTransactionHash = ErrorCode{
+		category: InputError,
+		code:     2017,
+		desc:     "Provided invalid transaction hash.",
+	}
+
+	ErrDuplicateEventType = ErrorCode{
+		category: InputError,
+		code:     2018,
+		desc:     "Event type set more than once for a subscription.",
+	}
+
+	ErrInvalidTopic = ErrorCode{
+		category: InputError,
+		code:     2019,
+		desc:     "Provided invalid topic.",
+	}
+
+	ErrInvalidSubscriptionFilter = ErrorCode{
+		category: InputError,
+		code:     2020,
+		desc:     "Provided invalid subscription filter.",
+	}
+
+	ErrInvalidAbi = ErrorCode{
+		category: InputError,
+		code:     2021,
+		desc:     "Provided invalid contract ABI.",
+	}
+
+	ErrInvalidConfirmations = ErrorCode{
+		category: InputError,
+		code:     2022,
+		desc:     "Provided invalid number of confirmations.",
+	}
+
 	ErrQueueLimitReached = ErrorCode{
 		category: ResourceLimitReached,
 		code:     3001,
@@ -381,6 +453,36 @@ var (
 		desc:     "Attempt to create a subscription that already exists.",
 	}
 
+	ErrIdempotencyKeyInProgress = ErrorCode{
+		category: StateConflict,
+		code:     4003,
+		desc:     "A request with the same idempotency key is still being started.",
+	}
+
+	ErrRequestCancelled = ErrorCode{
+		category: StateConflict,
+		code:     4004,
+		desc:     "Request was cancelled before its transaction was submitted.",
+	}
+
+	ErrRequestNotCancellable = ErrorCode{
+		category: StateConflict,
+		code:     4005,
+		desc:     "Request is not pending or its transaction has already been submitted.",
+	}
+
+	ErrRequestTimeout = ErrorCode{
+		category: StateConflict,
+		code:     4006,
+		desc:     "Request did not complete before its timeout expired.",
+	}
+
+	ErrIdempotencyKeyMismatch = ErrorCode{
+		category: StateConflict,
+		code:     4007,
+		desc:     "Idempotency key was already used for a request with a different payload.",
+	}
+
 	ErrAPINotImplemented = ErrorCode{
 		category: NotImplemented,
 		code:     5001,
@@ -399,6 +501,24 @@ var (
 		desc:     "Subscription not found.",
 	}
 
+	ErrRequestStatusNotFound = ErrorCode{
+		category: NotFound,
+		code:     6003,
+		desc:     "Request status not found.",
+	}
+
+	ErrReceiptNotFound = ErrorCode{
+		category: NotFound,
+		code:     6004,
+		desc:     "Transaction receipt not found.",
+	}
+
+	ErrAbiNotFound = ErrorCode{
+		category: NotFound,
+		code:     6005,
+		desc:     "Contract ABI not found.",
+	}
+
 	ErrInvalidAAD = ErrorCode{
 		category: AuthenticationError,
 		code:     7001,
@@ -422,6 +542,12 @@ var (
 		code:     7004,
 		desc:     "Failed to verify request.",
 	}
+
+	ErrTransactionNotOwned = ErrorCode{
+		category: AuthenticationError,
+		code:     7005,
+		desc:     "Transaction was not submitted by the caller.",
+	}
 )
 
 // Category defines error categories that logically group them. This classification
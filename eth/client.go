@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -25,22 +26,27 @@ var (
 )
 
 type Client interface {
+	CallContract(context.Context, ethereum.CallMsg) ([]byte, error)
 	EstimateGas(context.Context, ethereum.CallMsg) (uint64, error)
 	GetExpiry(context.Context, common.Address) (uint64, error)
 	GetPublicKey(context.Context, common.Address) (PublicKey, error)
 	NonceAt(context.Context, common.Address) (uint64, error)
 	SendTransaction(context.Context, *types.Transaction) (SendTransactionResponse, error)
+	FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error)
 	SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error)
-	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	SubscribeNewHead(context.Context, chan<- *types.Header) (ethereum.Subscription, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*Receipt, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	GetCode(ctx context.Context, addr common.Address) (string, error)
 }
 
 type ethClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
 	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
 	NonceAt(ctx context.Context, account common.Address, n *big.Int) (uint64, error)
-	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
 	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, c chan<- types.Log) (ethereum.Subscription, error)
+	SubscribeNewHead(ctx context.Context, c chan<- *types.Header) (ethereum.Subscription, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	CodeAt(ctx context.Context, addr common.Address, blockNumber *big.Int) ([]byte, error)
 	Close()
@@ -118,6 +124,18 @@ func (c *PooledClient) request(ctx context.Context, fn func(conn *Conn) (interfa
 	return v, nil
 }
 
+func (c *PooledClient) CallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	v, err := c.request(ctx, func(conn *Conn) (interface{}, error) {
+		return conn.eclient.CallContract(ctx, msg, nil)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]byte), nil
+}
+
 func (c *PooledClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
 	v, err := c.request(ctx, func(conn *Conn) (interface{}, error) {
 		return conn.eclient.EstimateGas(ctx, msg)
@@ -227,16 +245,39 @@ func (c *PooledClient) GetCode(ctx context.Context, addr common.Address) (string
 	return hexutil.Encode(v.([]byte)), nil
 }
 
-func (c *PooledClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+func (c *PooledClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*Receipt, error) {
 	v, err := c.request(ctx, func(conn *Conn) (interface{}, error) {
-		return conn.eclient.TransactionReceipt(ctx, txHash)
+		// the receipt is retrieved with a raw call because the receipt
+		// returned by ethclient does not keep the number of the block
+		// that includes the transaction
+		var res json.RawMessage
+		if err := conn.rclient.CallContext(ctx, &res, "eth_getTransactionReceipt", txHash); err != nil {
+			return nil, err
+		}
+
+		return decodeReceipt(res)
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return v.(*types.Receipt), nil
+	return v.(*Receipt), nil
+}
+
+func (c *PooledClient) FilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+) ([]types.Log, error) {
+	v, err := c.request(ctx, func(conn *Conn) (interface{}, error) {
+		return conn.eclient.FilterLogs(ctx, q)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]types.Log), nil
 }
 
 func (c *PooledClient) SubscribeFilterLogs(
@@ -255,6 +296,21 @@ func (c *PooledClient) SubscribeFilterLogs(
 	return v.(ethereum.Subscription), nil
 }
 
+func (c *PooledClient) SubscribeNewHead(
+	ctx context.Context,
+	ch chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	v, err := c.request(ctx, func(conn *Conn) (interface{}, error) {
+		return conn.eclient.SubscribeNewHead(ctx, ch)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(ethereum.Subscription), nil
+}
+
 type Conn struct {
 	eclient ethClient
 	rclient rpcClient
@@ -2,8 +2,10 @@ package eth
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,6 +66,15 @@ func (c *mockEthClient) CodeAt(ctx context.Context, address common.Address, bloc
 	return args.Get(0).([]byte), nil
 }
 
+func (c *mockEthClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	args := c.Called(ctx, msg, blockNumber)
+	if args.Get(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]byte), nil
+}
+
 func (c *mockEthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
 	args := c.Called(ctx, msg)
 	if args.Get(1) != nil {
@@ -82,17 +93,26 @@ func (c *mockEthClient) NonceAt(ctx context.Context, account common.Address, n *
 	return args.Get(0).(uint64), nil
 }
 
-func (c *mockEthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-	args := c.Called(ctx, txHash)
+func (c *mockEthClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	args := c.Called(ctx, q, ch)
 	if args.Get(1) != nil {
 		return nil, args.Error(1)
 	}
 
-	return args.Get(0).(*types.Receipt), nil
+	return args.Get(0).(ethereum.Subscription), nil
 }
 
-func (c *mockEthClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	args := c.Called(ctx, q, ch)
+func (c *mockEthClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	args := c.Called(ctx, q)
+	if args.Get(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]types.Log), nil
+}
+
+func (c *mockEthClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	args := c.Called(ctx, ch)
 	if args.Get(1) != nil {
 		return nil, args.Error(1)
 	}
@@ -209,3 +229,30 @@ func TestPooledClientSendTransactionCallErr(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "maximum number of attempts 10 reached with last error error", err.Error())
 }
+
+func TestPooledClientTransactionReceiptOK(t *testing.T) {
+	pool := mockPool{conn: &Conn{eclient: &mockEthClient{}, rclient: &mockRpcClient{}}}
+	c := NewPooledClient(PooledClientProps{
+		Pool:        pool,
+		RetryConfig: TestRetryConfig,
+	})
+
+	hash := common.HexToHash("0x01")
+	pool.conn.rclient.(*mockRpcClient).
+		On("CallContext", mock.Anything, mock.Anything, "eth_getTransactionReceipt", []interface{}{hash}).
+		Run(func(args mock.Arguments) {
+			res := args[1].(*json.RawMessage)
+			*res = json.RawMessage(`{"status":"0x1","cumulativeGasUsed":"0x5208",` +
+				`"logsBloom":"0x` + strings.Repeat("0", 512) + `","logs":[],` +
+				`"transactionHash":"` + hash.Hex() + `",` +
+				`"contractAddress":"0x0000000000000000000000000000000000000000",` +
+				`"gasUsed":"0x5208","blockNumber":"0x10"}`)
+		}).
+		Return(nil)
+
+	receipt, err := c.TransactionReceipt(context.Background(), hash)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(16), receipt.BlockNumber)
+	assert.Equal(t, uint64(21000), receipt.GasUsed)
+	assert.Equal(t, hash, receipt.TxHash)
+}
@@ -1,5 +1,13 @@
 package eth
 
+import (
+	"encoding/json"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
 type PublicKey struct {
 	Timestamp uint64 `json:"timestamp"`
 	PublicKey string `json:"public_key"`
@@ -12,6 +20,37 @@ type SendTransactionResponse struct {
 	Hash   string `json:"transactionHash"`
 }
 
+// Receipt is the receipt of a transaction along with the number
+// of the block that includes the transaction
+type Receipt struct {
+	*types.Receipt
+
+	// BlockNumber is the number of the block that includes
+	// the transaction
+	BlockNumber uint64
+}
+
+// decodeReceipt decodes the receipt returned by eth_getTransactionReceipt
+func decodeReceipt(p json.RawMessage) (*Receipt, error) {
+	if len(p) == 0 || string(p) == "null" {
+		return nil, ethereum.NotFound
+	}
+
+	var receipt types.Receipt
+	if err := json.Unmarshal(p, &receipt); err != nil {
+		return nil, err
+	}
+
+	var block struct {
+		BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	}
+	if err := json.Unmarshal(p, &block); err != nil {
+		return nil, err
+	}
+
+	return &Receipt{Receipt: &receipt, BlockNumber: uint64(block.BlockNumber)}, nil
+}
+
 type sendTransactionResponseDeserialize struct {
 	Output string `json:"output"`
 	Status string `json:"status"`
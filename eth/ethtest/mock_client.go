@@ -20,6 +20,10 @@ type MockMethod struct {
 type MockMethods map[string]MockMethod
 
 var DefaultMockMethods = map[string]MockMethod{
+	"CallContract": {
+		Arguments: []interface{}{mock.Anything, mock.Anything},
+		Return:    []interface{}{[]byte("success"), nil},
+	},
 	"EstimateGas": {
 		Arguments: []interface{}{mock.Anything, mock.Anything},
 		Return:    []interface{}{uint64(0), nil},
@@ -39,9 +43,13 @@ var DefaultMockMethods = map[string]MockMethod{
 	"TransactionReceipt": {
 		Arguments: []interface{}{mock.Anything, mock.Anything},
 		Return: []interface{}{
-			&types.Receipt{
-				Status:          1,
-				ContractAddress: common.HexToAddress("0x0000000000000000000000000000000000000000"),
+			&eth.Receipt{
+				Receipt: &types.Receipt{
+					Status:          1,
+					ContractAddress: common.HexToAddress("0x0000000000000000000000000000000000000000"),
+					GasUsed:         21000,
+				},
+				BlockNumber: 1,
 			}, nil,
 		},
 	},
@@ -69,12 +77,22 @@ var DefaultMockMethods = map[string]MockMethod{
 			}, nil,
 		},
 	},
+	"FilterLogs": {
+		Arguments: []interface{}{mock.Anything, mock.Anything},
+		Return:    []interface{}{[]types.Log{}, nil},
+	},
 	"SubscribeFilterLogs": {
 		Arguments: []interface{}{mock.Anything, mock.Anything, mock.Anything},
 		Return: []interface{}{
 			&MockSubscription{ErrC: make(chan error)}, nil,
 		},
 	},
+	"SubscribeNewHead": {
+		Arguments: []interface{}{mock.Anything, mock.Anything},
+		Return: []interface{}{
+			&MockSubscription{ErrC: make(chan error)}, nil,
+		},
+	},
 }
 
 func OverwriteDefaults(overwrite MockMethods) MockMethods {
@@ -128,6 +146,18 @@ func (c *MockClient) BalanceAt(
 	return args.Get(0).(*big.Int), nil
 }
 
+func (m *MockClient) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+) ([]byte, error) {
+	args := m.Called(ctx, msg)
+	if args.Get(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]byte), nil
+}
+
 func (m *MockClient) EstimateGas(
 	ctx context.Context,
 	msg ethereum.CallMsg,
@@ -176,6 +206,17 @@ func (m *MockClient) SendTransaction(
 	return args.Get(0).(eth.SendTransactionResponse), args.Error(1)
 }
 
+func (m *MockClient) FilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+) ([]types.Log, error) {
+	args := m.Called(ctx, q)
+	if args.Get(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]types.Log), nil
+}
+
 func (m *MockClient) SubscribeFilterLogs(
 	ctx context.Context,
 	q ethereum.FilterQuery,
@@ -188,7 +229,18 @@ func (m *MockClient) SubscribeFilterLogs(
 	return args.Get(0).(*MockSubscription), nil
 }
 
-func (m *MockClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+func (m *MockClient) SubscribeNewHead(
+	ctx context.Context,
+	c chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	args := m.Called(ctx, c)
+	if args.Get(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MockSubscription), nil
+}
+
+func (m *MockClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*eth.Receipt, error) {
 	args := m.Called(ctx, txHash)
-	return args.Get(0).(*types.Receipt), args.Error(1)
+	return args.Get(0).(*eth.Receipt), args.Error(1)
 }
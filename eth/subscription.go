@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 
 	ethereum "github.com/ethereum/go-ethereum"
@@ -35,12 +36,28 @@ func (s *EthSubscription) Err() <-chan error {
 }
 
 // LogSubscriber creates log based subscriptions
-// using the underlying clients
+// using the underlying clients. If the FilterQuery
+// sets a FromBlock, the past logs from that block are
+// delivered before the logs of the live subscription
 type LogSubscriber struct {
 	lock        sync.Mutex
 	FilterQuery ethereum.FilterQuery
 	BlockNumber uint64
 	Index       uint
+
+	// Delivered is true once a log has been delivered, in which
+	// case BlockNumber and Index refer to the last delivered log.
+	// It can be set to resume a subscription from a log
+	Delivered bool
+
+	// Confirmations is the number of blocks that need to be added on
+	// top of the block of a log before the log is delivered. If 0, the
+	// logs are delivered as soon as they are received
+	Confirmations uint64
+
+	// pending are the logs that are not confirmed yet ordered by
+	// their position. They are kept across resubscriptions
+	pending []types.Log
 }
 
 func (s *LogSubscriber) createSubscription(
@@ -53,7 +70,120 @@ func (s *LogSubscriber) createSubscription(
 	return client.SubscribeFilterLogs(ctx, s.FilterQuery, clog)
 }
 
-// Subscribe implementation of Subscriber for LogSubscriber
+// backfill retrieves the past logs that match the filter from
+// its FromBlock, if it is set
+func (s *LogSubscriber) backfill(ctx context.Context, client Client) ([]types.Log, error) {
+	s.lock.Lock()
+	query := s.FilterQuery
+	s.lock.Unlock()
+
+	if query.FromBlock == nil {
+		return nil, nil
+	}
+
+	return client.FilterLogs(ctx, query)
+}
+
+// advance moves the offsets tracked by the subscriber to the log. It
+// returns false if the log is previous to the offsets, in which case
+// it has already been delivered and it should be discarded
+func (s *LogSubscriber) advance(ev types.Log) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if ev.BlockNumber < s.BlockNumber ||
+		(ev.BlockNumber == s.BlockNumber && ev.Index < s.Index) ||
+		(s.Delivered && ev.BlockNumber == s.BlockNumber && ev.Index == s.Index) {
+		return false
+	}
+
+	s.BlockNumber = ev.BlockNumber
+	s.Index = ev.Index
+	s.Delivered = true
+	return true
+}
+
+// rewind moves the offsets tracked by the subscriber back to a log
+// removed by a reorg if it is previous to them, so that the logs that
+// replace it in the new chain are not discarded as delivered
+func (s *LogSubscriber) rewind(ev types.Log) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if ev.BlockNumber > s.BlockNumber ||
+		(ev.BlockNumber == s.BlockNumber && ev.Index > s.Index) {
+		return
+	}
+
+	s.BlockNumber = ev.BlockNumber
+	s.Index = ev.Index
+	s.Delivered = false
+}
+
+// hold keeps a log until it is confirmed. The pending logs are kept
+// ordered by their position so that they are delivered in order
+func (s *LogSubscriber) hold(ev types.Log) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	i := sort.Search(len(s.pending), func(i int) bool {
+		p := s.pending[i]
+		return p.BlockNumber > ev.BlockNumber ||
+			(p.BlockNumber == ev.BlockNumber && p.Index >= ev.Index)
+	})
+
+	// the logs of the live subscription may overlap with the past logs
+	if i < len(s.pending) && s.pending[i].BlockHash == ev.BlockHash && s.pending[i].Index == ev.Index {
+		return
+	}
+
+	s.pending = append(s.pending, types.Log{})
+	copy(s.pending[i+1:], s.pending[i:])
+	s.pending[i] = ev
+}
+
+// drop removes a pending log that has been removed by a reorg. It
+// returns false if the log is not pending
+func (s *LogSubscriber) drop(ev types.Log) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i, p := range s.pending {
+		if p.BlockHash == ev.BlockHash && p.Index == ev.Index {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// confirm returns the pending logs that are confirmed once the
+// chain has reached the head and stops holding them
+func (s *LogSubscriber) confirm(head uint64) []types.Log {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	i := 0
+	for i < len(s.pending) && s.pending[i].BlockNumber+s.Confirmations <= head {
+		i++
+	}
+
+	logs := s.pending[:i:i]
+	s.pending = s.pending[i:]
+	return logs
+}
+
+// Subscribe implementation of Subscriber for LogSubscriber. The live
+// subscription is created before the past logs are retrieved, so
+// that no logs are missed in between. The logs of the live
+// subscription that overlap with the past logs are discarded.
+//
+// Logs removed by a reorg are forwarded with Removed set, so that the
+// events delivered for them can be retracted. If Confirmations is set,
+// the subscriber also follows the heads of the chain and holds the logs
+// until they are deep enough, in which case the logs removed while
+// they are held are just dropped
 func (s *LogSubscriber) Subscribe(
 	ctx context.Context,
 	client Client,
@@ -67,16 +197,55 @@ func (s *LogSubscriber) Subscribe(
 		return nil, err
 	}
 
+	var heads ethereum.Subscription
+	var cheader chan *types.Header
+	if s.Confirmations > 0 {
+		cheader = make(chan *types.Header, 64)
+		heads, err = client.SubscribeNewHead(ctx, cheader)
+		if err != nil {
+			sub.Unsubscribe()
+			return nil, err
+		}
+	}
+
+	logs, err := s.backfill(ctx, client)
+	if err != nil {
+		sub.Unsubscribe()
+		if heads != nil {
+			heads.Unsubscribe()
+		}
+		return nil, err
+	}
+
 	go func() {
 		defer func() {
 			// ensure that if the subscriber is started again it will start
 			// from the block from which it stopped
 			s.lock.Lock()
 			defer s.lock.Unlock()
-			s.FilterQuery.FromBlock = big.NewInt(0).SetUint64(s.BlockNumber)
+			if s.Delivered || s.BlockNumber > 0 {
+				s.FilterQuery.FromBlock = big.NewInt(0).SetUint64(s.BlockNumber)
+			}
 			close(cerr)
 		}()
 
+		for _, ev := range logs {
+			if s.Confirmations > 0 {
+				s.hold(ev)
+				continue
+			}
+
+			if !s.advance(ev) {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case c <- ev:
+			}
+		}
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -86,19 +255,90 @@ func (s *LogSubscriber) Subscribe(
 					return
 				}
 
+				if ev.Removed {
+					if !s.drop(ev) {
+						s.rewind(ev)
+						c <- ev
+					}
+					continue
+				}
+
+				if s.Confirmations > 0 {
+					s.hold(ev)
+					continue
+				}
+
 				// in case events are received that are previous to the offsets
 				// tracked by the subscriber, the events are discarded
-				if ev.BlockNumber < s.BlockNumber ||
-					(ev.BlockNumber == s.BlockNumber && ev.Index < s.Index) {
+				if !s.advance(ev) {
 					continue
 				}
 
-				s.lock.Lock()
-				s.BlockNumber = ev.BlockNumber
-				s.Index = ev.Index
-				s.lock.Unlock()
-
 				c <- ev
+			case header, ok := <-cheader:
+				if !ok {
+					return
+				}
+
+				for _, ev := range s.confirm(header.Number.Uint64()) {
+					if !s.advance(ev) {
+						continue
+					}
+
+					c <- ev
+				}
+			case err, ok := <-sub.Err():
+				if !ok {
+					return
+				}
+
+				cerr <- err
+				return
+			}
+		}
+	}()
+
+	logSub := &EthSubscription{sub: sub, err: cerr}
+	if heads == nil {
+		return logSub, nil
+	}
+
+	// the subscription fails as a whole if the heads of the chain
+	// cannot be followed, so that it is created again
+	return newMultiSubscription([]ethereum.Subscription{logSub, heads}), nil
+}
+
+// HeadSubscriber creates subscriptions to the headers of
+// the blocks added to the chain
+type HeadSubscriber struct{}
+
+// Subscribe implementation of Subscriber for HeadSubscriber
+func (s *HeadSubscriber) Subscribe(
+	ctx context.Context,
+	client Client,
+	c chan<- interface{},
+) (ethereum.Subscription, error) {
+	cerr := make(chan error)
+	cheader := make(chan *types.Header, 64)
+
+	sub, err := client.SubscribeNewHead(ctx, cheader)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer close(cerr)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case header, ok := <-cheader:
+				if !ok {
+					return
+				}
+
+				c <- header
 			case err, ok := <-sub.Err():
 				if !ok {
 					return
@@ -113,6 +353,87 @@ func (s *LogSubscriber) Subscribe(
 	return &EthSubscription{sub: sub, err: cerr}, nil
 }
 
+// MultiSubscriber creates a subscription for each of its
+// subscribers and forwards all their events on the same
+// channel, so that they can be handled as one subscription
+type MultiSubscriber struct {
+	Subscribers []Subscriber
+}
+
+// Subscribe implementation of Subscriber for MultiSubscriber. If one of
+// the subscriptions fails, all of them are closed and the error is
+// reported, so that the subscription can be created again as a whole
+func (s *MultiSubscriber) Subscribe(
+	ctx context.Context,
+	client Client,
+	c chan<- interface{},
+) (ethereum.Subscription, error) {
+	subs := make([]ethereum.Subscription, 0, len(s.Subscribers))
+	for _, subscriber := range s.Subscribers {
+		sub, err := subscriber.Subscribe(ctx, client, c)
+		if err != nil {
+			for _, sub := range subs {
+				sub.Unsubscribe()
+			}
+			return nil, err
+		}
+
+		subs = append(subs, sub)
+	}
+
+	return newMultiSubscription(subs), nil
+}
+
+// multiSubscription groups subscriptions so that they are
+// closed together and report the first error that any of
+// them encounters
+type multiSubscription struct {
+	subs []ethereum.Subscription
+	err  chan error
+	once sync.Once
+}
+
+func newMultiSubscription(subs []ethereum.Subscription) *multiSubscription {
+	m := &multiSubscription{subs: subs, err: make(chan error, 1)}
+
+	for _, sub := range subs {
+		go func(sub ethereum.Subscription) {
+			err, ok := <-sub.Err()
+			if !ok {
+				return
+			}
+
+			m.close(err)
+		}(sub)
+	}
+
+	return m
+}
+
+func (m *multiSubscription) close(err error) {
+	m.once.Do(func() {
+		for _, sub := range m.subs {
+			sub.Unsubscribe()
+		}
+
+		if err != nil {
+			m.err <- err
+		}
+		close(m.err)
+	})
+}
+
+// Unsubscribe closes all the subscriptions
+func (m *multiSubscription) Unsubscribe() {
+	m.close(nil)
+}
+
+// Err returns a channel to retrieve the first error of any
+// of the subscriptions
+func (m *multiSubscription) Err() <-chan error {
+	return m.err
+}
+
 // Subscriber is an interface for types that creates subscriptions
 // against an ethereum-like backend
 type Subscriber interface {
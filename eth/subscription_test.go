@@ -0,0 +1,58 @@
+package eth
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSubscriberConfirm(t *testing.T) {
+	s := &LogSubscriber{Confirmations: 2}
+
+	s.hold(types.Log{BlockNumber: 3, BlockHash: common.HexToHash("0x03")})
+	s.hold(types.Log{BlockNumber: 2, Index: 1, BlockHash: common.HexToHash("0x02")})
+	s.hold(types.Log{BlockNumber: 2, Index: 0, BlockHash: common.HexToHash("0x02")})
+	s.hold(types.Log{BlockNumber: 2, Index: 1, BlockHash: common.HexToHash("0x02")})
+
+	assert.Equal(t, []types.Log{}, s.confirm(3))
+	assert.Equal(t, []types.Log{
+		{BlockNumber: 2, Index: 0, BlockHash: common.HexToHash("0x02")},
+		{BlockNumber: 2, Index: 1, BlockHash: common.HexToHash("0x02")},
+	}, s.confirm(4))
+	assert.Equal(t, []types.Log{
+		{BlockNumber: 3, BlockHash: common.HexToHash("0x03")},
+	}, s.confirm(5))
+}
+
+func TestLogSubscriberDrop(t *testing.T) {
+	s := &LogSubscriber{Confirmations: 2}
+
+	s.hold(types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x02")})
+	s.hold(types.Log{BlockNumber: 3, BlockHash: common.HexToHash("0x03")})
+
+	assert.True(t, s.drop(types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x02"), Removed: true}))
+	assert.False(t, s.drop(types.Log{BlockNumber: 2, BlockHash: common.HexToHash("0x02"), Removed: true}))
+	assert.Equal(t, []types.Log{
+		{BlockNumber: 3, BlockHash: common.HexToHash("0x03")},
+	}, s.confirm(5))
+}
+
+func TestLogSubscriberRewind(t *testing.T) {
+	s := &LogSubscriber{}
+
+	assert.True(t, s.advance(types.Log{BlockNumber: 3, Index: 1}))
+
+	s.rewind(types.Log{BlockNumber: 4, Index: 0, Removed: true})
+	assert.Equal(t, uint64(3), s.BlockNumber)
+	assert.True(t, s.Delivered)
+
+	s.rewind(types.Log{BlockNumber: 3, Index: 0, Removed: true})
+	assert.Equal(t, uint64(3), s.BlockNumber)
+	assert.Equal(t, uint(0), s.Index)
+	assert.False(t, s.Delivered)
+
+	assert.False(t, s.advance(types.Log{BlockNumber: 2, Index: 0}))
+	assert.True(t, s.advance(types.Log{BlockNumber: 3, Index: 0}))
+}
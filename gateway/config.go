@@ -144,6 +144,29 @@ func (c *BindConfig) Bind(prefix string, v *viper.Viper, cmd *cobra.Command) err
 type BindPublicConfig struct {
 	BindConfig
 	rpc.HttpCorsPreProcessorProps
+
+	// Grpc is the configuration for serving the public
+	// APIs over gRPC
+	Grpc BindGrpcConfig
+
+	// Ws is the configuration for the websocket connections
+	Ws BindWsConfig
+}
+
+// BindGrpcConfig is the configuration for binding the gRPC
+// server to the computer network interface
+type BindGrpcConfig struct {
+	Enabled   bool
+	Interface string
+	Port      int32
+}
+
+// BindWsConfig is the configuration for the websocket
+// connections opened on the public API
+type BindWsConfig struct {
+	// AllowedOrigins are the origins other than the one of the
+	// gateway from which a browser can open a connection
+	AllowedOrigins []string
 }
 
 func (c *BindPublicConfig) Log(fields log.Fields) {
@@ -163,6 +186,10 @@ func (c *BindPublicConfig) Log(fields log.Fields) {
 	fields.Add("bind_public.http_cors.exposed_headers", c.HttpCorsPreProcessorProps.ExposedHeaders)
 	fields.Add("bind_public.http_cors.max_age", c.HttpCorsPreProcessorProps.MaxAge)
 	fields.Add("bind_public.http_cors.allowed_credentials", c.HttpCorsPreProcessorProps.AllowCredentials)
+	fields.Add("bind_public.grpc.enabled", c.Grpc.Enabled)
+	fields.Add("bind_public.grpc.interface", c.Grpc.Interface)
+	fields.Add("bind_public.grpc.port", c.Grpc.Port)
+	fields.Add("bind_public.ws.allowed_origins", c.Ws.AllowedOrigins)
 }
 
 func (c *BindPublicConfig) Configure(v *viper.Viper) error {
@@ -178,6 +205,20 @@ func (c *BindPublicConfig) Configure(v *viper.Viper) error {
 	c.HttpCorsPreProcessorProps.MaxAge = v.GetInt("bind_public.http_cors.max_age")
 	c.HttpCorsPreProcessorProps.AllowCredentials = v.GetBool("bind_public.http_cors.allowed_credentials")
 
+	c.Grpc.Enabled = v.GetBool("bind_public.grpc.enabled")
+	c.Grpc.Interface = v.GetString("bind_public.grpc.interface")
+	c.Grpc.Port = v.GetInt32("bind_public.grpc.port")
+	if c.Grpc.Enabled {
+		if len(c.Grpc.Interface) == 0 {
+			return errors.New("bind_public.grpc.interface must be set if bind_public.grpc.enabled is set")
+		}
+		if c.Grpc.Port > 65535 || c.Grpc.Port < 0 {
+			return errors.New("bind_public.grpc.port must be an integer between 0 and 65535")
+		}
+	}
+
+	c.Ws.AllowedOrigins = v.GetStringSlice("bind_public.ws.allowed_origins")
+
 	return nil
 }
 
@@ -200,6 +241,15 @@ func (c *BindPublicConfig) Bind(v *viper.Viper, cmd *cobra.Command) error {
 		"exposed headers for CORS")
 	cmd.PersistentFlags().Bool("bind_public.http_cors.allowed_credentials", true,
 		"whether credentials are allowed when using CORS")
+	cmd.PersistentFlags().Bool("bind_public.grpc.enabled", false,
+		"if set the public APIs are also served over gRPC")
+	cmd.PersistentFlags().String("bind_public.grpc.interface", "127.0.0.1",
+		"interface to bind for gRPC")
+	cmd.PersistentFlags().Int32("bind_public.grpc.port", 1236,
+		"port to listen to for gRPC")
+	cmd.PersistentFlags().StringSlice("bind_public.ws.allowed_origins", nil,
+		"origins other than the one of the gateway from which browsers can open websocket connections. "+
+			"If set to * any origin is allowed")
 
 	return nil
 }
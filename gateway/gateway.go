@@ -2,12 +2,22 @@ package gateway
 
 import (
 	"context"
+	"reflect"
+	"time"
 
+	"github.com/oasislabs/oasis-gateway/api/v0/abi"
 	"github.com/oasislabs/oasis-gateway/api/v0/event"
+	eventgrpc "github.com/oasislabs/oasis-gateway/api/v0/event/grpc"
 	"github.com/oasislabs/oasis-gateway/api/v0/health"
+	"github.com/oasislabs/oasis-gateway/api/v0/openapi"
 	"github.com/oasislabs/oasis-gateway/api/v0/service"
+	servicegrpc "github.com/oasislabs/oasis-gateway/api/v0/service/grpc"
+	"github.com/oasislabs/oasis-gateway/api/v0/version"
+	"github.com/oasislabs/oasis-gateway/api/v0/ws"
 	"github.com/oasislabs/oasis-gateway/auth"
 	authcore "github.com/oasislabs/oasis-gateway/auth/core"
+	"github.com/oasislabs/oasis-gateway/auth/insecure"
+	"github.com/oasislabs/oasis-gateway/auth/oauth"
 	"github.com/oasislabs/oasis-gateway/backend"
 	backendcore "github.com/oasislabs/oasis-gateway/backend/core"
 	"github.com/oasislabs/oasis-gateway/callback"
@@ -17,6 +27,7 @@ import (
 	mqueuecore "github.com/oasislabs/oasis-gateway/mqueue/core"
 	"github.com/oasislabs/oasis-gateway/rpc"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 // RootLogger is the base logger of the application, all
@@ -114,9 +125,11 @@ func NewServiceGroupWithFactories(ctx context.Context, config *Config, factories
 	}
 
 	request, err := factories.BackendRequestManager.New(ctx, &backend.Deps{
-		Logger: RootLogger,
-		MQueue: mqueue,
-		Client: client,
+		Logger:               RootLogger,
+		MQueue:               mqueue,
+		Client:               client,
+		RequestTimeout:       config.BackendConfig.RequestTimeout,
+		RestoreSubscriptions: config.BackendConfig.RestoreSubscriptions,
 	})
 	if err != nil {
 		return nil, err
@@ -185,6 +198,11 @@ func NewPrivateRouter(config *Config, services Services, group *ServiceGroup) *r
 	})
 
 	health.BindHandler(&health.Deps{Collector: services}, binder)
+	version.BindHandler(NewVersionDeps(config), binder)
+	abi.BindHandler(abi.Services{
+		Logger: RootLogger,
+		Client: group.Request,
+	}, binder)
 
 	return binder.Build()
 }
@@ -209,6 +227,97 @@ func NewPublicRouter(config *Config, group *ServiceGroup) *rpc.HttpRouter {
 		binder.AddPreProcessor(rpc.NewHttpCorsPreProcessor(config.BindPublicConfig.HttpCorsPreProcessorProps))
 	}
 
+	// the service and event handlers are also exposed as JSON-RPC
+	// methods on a single endpoint
+	jsonRpcBinder := rpc.NewJsonRpcBinder(rpc.JsonRpcBinderProperties{Logger: RootLogger})
+	writeTimeout := time.Duration(config.BindPublicConfig.HttpWriteTimeoutMs) * time.Millisecond
+	for _, b := range []rpc.HandlerBinder{binder, jsonRpcBinder} {
+		service.BindHandler(service.Services{
+			Logger:       RootLogger,
+			Client:       group.Request,
+			Verifier:     group.Authenticator,
+			WriteTimeout: writeTimeout,
+		}, b)
+		event.BindHandler(event.Services{
+			Logger:       RootLogger,
+			Client:       group.Request,
+			WriteTimeout: writeTimeout,
+		}, b)
+	}
+	binder.Bind("POST", "/v0/api/jsonrpc", jsonRpcBinder.Build(),
+		rpc.EntityFactoryFunc(rpc.NewJsonRpcEntity))
+	ws.BindHandler(ws.Services{
+		Logger:         RootLogger,
+		Client:         group.Request,
+		AllowedOrigins: config.BindPublicConfig.Ws.AllowedOrigins,
+	}, binder)
+
+	// browsers cannot set the headers of the requests that open a
+	// websocket connection or an EventSource, so they can be provided
+	// in the query
+	binder.AddPreProcessor(rpc.NewHttpQueryHeaderPreProcessor(rpc.HttpQueryHeaderPreProcessorProps{
+		Paths: []string{
+			"/v0/api/ws",
+			"/v0/api/service/poll/stream",
+			"/v0/api/event/poll/stream",
+		},
+		Headers: []string{
+			authcore.RequestHeaderSessionKey,
+			insecure.HeaderKey,
+			oauth.GOOGLE_ID_TOKEN_KEY,
+		},
+	}))
+	version.BindHandler(NewVersionDeps(config), binder)
+
+	// the OpenAPI document describes the handlers bound so far, so
+	// it needs to be bound after all the other handlers
+	openapi.BindHandler(&openapi.Deps{
+		Document: openapi.NewDocument(openapi.DocumentProps{
+			Title:    "Oasis Gateway Public API",
+			Version:  "v0",
+			Bindings: binder.Bindings(),
+			Variants: publicVariants,
+		}),
+	}, binder)
+
+	return binder.Build()
+}
+
+// NewVersionDeps creates the dependencies of the version handler
+// from the providers the gateway is configured with
+func NewVersionDeps(config *Config) *version.Deps {
+	var authProviders []string
+	for _, provider := range config.AuthConfig.Providers {
+		authProviders = append(authProviders, provider.Name())
+	}
+
+	return &version.Deps{
+		BackendProvider: config.BackendConfig.Provider.String(),
+		MailboxProvider: config.MailboxConfig.Provider.String(),
+		AuthProviders:   authProviders,
+	}
+}
+
+// publicVariants are the events that the public API returns as
+// implementations of the event interfaces of its entities
+var publicVariants = openapi.Variants{
+	reflect.TypeOf((*service.Event)(nil)).Elem(): {
+		reflect.TypeOf(service.ExecuteServiceEvent{}),
+		reflect.TypeOf(service.DeployServiceEvent{}),
+		reflect.TypeOf(service.ErrorEvent{}),
+		reflect.TypeOf(service.LateResultEvent{}),
+	},
+	reflect.TypeOf((*event.Event)(nil)).Elem(): {
+		reflect.TypeOf(event.DataEvent{}),
+		reflect.TypeOf(event.RetractedEvent{}),
+		reflect.TypeOf(event.ErrorEvent{}),
+	},
+}
+
+// NewPublicGrpcServer creates the gRPC server that serves the service
+// and event APIs with the same handlers as the public router
+func NewPublicGrpcServer(config *Config, group *ServiceGroup) *grpc.Server {
+	binder := rpc.NewGrpcBinder(rpc.GrpcBinderProperties{Logger: RootLogger})
 	service.BindHandler(service.Services{
 		Logger:   RootLogger,
 		Client:   group.Request,
@@ -218,6 +327,20 @@ func NewPublicRouter(config *Config, group *ServiceGroup) *rpc.HttpRouter {
 		Logger: RootLogger,
 		Client: group.Request,
 	}, binder)
+	handler := binder.Build()
 
-	return binder.Build()
+	grpcAuth := authcore.NewGrpcAuth(group.Authenticator, RootLogger)
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(grpcAuth.UnaryServerInterceptor),
+		grpc.StreamInterceptor(grpcAuth.StreamServerInterceptor),
+	}
+	if config.BindPublicConfig.MaxBodyBytes > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(int(config.BindPublicConfig.MaxBodyBytes)))
+	}
+
+	server := grpc.NewServer(opts...)
+	servicegrpc.RegisterServiceServer(server, servicegrpc.NewServer(handler))
+	eventgrpc.RegisterEventServer(server, eventgrpc.NewServer(handler))
+
+	return server
 }
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"github.com/oasislabs/oasis-gateway/stats"
 )
@@ -40,6 +41,12 @@ type InsertRequest struct {
 
 	// Element to be inserted to the queue
 	Element Element
+
+	// Retention is the minimum time the queue is kept after the
+	// element is inserted, even if the queue is not used in the
+	// meantime. If zero, the queue is kept for as long as the
+	// provider keeps idle queues
+	Retention time.Duration
 }
 
 // RetrieveRequest to request the queue to all the
@@ -81,6 +88,17 @@ type DiscardRequest struct {
 	Key string
 }
 
+// WaitRequest to block until the queue has elements
+// available starting at Offset
+type WaitRequest struct {
+	// Key unique identifier of the queue
+	Key string
+
+	// Offset from which elements need to be available for
+	// the wait to complete
+	Offset uint64
+}
+
 // NextRequest to request the next offset available
 // in the queue that can be inserted
 type NextRequest struct {
@@ -95,6 +113,13 @@ type RemoveRequest struct {
 	Key string
 }
 
+// CountRequest to ask for the number of elements available
+// in the queue identified by the provided key
+type CountRequest struct {
+	// Key unique identifier of the queue
+	Key string
+}
+
 // ExistsRequest to ask to destroy the queue identified
 // by the provided key
 type ExistsRequest struct {
@@ -124,12 +149,22 @@ type MQueue interface {
 	// offset to the provided offset
 	Discard(context.Context, DiscardRequest) error
 
+	// Wait blocks until an element with an offset greater or equal
+	// to the provided offset is set in the queue, or until the
+	// context is done
+	Wait(context.Context, WaitRequest) error
+
 	// Next element offset that can be used for the queue.
 	Next(context.Context, NextRequest) (uint64, error)
 
 	// Remove the queue and associated resources with the key
 	Remove(context.Context, RemoveRequest) error
 
+	// Count returns the number of elements in the queue that have
+	// been set and have not been discarded, which are the elements
+	// that a Retrieve request for the whole queue would return
+	Count(context.Context, CountRequest) (uint64, error)
+
 	// Exists returns true if the key exists
 	Exists(context.Context, ExistsRequest) (bool, error)
 }
@@ -40,6 +40,11 @@ func (m *Mailbox) Discard(ctx context.Context, req core.DiscardRequest) error {
 	return args.Error(0)
 }
 
+func (m *Mailbox) Wait(ctx context.Context, req core.WaitRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
 func (m *Mailbox) Next(ctx context.Context, req core.NextRequest) (uint64, error) {
 	args := m.Called(ctx, req)
 	return args.Get(0).(uint64), args.Error(1)
@@ -49,3 +54,8 @@ func (m *Mailbox) Remove(ctx context.Context, req core.RemoveRequest) error {
 	args := m.Called(ctx, req)
 	return args.Error(0)
 }
+
+func (m *Mailbox) Count(ctx context.Context, req core.CountRequest) (uint64, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(uint64), args.Error(1)
+}
@@ -2,6 +2,7 @@ package mem
 
 import (
 	"context"
+	"time"
 
 	"github.com/oasislabs/oasis-gateway/concurrent"
 	"github.com/oasislabs/oasis-gateway/mqueue/core"
@@ -12,7 +13,8 @@ const (
 )
 
 type insertRequest struct {
-	Element core.Element
+	Element   core.Element
+	Retention time.Duration
 }
 
 type retrieveRequest struct {
@@ -28,18 +30,37 @@ type discardRequest struct {
 
 type nextRequest struct{}
 
+type countRequest struct{}
+
+type waitRequest struct {
+	Offset uint64
+}
+
+type cancelWaitRequest struct {
+	C <-chan struct{}
+}
+
 // MessageHandler implements a very simple messaging queue-like
 // functionality serving requests for a single queue.
 type MessageHandler struct {
 	key    string
 	window SlidingWindow
+
+	// waiters keeps the channels of the pending wait requests
+	// with the offset from which they expect elements to be set
+	waiters map[chan struct{}]uint64
+
+	// retainUntil is the time until which the queue needs to be
+	// kept because of the retention requested on insert
+	retainUntil time.Time
 }
 
 // NewMessageHandler creates a new instance of a worker
 func NewMessageHandler(key string) *MessageHandler {
 	w := &MessageHandler{
-		key:    key,
-		window: NewSlidingWindow(SlidingWindowProps{MaxSize: maxElementsPerQueue}),
+		key:     key,
+		window:  NewSlidingWindow(SlidingWindowProps{MaxSize: maxElementsPerQueue}),
+		waiters: make(map[chan struct{}]uint64),
 	}
 
 	return w
@@ -68,6 +89,13 @@ func (w *MessageHandler) handleRequestEvent(ctx context.Context, ev concurrent.R
 		return nil, err
 	case nextRequest:
 		return w.next(req)
+	case countRequest:
+		return w.count(req)
+	case waitRequest:
+		return w.wait(req)
+	case cancelWaitRequest:
+		w.cancelWait(req)
+		return nil, nil
 	default:
 		panic("invalid request received for worker")
 	}
@@ -81,7 +109,23 @@ func (w *MessageHandler) handleErrorEvent(ctx context.Context, ev concurrent.Err
 }
 
 func (w *MessageHandler) insert(req insertRequest) error {
-	return w.window.Set(req.Element.Offset, req.Element.Type, req.Element.Value)
+	if err := w.window.Set(req.Element.Offset, req.Element.Type, req.Element.Value); err != nil {
+		return err
+	}
+
+	// the retention is never shortened by a later insert
+	if until := time.Now().Add(req.Retention); until.After(w.retainUntil) {
+		w.retainUntil = until
+	}
+
+	for c, offset := range w.waiters {
+		if offset <= req.Element.Offset {
+			close(c)
+			delete(w.waiters, c)
+		}
+	}
+
+	return nil
 }
 
 func (w *MessageHandler) retrieve(req retrieveRequest) (core.Elements, error) {
@@ -102,3 +146,43 @@ func (w *MessageHandler) discard(req discardRequest) error {
 func (w *MessageHandler) next(req nextRequest) (uint64, error) {
 	return w.window.ReserveNext()
 }
+
+func (w *MessageHandler) count(req countRequest) (uint64, error) {
+	return uint64(w.window.Count()), nil
+}
+
+// keepAlive returns true if the queue needs to be kept even if it
+// is inactive, because it is within the retention requested on insert
+func (w *MessageHandler) keepAlive() bool {
+	return time.Now().Before(w.retainUntil)
+}
+
+// wait returns a channel that is closed once an element with an
+// offset greater or equal to the requested offset is set
+func (w *MessageHandler) wait(req waitRequest) (<-chan struct{}, error) {
+	c := make(chan struct{})
+
+	els, err := w.window.Get(req.Offset, maxElementsPerQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(els.Elements) > 0 {
+		close(c)
+		return c, nil
+	}
+
+	w.waiters[c] = req.Offset
+	return c, nil
+}
+
+// cancelWait removes a pending wait request so that its resources
+// can be released
+func (w *MessageHandler) cancelWait(req cancelWaitRequest) {
+	for c := range w.waiters {
+		if c == req.C {
+			delete(w.waiters, c)
+			return
+		}
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/oasislabs/oasis-gateway/concurrent"
+	"github.com/oasislabs/oasis-gateway/mqueue/core"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,3 +47,44 @@ func TestMessageHandlerHandleWorkerRequestUnknown(t *testing.T) {
 		})
 	})
 }
+
+func TestMessageHandlerCancelWait(t *testing.T) {
+	handler := NewMessageHandler("key")
+
+	c, err := handler.wait(waitRequest{Offset: 0})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(handler.waiters))
+
+	handler.cancelWait(cancelWaitRequest{C: c})
+	assert.Equal(t, 0, len(handler.waiters))
+}
+
+func TestMessageHandlerKeepAliveNoRetention(t *testing.T) {
+	handler := NewMessageHandler("key")
+	_, err := handler.next(nextRequest{})
+	assert.Nil(t, err)
+
+	err = handler.insert(insertRequest{Element: core.Element{Offset: 0, Value: "value"}})
+	assert.Nil(t, err)
+
+	assert.False(t, handler.keepAlive())
+}
+
+func TestMessageHandlerKeepAliveRetention(t *testing.T) {
+	handler := NewMessageHandler("key")
+	for i := 0; i < 2; i++ {
+		_, err := handler.next(nextRequest{})
+		assert.Nil(t, err)
+	}
+
+	err := handler.insert(insertRequest{
+		Element:   core.Element{Offset: 0, Value: "value"},
+		Retention: time.Hour,
+	})
+	assert.Nil(t, err)
+
+	err = handler.insert(insertRequest{Element: core.Element{Offset: 1, Value: "value"}})
+	assert.Nil(t, err)
+
+	assert.True(t, handler.keepAlive())
+}
@@ -56,6 +56,7 @@ func (s *Server) create(ctx context.Context, ev concurrent.CreateWorkerEvent) er
 	ev.Props.WorkerHandler = concurrent.WorkerHandlerFunc(worker.handle)
 	ev.Props.UserData = worker
 	ev.Props.MaxInactivity = maxInactivityTimeout
+	ev.Props.KeepAlive = worker.keepAlive
 
 	return nil
 }
@@ -65,9 +66,14 @@ func (s *Server) destroy(ctx context.Context, ev concurrent.DestroyWorkerEvent)
 	return nil
 }
 
-// Insert inserts the element to the provided offset.
+// Insert inserts the element to the provided offset. The worker that
+// keeps the queue is not destroyed for inactivity until the retention
+// of the element expires
 func (s *Server) Insert(ctx context.Context, req core.InsertRequest) error {
-	_, err := s.master.Request(ctx, req.Key, insertRequest{Element: req.Element})
+	_, err := s.master.Request(ctx, req.Key, insertRequest{
+		Element:   req.Element,
+		Retention: req.Retention,
+	})
 	return err
 }
 
@@ -93,6 +99,27 @@ func (s *Server) Discard(ctx context.Context, req core.DiscardRequest) error {
 	return err
 }
 
+// Wait blocks until an element with an offset greater or equal
+// to the provided offset is set in the queue, or until the
+// context is done
+func (s *Server) Wait(ctx context.Context, req core.WaitRequest) error {
+	v, err := s.master.Request(ctx, req.Key, waitRequest{Offset: req.Offset})
+	if err != nil {
+		return err
+	}
+
+	c := v.(<-chan struct{})
+	select {
+	case <-c:
+		return nil
+	case <-ctx.Done():
+		// the request context is already done, so the wait is
+		// cancelled on a context that is still valid
+		_, err := s.master.Request(context.Background(), req.Key, cancelWaitRequest{C: c})
+		return err
+	}
+}
+
 // Next element offset that can be used for the queue.
 func (s *Server) Next(ctx context.Context, req core.NextRequest) (uint64, error) {
 	v, err := s.master.Request(ctx, req.Key, nextRequest{})
@@ -103,6 +130,17 @@ func (s *Server) Next(ctx context.Context, req core.NextRequest) (uint64, error)
 	return v.(uint64), nil
 }
 
+// Count returns the number of elements in the queue that have
+// been set and have not been discarded
+func (s *Server) Count(ctx context.Context, req core.CountRequest) (uint64, error) {
+	v, err := s.master.Request(ctx, req.Key, countRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return v.(uint64), nil
+}
+
 // Remove the key's queue and it's associated resources
 func (s *Server) Remove(ctx context.Context, req core.RemoveRequest) error {
 	return s.master.Destroy(ctx, req.Key)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/oasislabs/oasis-gateway/log"
 	"github.com/oasislabs/oasis-gateway/mqueue/core"
@@ -144,6 +145,87 @@ func TestServerDiscardKeepPreviousTrue(t *testing.T) {
 	}, els)
 }
 
+func TestServerCount(t *testing.T) {
+	s := NewServer(context.TODO(), Services{Logger: logger})
+
+	for i := 0; i < 4; i++ {
+		offset, err := s.Next(ctx, core.NextRequest{Key: "key"})
+		assert.Nil(t, err)
+
+		// the last offset is reserved but not set
+		if i == 3 {
+			break
+		}
+
+		err = s.Insert(ctx, core.InsertRequest{Key: "key", Element: core.Element{
+			Offset: offset,
+			Value:  "value",
+		}})
+		assert.Nil(t, err)
+	}
+
+	err := s.Discard(ctx, core.DiscardRequest{
+		Key:          "key",
+		Offset:       uint64(1),
+		Count:        1,
+		KeepPrevious: true,
+	})
+	assert.Nil(t, err)
+
+	count, err := s.Count(ctx, core.CountRequest{Key: "key"})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), count)
+}
+
+func TestServerWaitAvailable(t *testing.T) {
+	s := NewServer(context.TODO(), Services{Logger: logger})
+
+	offset, err := s.Next(ctx, core.NextRequest{Key: "key"})
+	assert.Nil(t, err)
+
+	err = s.Insert(ctx, core.InsertRequest{Key: "key", Element: core.Element{
+		Offset: offset,
+		Value:  "value",
+	}})
+	assert.Nil(t, err)
+
+	err = s.Wait(ctx, core.WaitRequest{Key: "key", Offset: offset})
+	assert.Nil(t, err)
+}
+
+func TestServerWaitInsert(t *testing.T) {
+	s := NewServer(context.TODO(), Services{Logger: logger})
+
+	offset, err := s.Next(ctx, core.NextRequest{Key: "key"})
+	assert.Nil(t, err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = s.Insert(ctx, core.InsertRequest{Key: "key", Element: core.Element{
+			Offset: offset,
+			Value:  "value",
+		}})
+	}()
+
+	waitCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = s.Wait(waitCtx, core.WaitRequest{Key: "key", Offset: offset})
+	assert.Nil(t, err)
+	assert.Nil(t, waitCtx.Err())
+}
+
+func TestServerWaitTimeout(t *testing.T) {
+	s := NewServer(context.TODO(), Services{Logger: logger})
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	err := s.Wait(waitCtx, core.WaitRequest{Key: "key", Offset: 0})
+	assert.Nil(t, err)
+	assert.Error(t, waitCtx.Err())
+}
+
 func TestServerNext(t *testing.T) {
 	s := NewServer(context.TODO(), Services{Logger: logger})
 
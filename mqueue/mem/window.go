@@ -108,6 +108,19 @@ func (w *SlidingWindow) Get(offset uint64, count uint) (core.Elements, errors.Er
 	return res, nil
 }
 
+// Count returns the number of elements in the window
+// that have been set and have not been discarded
+func (w *SlidingWindow) Count() uint {
+	var count uint
+	for i := uint(0); i < w.nextUnreservedIndex; i++ {
+		if w.elements[i].Set && !w.elements[i].Discarded {
+			count++
+		}
+	}
+
+	return count
+}
+
 // ReserveNext reserves the next offset available in the
 // window, or an error if it is not possible to provide
 // a next offset because either the window cannot grow more
@@ -10,10 +10,11 @@ type command interface {
 
 const (
 	mqnext     op = "return mqnext(KEYS[1])"
-	mqinsert   op = "return mqinsert(KEYS[1], ARGV[1], ARGV[2], ARGV[3])"
+	mqinsert   op = "return mqinsert(KEYS[1], ARGV[1], ARGV[2], ARGV[3], ARGV[4])"
 	mqretrieve op = "return mqretrieve(KEYS[1], ARGV[1], ARGV[2])"
 	mqdiscard  op = "return mqdiscard(KEYS[1], ARGV[1], ARGV[2], ARGV[3])"
 	mqremove   op = "return mqremove(KEYS[1])"
+	mqcount    op = "return mqcount(KEYS[1])"
 )
 
 type nextRequest struct {
@@ -37,6 +38,10 @@ type insertRequest struct {
 	Key     string
 	Content string
 	Type    string
+
+	// Retention is the minimum time in seconds the key
+	// is kept after the element is inserted
+	Retention uint64
 }
 
 func (r insertRequest) Op() op {
@@ -48,7 +53,7 @@ func (r insertRequest) Keys() []string {
 }
 
 func (r insertRequest) Args() []interface{} {
-	return []interface{}{r.Offset, r.Type, r.Content}
+	return []interface{}{r.Offset, r.Type, r.Content, r.Retention}
 }
 
 type retrieveRequest struct {
@@ -103,3 +108,19 @@ func (r removeRequest) Keys() []string {
 func (r removeRequest) Args() []interface{} {
 	return nil
 }
+
+type countRequest struct {
+	Key string
+}
+
+func (r countRequest) Op() op {
+	return mqcount
+}
+
+func (r countRequest) Keys() []string {
+	return []string{r.Key}
+}
+
+func (r countRequest) Args() []interface{} {
+	return nil
+}
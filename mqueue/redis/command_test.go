@@ -15,10 +15,11 @@ func TestNextRequest(t *testing.T) {
 
 func TestInsertRequest(t *testing.T) {
 	req := insertRequest{
-		Offset:  1,
-		Key:     "key",
-		Content: "content",
-		Type:    "type",
+		Offset:    1,
+		Key:       "key",
+		Content:   "content",
+		Type:      "type",
+		Retention: 60,
 	}
 
 	assert.Equal(t, []string{"key"}, req.Keys())
@@ -26,6 +27,7 @@ func TestInsertRequest(t *testing.T) {
 		uint64(1),
 		"type",
 		"content",
+		uint64(60),
 	}, req.Args())
 }
 
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/oasislabs/oasis-gateway/log"
@@ -17,13 +18,20 @@ const (
 	next     string = "next"
 	remove   string = "remove"
 	exists   string = "exists"
+	wait     string = "wait"
+	count    string = "count"
 )
 
+// waitCount is the number of elements retrieved from the queue
+// when checking whether elements are available for a wait request
+const waitCount = 1024
+
 // Client is the interface to the redis client used implementing
 // the methods used by the MQueue implementation
 type Client interface {
 	Eval(script string, keys []string, args ...interface{}) *redis.Cmd
 	Exists(key ...string) *redis.IntCmd
+	Subscribe(channels ...string) *redis.PubSub
 }
 
 type Props struct {
@@ -49,9 +57,10 @@ type SingleInstanceProps struct {
 // MQueue implements the messaging queue functionality required
 // from the mqueue package using Redis as a backend
 type MQueue struct {
-	client  Client
-	logger  log.Logger
-	tracker *stats.MethodTracker
+	client     Client
+	logger     log.Logger
+	tracker    *stats.MethodTracker
+	subscriber *subscriber
 }
 
 // NewClusterMQueue creates a new instance of a redis client
@@ -63,9 +72,10 @@ func NewClusterMQueue(props ClusterProps) (*MQueue, error) {
 	})
 
 	return &MQueue{
-		client:  c,
-		logger:  logger,
-		tracker: stats.NewMethodTracker(insert, retrieve, discard, next, remove, exists),
+		client:     c,
+		logger:     logger,
+		tracker:    stats.NewMethodTracker(insert, retrieve, discard, next, remove, exists, wait, count),
+		subscriber: newSubscriber(props.Context, c, logger),
 	}, nil
 }
 
@@ -78,9 +88,10 @@ func NewSingleMQueue(props SingleInstanceProps) (*MQueue, error) {
 	})
 
 	return &MQueue{
-		client:  c,
-		logger:  logger,
-		tracker: stats.NewMethodTracker(insert, retrieve, discard, next, remove),
+		client:     c,
+		logger:     logger,
+		tracker:    stats.NewMethodTracker(insert, retrieve, discard, next, remove, wait, count),
+		subscriber: newSubscriber(props.Context, c, logger),
 	}, nil
 }
 
@@ -111,10 +122,11 @@ func (m *MQueue) insert(ctx context.Context, req core.InsertRequest) error {
 	}
 
 	v, err := m.exec(ctx, insertRequest{
-		Key:     req.Key,
-		Offset:  req.Element.Offset,
-		Type:    req.Element.Type,
-		Content: string(serialized),
+		Key:       req.Key,
+		Offset:    req.Element.Offset,
+		Type:      req.Element.Type,
+		Content:   string(serialized),
+		Retention: uint64(req.Retention / time.Second),
 	})
 
 	if err != nil {
@@ -167,8 +179,9 @@ func (m *MQueue) retrieve(ctx context.Context, req core.RetrieveRequest) (core.E
 			offsetSet = true
 		}
 
-		// just ignore all elements that have not been set yet
-		if !decoded.Set {
+		// just ignore all elements that have not been set yet or that
+		// have been discarded individually
+		if !decoded.Set || decoded.Discarded {
 			continue
 		}
 
@@ -218,6 +231,63 @@ func (m *MQueue) discard(ctx context.Context, req core.DiscardRequest) error {
 	return nil
 }
 
+func (m *MQueue) Wait(ctx context.Context, req core.WaitRequest) error {
+	_, err := m.tracker.Instrument(wait, func() (interface{}, error) {
+		return nil, m.wait(ctx, req)
+	})
+
+	return err
+}
+
+// wait waits for the notification published when an element is
+// inserted in the queue. The notifications are received through the
+// subscriber shared by the process, and the subscription is confirmed
+// before checking whether there are elements available, so that an
+// insert that happens between the check and the wait is not missed
+func (m *MQueue) wait(ctx context.Context, req core.WaitRequest) error {
+	w, err := m.subscriber.add(insertChannel(req.Key), req.Offset)
+	if err != nil {
+		return ErrRedisExec{Cause: err}
+	}
+	defer m.subscriber.remove(w)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-w.ready:
+	}
+
+	els, err := m.retrieve(ctx, core.RetrieveRequest{
+		Key:    req.Key,
+		Offset: req.Offset,
+		Count:  waitCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the retrieve script clamps the range to the window, so
+	// elements before the offset may be returned
+	for _, el := range els.Elements {
+		if el.Offset >= req.Offset {
+			return nil
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-w.c:
+	}
+
+	return nil
+}
+
+// insertChannel returns the channel to which the offset of an
+// element inserted in the queue is published
+func insertChannel(key string) string {
+	return key + insertSuffix
+}
+
 func (m *MQueue) Next(ctx context.Context, req core.NextRequest) (uint64, error) {
 	offset, err := m.tracker.Instrument(next, func() (interface{}, error) {
 		return m.next(ctx, req)
@@ -240,6 +310,28 @@ func (m *MQueue) next(ctx context.Context, req core.NextRequest) (uint64, error)
 	return uint64(v.(int64)), nil
 }
 
+func (m *MQueue) Count(ctx context.Context, req core.CountRequest) (uint64, error) {
+	v, err := m.tracker.Instrument(count, func() (interface{}, error) {
+		return m.count(ctx, req)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return v.(uint64), nil
+}
+
+func (m *MQueue) count(ctx context.Context, req core.CountRequest) (uint64, error) {
+	v, err := m.exec(ctx, countRequest{
+		Key: req.Key,
+	})
+	if err != nil {
+		return 0, ErrRedisExec{Cause: err}
+	}
+
+	return uint64(v.(int64)), nil
+}
+
 func (m *MQueue) Remove(ctx context.Context, req core.RemoveRequest) error {
 	_, err := m.tracker.Instrument(remove, func() (interface{}, error) {
 		return nil, m.remove(ctx, req)
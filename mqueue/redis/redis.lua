@@ -1,5 +1,14 @@
 local expire_time = 600 -- in seconds
 
+-- touch extends the time the key is kept to at least ttl seconds.
+-- The time is never shortened, so that the retention requested
+-- when an element is inserted is kept by the operations that follow
+local touch = function(key, ttl)
+  if redis.call('ttl', key) < ttl then
+    redis.call('expire', key, ttl)
+  end
+end
+
 local mqbasenlen = function(key)
   local len = redis.call('llen', key)
   if len > 0 then
@@ -23,15 +32,17 @@ local mqnext = function(key)
 
   local payload = cjson.encode({offset = offset, set = false, discarded = false})
   assert(redis.call('rpush', key, payload) == len + 1)
-  redis.call('expire', key, expire_time)
+  touch(key, expire_time)
   return offset
 end
 
 -- mqinsert inserts the value for the provided offset over
 -- the window to an already existing element. If the element does
 -- not exist, the operation fails. get_next_offset must be called
--- so that a specific offset is provided before it can be used
-local mqinsert = function(key, offset, value_type, value)
+-- so that a specific offset is provided before it can be used.
+-- The key is kept for at least retention seconds, if provided.
+-- The offset is published to the key's insert channel
+local mqinsert = function(key, offset, value_type, value, retention)
   local base_n_len = mqbasenlen(key)
   local base = base_n_len[1]
   local len = base_n_len[2]
@@ -40,8 +51,12 @@ local mqinsert = function(key, offset, value_type, value)
   assert(index >= 0 and index < len)
 
   local payload = cjson.encode({offset = tonumber(offset), value = value, value_type = value_type, set = true, discarded = false})
-  redis.call('expire', key, expire_time)
-  return redis.call('lset', key, index, payload)
+  touch(key, math.max(expire_time, tonumber(retention) or 0))
+  local res = redis.call('lset', key, index, payload)
+
+  -- notify the clients waiting for elements to be inserted
+  redis.call('publish', key .. ':insert', offset)
+  return res
 end
 
 -- mqretrieve returns a window of elements within the list
@@ -79,7 +94,7 @@ local mqretrieve = function(key, offset, count)
     stop = start
   end
 
-  redis.call('expire', key, expire_time)
+  touch(key, expire_time)
   return redis.call('lrange', key, start, stop)
 end
 
@@ -155,10 +170,24 @@ local mqdiscard = function(key, offset, count, keep_previous)
     end
   end
 
-  redis.call('expire', key, expire_time)
+  touch(key, expire_time)
   return "OK"
 end
 
+-- mqcount returns the number of elements within the list
+-- that have been set and have not been discarded
+local mqcount = function(key)
+  local count = 0
+  for _, el in ipairs(redis.call('lrange', key, 0, -1)) do
+    local decoded = cjson.decode(el)
+    if decoded['set'] and not decoded['discarded'] then
+      count = count + 1
+    end
+  end
+
+  return count
+end
+
 -- remove the key and all associated resources
 local mqremove = function(key)
   return redis.call('del', key)
@@ -171,6 +200,7 @@ rawset(_G, "mqdiscard", mqdiscard)
 rawset(_G, "mqretrieve", mqretrieve)
 rawset(_G, "mqinsert", mqinsert)
 rawset(_G, "mqnext", mqnext)
+rawset(_G, "mqcount", mqcount)
 
 -- test the basic functionality of the script
 local test = function()
@@ -187,6 +217,8 @@ local test = function()
     assert(cjson.decode(t[i+1])['offset'] == i)
   end
 
+  assert(mqcount('example') == 11)
+
   mqdiscard('example', 2, 0, false)
   local t = mqretrieve('example', 0, 10)
   for i = 0, 8  do
@@ -196,6 +228,7 @@ local test = function()
   mqdiscard('example', 3, 1, true)
   local t = mqretrieve('example', 0, 10)
   assert(table.getn(t) == 9)
+  assert(mqcount('example') == 8)
   assert(cjson.decode(t[1])['offset'] == 2)
   assert(cjson.decode(t[1])['discarded'] == false)
   assert(cjson.decode(t[2])['offset'] == 3)
@@ -220,6 +253,15 @@ local test = function()
 
   mqremove('example')
   assert(redis.call('exists', 'example') == 0)
+
+  -- the retention of an element is not shortened by other operations
+  mqnext('retained')
+  mqinsert('retained', 0, 'test', cjson.encode({data = 0}), 3600)
+  mqretrieve('retained', 0, 1)
+  local ttl = redis.call('ttl', 'retained')
+  assert(ttl <= 3600 and ttl > 600)
+
+  mqremove('retained')
 end
 
 if ARGV[1] == "test" then
@@ -0,0 +1,201 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/oasislabs/oasis-gateway/log"
+)
+
+const (
+	// insertPattern matches the channels to which the offsets of the
+	// elements inserted in the queues are published
+	insertPattern = "*" + insertSuffix
+
+	// insertSuffix is the suffix of the insert channel of a queue
+	insertSuffix = ":insert"
+
+	// anchorChannel is a channel to which nothing is published. The
+	// cluster client picks the node of a subscription from its channels,
+	// so the subscriber keeps one to be able to reconnect
+	anchorChannel = "mqueue:subscriber"
+
+	// receiveBackoff is the time the subscriber waits before receiving
+	// again after it fails to receive from the server
+	receiveBackoff = 100 * time.Millisecond
+)
+
+// waiter waits for an element to be inserted in a queue
+// at an offset equal or greater than its offset
+type waiter struct {
+	channel string
+	offset  uint64
+
+	// ready is closed once the subscriber is subscribed to the
+	// inserts, so that an insert that happens afterwards is not missed
+	ready <-chan struct{}
+
+	// c is closed once an element is inserted at or after the offset
+	c    chan struct{}
+	once sync.Once
+}
+
+func (w *waiter) wake() {
+	w.once.Do(func() { close(w.c) })
+}
+
+// subscriber keeps a single subscription per process to the
+// notifications published when elements are inserted, and fans them
+// out to the waiters of each queue. The subscription is created with
+// the first waiter and kept until the context is done
+type subscriber struct {
+	ctx     context.Context
+	client  Client
+	logger  log.Logger
+	lock    sync.Mutex
+	pubsub  *redis.PubSub
+	ready   chan struct{}
+	waiters map[string]map[*waiter]struct{}
+}
+
+func newSubscriber(ctx context.Context, client Client, logger log.Logger) *subscriber {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return &subscriber{
+		ctx:     ctx,
+		client:  client,
+		logger:  logger,
+		waiters: make(map[string]map[*waiter]struct{}),
+	}
+}
+
+// add adds a waiter for the insert channel of a queue. The waiter
+// needs to be removed once it is done waiting
+func (s *subscriber) add(channel string, offset uint64) (*waiter, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.pubsub == nil {
+		if err := s.subscribe(); err != nil {
+			return nil, err
+		}
+	}
+
+	w := &waiter{channel: channel, offset: offset, ready: s.ready, c: make(chan struct{})}
+	waiters, ok := s.waiters[channel]
+	if !ok {
+		waiters = make(map[*waiter]struct{})
+		s.waiters[channel] = waiters
+	}
+	waiters[w] = struct{}{}
+
+	return w, nil
+}
+
+// remove removes a waiter added to the subscriber
+func (s *subscriber) remove(w *waiter) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	waiters := s.waiters[w.channel]
+	delete(waiters, w)
+	if len(waiters) == 0 {
+		delete(s.waiters, w.channel)
+	}
+}
+
+// subscribe creates the subscription shared by the waiters
+func (s *subscriber) subscribe() error {
+	pubsub := s.client.Subscribe(anchorChannel)
+	if err := pubsub.PSubscribe(insertPattern); err != nil {
+		_ = pubsub.Close()
+		return err
+	}
+
+	s.pubsub = pubsub
+	s.ready = make(chan struct{})
+	go s.receive(pubsub, s.ready)
+	go func() {
+		<-s.ctx.Done()
+		_ = pubsub.Close()
+	}()
+
+	return nil
+}
+
+func (s *subscriber) receive(pubsub *redis.PubSub, ready chan struct{}) {
+	subscribed := false
+
+	for {
+		msg, err := pubsub.Receive()
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+
+			s.logger.Warn(s.ctx, "failed to receive insert notification", log.MapFields{
+				"call_type": "ReceiveNotificationFailure",
+				"err":       err.Error(),
+			})
+			time.Sleep(receiveBackoff)
+			continue
+		}
+
+		switch msg := msg.(type) {
+		case *redis.Subscription:
+			if msg.Kind != "psubscribe" {
+				continue
+			}
+
+			if !subscribed {
+				subscribed = true
+				close(ready)
+				continue
+			}
+
+			// the subscription is created again after reconnecting,
+			// so the inserts that happened in between are missed
+			s.wakeAll()
+		case *redis.Message:
+			s.notify(msg.Channel, msg.Payload)
+		}
+	}
+}
+
+// notify wakes up the waiters of the channel for which
+// an element has been inserted at the published offset
+func (s *subscriber) notify(channel string, payload string) {
+	if !strings.HasSuffix(channel, insertSuffix) {
+		return
+	}
+
+	offset, err := strconv.ParseUint(payload, 10, 64)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for w := range s.waiters[channel] {
+		if err != nil || offset >= w.offset {
+			w.wake()
+		}
+	}
+}
+
+// wakeAll wakes up all the waiters so that they check
+// whether elements have been inserted
+func (s *subscriber) wakeAll() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, waiters := range s.waiters {
+		for w := range waiters {
+			w.wake()
+		}
+	}
+}
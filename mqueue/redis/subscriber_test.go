@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/oasislabs/oasis-gateway/log"
+	"github.com/stretchr/testify/assert"
+)
+
+var Logger = log.NewLogrus(log.LogrusLoggerProperties{
+	Output: ioutil.Discard,
+})
+
+// addWaiter adds a waiter without subscribing to the server
+func addWaiter(s *subscriber, channel string, offset uint64) *waiter {
+	w := &waiter{channel: channel, offset: offset, c: make(chan struct{})}
+	if _, ok := s.waiters[channel]; !ok {
+		s.waiters[channel] = make(map[*waiter]struct{})
+	}
+	s.waiters[channel][w] = struct{}{}
+	return w
+}
+
+func isAwake(w *waiter) bool {
+	select {
+	case <-w.c:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestSubscriberNotify(t *testing.T) {
+	s := newSubscriber(context.Background(), nil, Logger)
+	behind := addWaiter(s, "key:insert", 1)
+	ahead := addWaiter(s, "key:insert", 3)
+	other := addWaiter(s, "other:insert", 0)
+
+	s.notify("key:insert", "2")
+	s.notify("key:insert", "2")
+
+	assert.True(t, isAwake(behind))
+	assert.False(t, isAwake(ahead))
+	assert.False(t, isAwake(other))
+}
+
+func TestSubscriberNotifyInvalidOffset(t *testing.T) {
+	s := newSubscriber(context.Background(), nil, Logger)
+	w := addWaiter(s, "key:insert", 3)
+
+	s.notify("key:insert", "offset")
+
+	assert.True(t, isAwake(w))
+}
+
+func TestSubscriberWakeAll(t *testing.T) {
+	s := newSubscriber(context.Background(), nil, Logger)
+	first := addWaiter(s, "key:insert", 3)
+	second := addWaiter(s, "other:insert", 3)
+
+	s.wakeAll()
+
+	assert.True(t, isAwake(first))
+	assert.True(t, isAwake(second))
+}
+
+func TestSubscriberRemove(t *testing.T) {
+	s := newSubscriber(context.Background(), nil, Logger)
+	w := addWaiter(s, "key:insert", 0)
+
+	s.remove(w)
+	s.notify("key:insert", "0")
+
+	assert.False(t, isAwake(w))
+	assert.Empty(t, s.waiters)
+}
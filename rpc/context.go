@@ -4,6 +4,14 @@ import (
 	"strconv"
 )
 
+// HttpHeaderIdempotencyKey is the header a client sets to identify a
+// request, so that retries of the same request are not executed twice
+const HttpHeaderIdempotencyKey = "X-OASIS-IDEMPOTENCY-KEY"
+
+// IdempotencyKey is the key for the context value that holds the
+// idempotency key provided by the client, if any
+type IdempotencyKey struct{}
+
 // ParseTraceID parses a traceID from a string and in case of failure
 // it returns a default -1
 func ParseTraceID(s string) int64 {
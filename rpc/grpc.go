@@ -0,0 +1,243 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcMetadataErrorCode is the trailer metadata key that holds the
+// code of the errors.ErrorCode of a call that failed, so that clients
+// can identify the error as they would from the http API
+const GrpcMetadataErrorCode = "x-oasis-error-code"
+
+// GrpcStream is implemented by the responses of handlers that stream
+// values to the client, so that they can be served over a gRPC
+// server-streaming RPC
+type GrpcStream interface {
+	// ServeGrpcStream sends the values of the stream to the client
+	// until the stream ends or the context is done
+	ServeGrpcStream(ctx context.Context, send func(interface{}) error) error
+}
+
+// GrpcBinder is a binder that keeps the bound handlers so that the
+// implementations of gRPC services can dispatch their calls to them.
+// Handlers bound to the same path for different http methods are
+// considered the same handler
+type GrpcBinder struct {
+	handlers map[string]Handler
+	logger   log.Logger
+}
+
+// GrpcBinderProperties are the properties used to create
+// a new instance of a GrpcBinder
+type GrpcBinderProperties struct {
+	Logger log.Logger
+}
+
+// NewGrpcBinder creates a new instance of the GrpcBinder. It will
+// panic in case there are errors in the construction of the binder
+func NewGrpcBinder(properties GrpcBinderProperties) *GrpcBinder {
+	if properties.Logger == nil {
+		panic("Logger must be set")
+	}
+
+	return &GrpcBinder{
+		handlers: make(map[string]Handler),
+		logger:   properties.Logger,
+	}
+}
+
+// Bind is the implementation of HandlerBinder for GrpcBinder. The
+// entity factory is not needed because the gRPC services create
+// the entities from the protobuf messages they receive
+func (b *GrpcBinder) Bind(method string, path string, handler Handler, factory EntityFactory) {
+	if _, ok := b.handlers[path]; ok {
+		return
+	}
+
+	b.handlers[path] = handler
+}
+
+// Build creates a new GrpcHandler and clears the handlers of the
+// GrpcBinder, so if new instances of GrpcHandlers need to be
+// built Bind needs to be used again
+func (b *GrpcBinder) Build() *GrpcHandler {
+	handlers := b.handlers
+
+	// avoid modification of the handlers after the
+	// handler has been created
+	b.handlers = make(map[string]Handler)
+
+	return &GrpcHandler{
+		handlers: handlers,
+		logger:   b.logger.ForClass("rpc", "GrpcHandler"),
+	}
+}
+
+// GrpcHandler dispatches the calls of a gRPC service to the
+// handler bound to the path that serves the same request
+// over http
+type GrpcHandler struct {
+	handlers map[string]Handler
+	logger   log.Logger
+}
+
+// Handle runs the handler bound to the provided path. Errors
+// returned by the handler are mapped to gRPC status errors
+func (h *GrpcHandler) Handle(ctx context.Context, path string, v interface{}) (interface{}, error) {
+	handler, ok := h.handlers[path]
+	if !ok {
+		h.logger.Debug(ctx, "", log.MapFields{
+			"call_type": "GrpcRequestHandleFailure",
+			"path":      path,
+			"err":       "handler not found",
+		})
+		return nil, GrpcError(ctx, errors.New(errors.ErrAPINotImplemented, nil))
+	}
+
+	ctx = context.WithValue(ctx, IdempotencyKey{}, grpcIdempotencyKey(ctx))
+	res, err := h.handle(ctx, path, handler, v)
+	if err != nil {
+		h.logger.Debug(ctx, "", log.MapFields{
+			"call_type": "GrpcRequestHandleFailure",
+			"path":      path,
+			"err":       err.Error(),
+		})
+		return nil, GrpcError(ctx, err)
+	}
+
+	h.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "GrpcRequestHandleSuccess",
+		"path":      path,
+	})
+
+	return res, nil
+}
+
+// Stream runs the handler bound to the provided path, which is
+// expected to return a GrpcStream, and serves the stream
+func (h *GrpcHandler) Stream(ctx context.Context, path string, v interface{}, send func(interface{}) error) error {
+	res, err := h.Handle(ctx, path, v)
+	if err != nil {
+		return err
+	}
+
+	s, ok := res.(GrpcStream)
+	if !ok {
+		return GrpcError(ctx, errors.New(errors.ErrAPINotImplemented, nil))
+	}
+
+	if err := s.ServeGrpcStream(ctx, send); err != nil {
+		h.logger.Debug(ctx, "", log.MapFields{
+			"call_type": "GrpcStreamServeFailure",
+			"path":      path,
+			"err":       err.Error(),
+		})
+		return GrpcError(ctx, err)
+	}
+
+	return nil
+}
+
+// handle runs the handler bound to a path. The gRPC server does not
+// recover from panics, so a panic needs to be recovered here for it
+// not to bring down the server
+func (h *GrpcHandler) handle(
+	ctx context.Context,
+	path string,
+	handler Handler,
+	body interface{},
+) (v interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			h.logger.Warn(ctx, "unexpected panic caught", log.MapFields{
+				"call_type":  "GrpcRequestHandleFailure",
+				"path":       path,
+				"err":        fmt.Sprintf("%+v", r),
+				"stacktrace": string(debug.Stack()),
+			})
+
+			v, err = nil, errors.New(errors.ErrInternalError, nil)
+		}
+	}()
+
+	return handler.Handle(ctx, body)
+}
+
+// grpcIdempotencyKey returns the idempotency key provided by the
+// client in the metadata of the call, if any
+func grpcIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(strings.ToLower(HttpHeaderIdempotencyKey))
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// GrpcError maps an error to a gRPC status error. The status code is
+// derived from the category of the error and the code of the error is
+// set in the trailer metadata of the call
+func GrpcError(ctx context.Context, err error) error {
+	var cause errors.Err
+	switch err := err.(type) {
+	case errors.Err:
+		cause = err
+	case *HttpError:
+		if err.Cause != nil {
+			cause = err.Cause
+		}
+	case HttpError:
+		if err.Cause != nil {
+			cause = err.Cause
+		}
+	}
+
+	if cause == nil {
+		cause = errors.New(errors.ErrInternalError, err)
+	}
+
+	// setting the trailer only fails if the context is not the
+	// context of a gRPC call, in which case there is no client
+	// to receive it
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(GrpcMetadataErrorCode,
+		strconv.Itoa(cause.ErrorCode().Code())))
+
+	return status.Error(mapGrpcCode(cause.ErrorCode().Category()), cause.ErrorCode().Desc())
+}
+
+// mapGrpcCode maps the category of an error to the gRPC status code
+// that is the equivalent of the http status code used for the category
+func mapGrpcCode(category errors.Category) codes.Code {
+	switch category {
+	case errors.InputError:
+		return codes.InvalidArgument
+	case errors.StateConflict:
+		return codes.FailedPrecondition
+	case errors.ResourceLimitReached:
+		return codes.ResourceExhausted
+	case errors.NotImplemented:
+		return codes.Unimplemented
+	case errors.AuthenticationError:
+		return codes.PermissionDenied
+	case errors.NotFound:
+		return codes.NotFound
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"context"
+	stderr "errors"
+	"testing"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type grpcStreamValues []interface{}
+
+func (s grpcStreamValues) ServeGrpcStream(ctx context.Context, send func(interface{}) error) error {
+	for _, v := range s {
+		if err := send(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newGrpcHandler() *GrpcHandler {
+	binder := NewGrpcBinder(GrpcBinderProperties{Logger: logger})
+	binder.Bind("POST", "/v0/api/service/echo", HandlerEcho{}, mapEntityFactory())
+	binder.Bind("POST", "/v0/api/service/fail", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.New(errors.ErrInvalidAddress, nil)
+	}), nil)
+	binder.Bind("POST", "/v0/api/service/panic", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		panic("error")
+	}), nil)
+	binder.Bind("POST", "/v0/api/service/error", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		return nil, stderr.New("error")
+	}), nil)
+	binder.Bind("POST", "/v0/api/service/key", HandlerFunc(func(ctx context.Context, v interface{}) (interface{}, error) {
+		return ctx.Value(IdempotencyKey{}), nil
+	}), nil)
+	binder.Bind("POST", "/v0/api/service/stream", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		return grpcStreamValues{1, 2}, nil
+	}), nil)
+	return binder.Build()
+}
+
+func TestGrpcHandlerOK(t *testing.T) {
+	res, err := newGrpcHandler().Handle(context.Background(), "/v0/api/service/echo", "value")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "value", res)
+}
+
+func TestGrpcHandlerNotFound(t *testing.T) {
+	_, err := newGrpcHandler().Handle(context.Background(), "/v0/api/service/unknown", nil)
+
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.Equal(t, "API not Implemented.", status.Convert(err).Message())
+}
+
+func TestGrpcHandlerErrorCode(t *testing.T) {
+	_, err := newGrpcHandler().Handle(context.Background(), "/v0/api/service/fail", nil)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "Provided invalid address.", status.Convert(err).Message())
+}
+
+func TestGrpcHandlerUnknownError(t *testing.T) {
+	_, err := newGrpcHandler().Handle(context.Background(), "/v0/api/service/error", nil)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "Internal Error. Please check the status of the service.", status.Convert(err).Message())
+}
+
+func TestGrpcHandlerPanic(t *testing.T) {
+	_, err := newGrpcHandler().Handle(context.Background(), "/v0/api/service/panic", nil)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGrpcHandlerIdempotencyKey(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-oasis-idempotency-key", "key"))
+
+	res, err := newGrpcHandler().Handle(ctx, "/v0/api/service/key", nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "key", res)
+}
+
+func TestGrpcHandlerStream(t *testing.T) {
+	var values []interface{}
+	err := newGrpcHandler().Stream(context.Background(), "/v0/api/service/stream", nil, func(v interface{}) error {
+		values = append(values, v)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{1, 2}, values)
+}
+
+func TestGrpcHandlerStreamNotStream(t *testing.T) {
+	err := newGrpcHandler().Stream(context.Background(), "/v0/api/service/echo", "value", func(v interface{}) error {
+		return nil
+	})
+
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestNewGrpcBinderNoLogger(t *testing.T) {
+	assert.Panics(t, func() {
+		NewGrpcBinder(GrpcBinderProperties{})
+	})
+}
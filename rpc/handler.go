@@ -38,3 +38,18 @@ type HandlerBinder interface {
 	// will be dispatched when method and path combination is provided
 	Bind(method string, path string, handler Handler, factory EntityFactory)
 }
+
+// DescribedHandler is a Handler that also holds an instance of the entity
+// it responds with, so that the API it serves can be described
+type DescribedHandler struct {
+	Handler
+
+	// Response is an instance of the entity returned by the Handler
+	// when the request succeeds
+	Response interface{}
+}
+
+// Describe decorates the handler with the entity it responds with
+func Describe(handler Handler, response interface{}) DescribedHandler {
+	return DescribedHandler{Handler: handler, Response: response}
+}
@@ -1,10 +1,13 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	stderr "errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"reflect"
 	"runtime/debug"
 	"strconv"
 
@@ -44,6 +47,15 @@ func (f HttpMiddlewareFunc) ServeHTTP(req *http.Request) (interface{}, error) {
 	return f(req)
 }
 
+// HttpStream is implemented by the responses of handlers that need to
+// take over the http response instead of having a single value encoded,
+// for example to upgrade the connection to a websocket
+type HttpStream interface {
+	// ServeStream writes the response to the client. It is responsible
+	// for writing the status code and headers of the response
+	ServeStream(res http.ResponseWriter, req *http.Request) error
+}
+
 // HttpError holds the necessary information to return an error when
 // using the http protocol
 type HttpError struct {
@@ -197,6 +209,10 @@ func (h *HttpRoute) reportSuccess(
 		return http.StatusNoContent, nil
 	}
 
+	if stream, ok := body.(HttpStream); ok {
+		return h.reportStream(res, req, stream)
+	}
+
 	if err := h.encoder.Encode(res, body); err != nil {
 		res.WriteHeader(http.StatusInternalServerError)
 		h.logger.Warn(req.Context(), "failed to encode response to response writer", log.MapFields{
@@ -219,6 +235,34 @@ func (h *HttpRoute) reportSuccess(
 	return http.StatusOK, nil
 }
 
+func (h *HttpRoute) reportStream(
+	res http.ResponseWriter,
+	req *http.Request,
+	stream HttpStream,
+) (int, error) {
+	path := req.URL.EscapedPath()
+	method := req.Method
+
+	if err := stream.ServeStream(res, req); err != nil {
+		h.logger.Debug(req.Context(), "failed to serve stream", log.MapFields{
+			"path":      path,
+			"method":    method,
+			"call_type": "HttpRequestHandleFailure",
+			"err":       err.Error(),
+		})
+		return 0, err
+	}
+
+	h.logger.Info(req.Context(), "", log.MapFields{
+		"path":        path,
+		"method":      method,
+		"call_type":   "HttpRequestHandleSuccess",
+		"status_code": http.StatusOK,
+	})
+
+	return http.StatusOK, nil
+}
+
 // HttpRoute implementation of HttpMiddleware
 func (h *HttpRoute) ServeHTTP(res http.ResponseWriter, req *http.Request) {
 	_, _ = h.tracker.InstrumentResult(req.Method, func() *stats.TrackResult {
@@ -348,6 +392,9 @@ func (h *HttpRouter) ServeHTTP(res http.ResponseWriter, req *http.Request) {
 	method := req.Method
 	traceID := ParseTraceID(req.Header.Get(HttpHeaderTraceID))
 	req = req.WithContext(context.WithValue(req.Context(), log.ContextKeyTraceID, traceID))
+	if key := req.Header.Get(HttpHeaderIdempotencyKey); len(key) > 0 {
+		req = req.WithContext(context.WithValue(req.Context(), IdempotencyKey{}, key))
+	}
 
 	h.logger.Debug(req.Context(), "", log.MapFields{
 		"path":      path,
@@ -570,6 +617,107 @@ func (h *HttpCorsPreProcessor) ServeHTTP(w http.ResponseWriter, req *http.Reques
 	return next, nextReq
 }
 
+// HttpQueryHeaderPreProcessorProps properties used to define the
+// behaviour of the HttpQueryHeaderPreProcessor
+type HttpQueryHeaderPreProcessorProps struct {
+	// Paths are the paths of the requests whose headers
+	// can be provided as query parameters
+	Paths []string
+
+	// Headers are the headers that can be provided as
+	// query parameters of the same name
+	Headers []string
+}
+
+// HttpQueryHeaderPreProcessor sets the headers of a request from the
+// query parameters of the same name. Browsers cannot set the headers of
+// the requests that open a websocket connection or an EventSource, so
+// they provide the authentication and the session key in the query
+type HttpQueryHeaderPreProcessor struct {
+	paths   map[string]bool
+	headers []string
+}
+
+// NewHttpQueryHeaderPreProcessor creates a new instance of a query
+// header Http PreProcessor
+func NewHttpQueryHeaderPreProcessor(props HttpQueryHeaderPreProcessorProps) *HttpQueryHeaderPreProcessor {
+	paths := make(map[string]bool, len(props.Paths))
+	for _, path := range props.Paths {
+		paths[path] = true
+	}
+
+	return &HttpQueryHeaderPreProcessor{
+		paths:   paths,
+		headers: props.Headers,
+	}
+}
+
+// ServeHTTP is the implementation of HttpPreProcessor for
+// HttpQueryHeaderPreProcessor. The headers set in the request are kept
+func (h *HttpQueryHeaderPreProcessor) ServeHTTP(w http.ResponseWriter, req *http.Request) (bool, *http.Request) {
+	if !h.paths[req.URL.Path] {
+		return true, req
+	}
+
+	query := req.URL.Query()
+	for _, header := range h.headers {
+		value := query.Get(header)
+		if len(value) > 0 && len(req.Header.Get(header)) == 0 {
+			req.Header.Set(header, value)
+		}
+	}
+
+	return true, req
+}
+
+// HttpBodyDecoder is implemented by the entities that decode the body
+// of a request themselves, instead of having it decoded as JSON, so
+// that they can handle a body that is not valid JSON
+type HttpBodyDecoder interface {
+	DecodeBody(p []byte) error
+}
+
+// HttpQueryDecoder is implemented by the entities that can be decoded
+// from the query parameters of a request that has no body, such as the
+// requests issued by an EventSource
+type HttpQueryDecoder interface {
+	DecodeQuery(query url.Values) error
+}
+
+// ParseQueryUint sets v to the value of a query parameter if it is
+// provided. It fails if the value is not an unsigned integer
+func ParseQueryUint(query url.Values, name string, v *uint64) error {
+	value := query.Get(name)
+	if len(value) == 0 {
+		return nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("query parameter %s must be an unsigned integer", name)
+	}
+
+	*v = n
+	return nil
+}
+
+// ParseQueryBool sets v to the value of a query parameter if it is
+// provided. It fails if the value is not a boolean
+func ParseQueryBool(query url.Values, name string, v *bool) error {
+	value := query.Get(name)
+	if len(value) == 0 {
+		return nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("query parameter %s must be a boolean", name)
+	}
+
+	*v = b
+	return nil
+}
+
 // HttpJsonHandler handles requests that expect a body in the JSON format,
 // handles the body and executes the final handler with the expected type
 type HttpJsonHandler struct {
@@ -675,10 +823,7 @@ func (h *HttpJsonHandler) ServeHTTP(req *http.Request) (interface{}, error) {
 	}
 
 	if body != nil && req.ContentLength > 0 {
-		if err := h.decoder.DecodeWithLimit(req.Body, body, rw.ReadLimitProps{
-			Limit:        req.ContentLength,
-			FailOnExceed: true,
-		}); err != nil {
+		if err := h.decodeBody(req, body); err != nil {
 			h.logger.Debug(req.Context(), "failed to decode json", log.MapFields{
 				"path":           req.URL.EscapedPath(),
 				"method":         req.Method,
@@ -690,10 +835,43 @@ func (h *HttpJsonHandler) ServeHTTP(req *http.Request) (interface{}, error) {
 		}
 	}
 
+	if decoder, ok := body.(HttpQueryDecoder); ok && req.ContentLength == 0 {
+		if err := decoder.DecodeQuery(req.URL.Query()); err != nil {
+			h.logger.Debug(req.Context(), "failed to decode query", log.MapFields{
+				"path":      req.URL.EscapedPath(),
+				"method":    req.Method,
+				"call_type": "HttpJsonRequestHandleFailure",
+				"err":       err.Error(),
+			})
+			return nil, errors.New(errors.ErrParseQueryParams, err)
+		}
+	}
+
 	// provide the parsed body to the handler and handle execution
 	return h.handler.Handle(req.Context(), body)
 }
 
+// decodeBody decodes the body of the request into the entity. If the
+// entity implements HttpBodyDecoder, the body is passed on as it is
+func (h *HttpJsonHandler) decodeBody(req *http.Request, body interface{}) error {
+	props := rw.ReadLimitProps{
+		Limit:        req.ContentLength,
+		FailOnExceed: true,
+	}
+
+	decoder, ok := body.(HttpBodyDecoder)
+	if !ok {
+		return h.decoder.DecodeWithLimit(req.Body, body, props)
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, req.ContentLength))
+	if _, err := rw.CopyWithLimit(buf, req.Body, props); err != nil {
+		return err
+	}
+
+	return decoder.DecodeBody(buf.Bytes())
+}
+
 // HttpHandlerFactory converts an rpc Handler into HttpMiddleware
 // that can be plugged into a router
 type HttpHandlerFactory interface {
@@ -708,11 +886,29 @@ func (f HttpHandlerFactoryFunc) Make(factory EntityFactory, handler Handler) Htt
 	return f(factory, handler)
 }
 
+// HttpBinding describes a handler bound to an HttpBinder
+type HttpBinding struct {
+	// Method is the http method of the binding
+	Method string
+
+	// URI is the path of the binding
+	URI string
+
+	// Request is the type of the entity the handler expects as the body of
+	// the request. It is nil if the handler does not expect a body
+	Request reflect.Type
+
+	// Response is the type of the entity the handler responds with. It is
+	// nil if the handler is not a DescribedHandler
+	Response reflect.Type
+}
+
 // HttpBinder is the binder for http. It is also the only mechanism to build
 // HttpRouter's. This is done so that an HttpRouter cannot be modified
 // after it has been created
 type HttpBinder struct {
 	handlers      map[string]MethodHandlers
+	bindings      []HttpBinding
 	preProcessors []HttpPreProcessor
 	encoder       Encoder
 	logger        log.Logger
@@ -728,6 +924,38 @@ func (b *HttpBinder) Bind(method string, uri string, handler Handler, factory En
 	}
 
 	route.Add(method, b.factory.Make(factory, handler))
+
+	binding := HttpBinding{Method: method, URI: uri}
+	if factory != nil {
+		binding.Request = entityType(factory.Create())
+	}
+	if described, ok := handler.(DescribedHandler); ok {
+		binding.Response = entityType(described.Response)
+	}
+	b.bindings = append(b.bindings, binding)
+}
+
+// Bindings returns the handlers bound to the binder in the order in
+// which they were bound. Unlike the handlers, the bindings are kept
+// after the HttpRouter is built
+func (b *HttpBinder) Bindings() []HttpBinding {
+	bindings := make([]HttpBinding, len(b.bindings))
+	copy(bindings, b.bindings)
+	return bindings
+}
+
+// entityType returns the type of the entity, dereferencing pointers
+func entityType(v interface{}) reflect.Type {
+	if v == nil {
+		return nil
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
 }
 
 func (b *HttpBinder) AddPreProcessor(preProcessor HttpPreProcessor) {
@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/oasislabs/oasis-gateway/errors"
@@ -51,6 +53,17 @@ func (m HttpMiddlewareOK) ServeHTTP(req *http.Request) (interface{}, error) {
 	return m.body, nil
 }
 
+type HttpStreamText struct {
+	text string
+}
+
+func (s HttpStreamText) ServeStream(res http.ResponseWriter, req *http.Request) error {
+	res.Header().Set("Content-type", "text/plain")
+	res.WriteHeader(http.StatusOK)
+	_, err := res.Write([]byte(s.text))
+	return err
+}
+
 type HttpMiddlewarePanic struct{}
 
 func (m HttpMiddlewarePanic) ServeHTTP(req *http.Request) (interface{}, error) {
@@ -70,9 +83,18 @@ func setupRouter() *HttpRouter {
 			"GET": HttpMiddlewareOK{body: map[string]string{"result": "ok"}},
 			"PUT": HttpMiddlewareOK{body: nil},
 		},
+		"/stream": map[string]HttpMiddleware{
+			"GET": HttpMiddlewareOK{body: HttpStreamText{text: "streamed"}},
+		},
 		"/panic": map[string]HttpMiddleware{
 			"GET": HttpMiddlewarePanic{},
 		},
+		"/idempotency": map[string]HttpMiddleware{
+			"GET": HttpMiddlewareFunc(func(req *http.Request) (interface{}, error) {
+				key, _ := req.Context().Value(IdempotencyKey{}).(string)
+				return map[string]string{"key": key}, nil
+			}),
+		},
 	}
 
 	mux := make(map[string]*HttpRoute)
@@ -151,6 +173,38 @@ func TestHttpRouterServeHTTPOKWithBody(t *testing.T) {
 	assert.Equal(t, "{\"result\":\"ok\"}\n", string(s))
 }
 
+func TestHttpRouterServeHTTPOKStream(t *testing.T) {
+	router := setupRouter()
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/stream", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	s, err := ioutil.ReadAll(recorder.Body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/plain", recorder.Header().Get("Content-type"))
+	assert.Equal(t, "streamed", string(s))
+}
+
+func TestHttpRouterServeHTTPIdempotencyKey(t *testing.T) {
+	router := setupRouter()
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/idempotency", nil)
+	req.Header.Set(HttpHeaderIdempotencyKey, "key")
+
+	router.ServeHTTP(recorder, req)
+
+	s, err := ioutil.ReadAll(recorder.Body)
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "{\"key\":\"key\"}\n", string(s))
+}
+
 func TestHttpRouterServeHTTPPanic(t *testing.T) {
 	router := setupRouter()
 
@@ -245,6 +299,28 @@ func TestHttpBinderBuildRouter(t *testing.T) {
 	assert.Equal(t, "", string(s))
 }
 
+func TestHttpBinderBindings(t *testing.T) {
+	binder := NewHttpBinder(HttpBinderProperties{
+		Encoder:        JsonEncoder{},
+		Logger:         logger,
+		HandlerFactory: HttpHandlerFactoryFunc(simpleHandlerFactory),
+	})
+
+	binder.Bind("GET", "/path", HandlerEcho{}, nil)
+	binder.Bind("POST", "/path", Describe(HandlerEcho{}, &Error{}), mapEntityFactory())
+	_ = binder.Build()
+
+	assert.Equal(t, []HttpBinding{
+		{Method: "GET", URI: "/path"},
+		{
+			Method:   "POST",
+			URI:      "/path",
+			Request:  reflect.TypeOf(map[string]string{}),
+			Response: reflect.TypeOf(Error{}),
+		},
+	}, binder.Bindings())
+}
+
 func TestHttpJsonHandlerContentLengthMissing(t *testing.T) {
 	handler := NewHttpJsonHandler(HttpJsonHandlerProperties{
 		Limit:   1024,
@@ -333,6 +409,84 @@ func TestHttpJsonHandlerOK(t *testing.T) {
 	assert.Equal(t, map[string]string{"hamburger": "rare", "potato": "fried"}, m)
 }
 
+func TestHttpJsonHandlerBodyDecoder(t *testing.T) {
+	handler := NewHttpJsonHandler(HttpJsonHandlerProperties{
+		Limit:   1024,
+		Handler: HandlerEcho{},
+		Logger:  logger,
+		Factory: EntityFactoryFunc(NewJsonRpcEntity),
+	})
+
+	req, _ := http.NewRequest("POST", "/path", bytes.NewBufferString("{\"jsonrpc\""))
+	req.ContentLength = 10
+	req.Header.Add("Content-type", "application/json")
+
+	v, err := handler.ServeHTTP(req)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"jsonrpc\"", string(*v.(*jsonRpcPayload)))
+}
+
+func TestHttpJsonHandlerBodyDecoderExceedsContentLength(t *testing.T) {
+	handler := NewHttpJsonHandler(HttpJsonHandlerProperties{
+		Limit:   1024,
+		Handler: HandlerEcho{},
+		Logger:  logger,
+		Factory: EntityFactoryFunc(NewJsonRpcEntity),
+	})
+
+	req, _ := http.NewRequest("POST", "/path", bytes.NewBufferString("{\"jsonrpc\""))
+	req.ContentLength = 5
+	req.Header.Add("Content-type", "application/json")
+
+	_, err := handler.ServeHTTP(req)
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrDeserializeJSON, err.(errors.Err).ErrorCode())
+}
+
+type QueryEntity struct {
+	Offset uint64
+	Wait   bool
+}
+
+func (e *QueryEntity) DecodeQuery(query url.Values) error {
+	if err := ParseQueryUint(query, "offset", &e.Offset); err != nil {
+		return err
+	}
+
+	return ParseQueryBool(query, "wait", &e.Wait)
+}
+
+func TestHttpJsonHandlerQueryOK(t *testing.T) {
+	handler := NewHttpJsonHandler(HttpJsonHandlerProperties{
+		Limit:   1024,
+		Handler: HandlerEcho{},
+		Logger:  logger,
+		Factory: EntityFactoryFunc(func() interface{} { return &QueryEntity{} }),
+	})
+
+	req, _ := http.NewRequest("GET", "/path?offset=3&wait=true", nil)
+
+	v, err := handler.ServeHTTP(req)
+	assert.Nil(t, err)
+	assert.Equal(t, &QueryEntity{Offset: 3, Wait: true}, v)
+}
+
+func TestHttpJsonHandlerQueryErr(t *testing.T) {
+	handler := NewHttpJsonHandler(HttpJsonHandlerProperties{
+		Limit:   1024,
+		Handler: HandlerEcho{},
+		Logger:  logger,
+		Factory: EntityFactoryFunc(func() interface{} { return &QueryEntity{} }),
+	})
+
+	req, _ := http.NewRequest("GET", "/path?offset=-1", nil)
+
+	v, err := handler.ServeHTTP(req)
+	assert.Equal(t, "[2009] error code InputError with desc Failed to parse query parameters. "+
+		"with cause query parameter offset must be an unsigned integer", err.Error())
+	assert.Nil(t, v)
+}
+
 func TestHttpErrorError(t *testing.T) {
 	e := errors.New(errors.ErrInternalError, nil)
 	err := HttpError{Cause: &e, StatusCode: 400}
@@ -446,3 +600,46 @@ func TestHttpCorsPreProcessorErrMethodNotAllowed(t *testing.T) {
 
 	assert.False(t, ok)
 }
+
+func TestHttpQueryHeaderPreProcessor(t *testing.T) {
+	processor := NewHttpQueryHeaderPreProcessor(HttpQueryHeaderPreProcessorProps{
+		Paths:   []string{"/path"},
+		Headers: []string{"X-OASIS-SESSION-KEY", "X-OASIS-INSECURE-AUTH"},
+	})
+
+	req := httptest.NewRequest("GET", "/path?X-OASIS-SESSION-KEY=key&X-OASIS-INSECURE-AUTH=user&other=value", nil)
+
+	ok, req := processor.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.True(t, ok)
+	assert.Equal(t, "key", req.Header.Get("X-OASIS-SESSION-KEY"))
+	assert.Equal(t, "user", req.Header.Get("X-OASIS-INSECURE-AUTH"))
+	assert.Equal(t, "", req.Header.Get("other"))
+}
+
+func TestHttpQueryHeaderPreProcessorKeepsHeader(t *testing.T) {
+	processor := NewHttpQueryHeaderPreProcessor(HttpQueryHeaderPreProcessorProps{
+		Paths:   []string{"/path"},
+		Headers: []string{"X-OASIS-SESSION-KEY"},
+	})
+
+	req := httptest.NewRequest("GET", "/path?X-OASIS-SESSION-KEY=query", nil)
+	req.Header.Set("X-OASIS-SESSION-KEY", "header")
+
+	_, req = processor.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "header", req.Header.Get("X-OASIS-SESSION-KEY"))
+}
+
+func TestHttpQueryHeaderPreProcessorOtherPath(t *testing.T) {
+	processor := NewHttpQueryHeaderPreProcessor(HttpQueryHeaderPreProcessorProps{
+		Paths:   []string{"/path"},
+		Headers: []string{"X-OASIS-SESSION-KEY"},
+	})
+
+	req := httptest.NewRequest("GET", "/other?X-OASIS-SESSION-KEY=key", nil)
+
+	_, req = processor.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "", req.Header.Get("X-OASIS-SESSION-KEY"))
+}
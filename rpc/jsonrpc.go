@@ -0,0 +1,385 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/oasislabs/oasis-gateway/log"
+)
+
+// JsonRpcVersion is the version of the JSON-RPC protocol
+// supported by the JsonRpcHandler
+const JsonRpcVersion = "2.0"
+
+// Error codes defined by the JSON-RPC 2.0 specification for the
+// errors that are not caused by the handler of the method
+const (
+	JsonRpcParseError     = -32700
+	JsonRpcInvalidRequest = -32600
+	JsonRpcMethodNotFound = -32601
+	JsonRpcInvalidParams  = -32602
+	JsonRpcInternalError  = -32603
+)
+
+const (
+	// jsonRpcPathPrefix is the prefix removed from the path of a
+	// handler to derive the name of its JSON-RPC method
+	jsonRpcPathPrefix = "/v0/api/"
+
+	// jsonRpcMaxBatchSize is the maximum number of requests a client
+	// can submit in a single batch, which is the same as for the
+	// batches of the service API
+	jsonRpcMaxBatchSize = 256
+
+	// jsonRpcBatchConcurrency is the maximum number of requests
+	// of a batch that are handled concurrently
+	jsonRpcBatchConcurrency = 16
+)
+
+// JsonRpcError is the error object returned by the server when it
+// fails to satisfy a JSON-RPC request. The errors returned by the
+// handlers keep the code and description of their errors.ErrorCode
+type JsonRpcError struct {
+	// Code is the identifier of the type of error
+	Code int `json:"code"`
+
+	// Message is a human readable description of the error
+	Message string `json:"message"`
+}
+
+// jsonRpcRequest is a JSON-RPC 2.0 request object. A request without
+// an ID is a notification, for which no response is returned
+type jsonRpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// jsonRpcResult is the response to a JSON-RPC request that succeeded
+type jsonRpcResult struct {
+	Version string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// jsonRpcErrorResponse is the response to a JSON-RPC request that failed
+type jsonRpcErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	Error   JsonRpcError    `json:"error"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// jsonRpcMethod is a handler bound as a JSON-RPC method
+type jsonRpcMethod struct {
+	handler Handler
+	factory EntityFactory
+}
+
+// JsonRpcMethodName derives the name of the JSON-RPC method for the
+// handler bound to the provided path. For example, the handler bound
+// to /v0/api/service/execute is exposed as service_execute
+func JsonRpcMethodName(path string) string {
+	path = strings.TrimPrefix(path, jsonRpcPathPrefix)
+	return strings.Replace(strings.Trim(path, "/"), "/", "_", -1)
+}
+
+// JsonRpcBinder is a binder that exposes the bound handlers as methods
+// of a JSON-RPC 2.0 handler. Handlers bound to the same path for
+// different http methods are exposed as a single JSON-RPC method
+type JsonRpcBinder struct {
+	methods map[string]jsonRpcMethod
+	logger  log.Logger
+}
+
+// JsonRpcBinderProperties are the properties used to create
+// a new instance of a JsonRpcBinder
+type JsonRpcBinderProperties struct {
+	Logger log.Logger
+}
+
+// NewJsonRpcBinder creates a new instance of the JsonRpcBinder. It will
+// panic in case there are errors in the construction of the binder
+func NewJsonRpcBinder(properties JsonRpcBinderProperties) *JsonRpcBinder {
+	if properties.Logger == nil {
+		panic("Logger must be set")
+	}
+
+	return &JsonRpcBinder{
+		methods: make(map[string]jsonRpcMethod),
+		logger:  properties.Logger,
+	}
+}
+
+// Bind is the implementation of HandlerBinder for JsonRpcBinder
+func (b *JsonRpcBinder) Bind(method string, path string, handler Handler, factory EntityFactory) {
+	name := JsonRpcMethodName(path)
+	if _, ok := b.methods[name]; ok {
+		return
+	}
+
+	b.methods[name] = jsonRpcMethod{handler: handler, factory: factory}
+}
+
+// Build creates a new JsonRpcHandler and clears the methods of the
+// JsonRpcBinder, so if new instances of JsonRpcHandlers need to be
+// built Bind needs to be used again
+func (b *JsonRpcBinder) Build() *JsonRpcHandler {
+	methods := b.methods
+
+	// avoid modification of the handler methods after the
+	// handler has been created
+	b.methods = make(map[string]jsonRpcMethod)
+
+	return &JsonRpcHandler{
+		methods: methods,
+		logger:  b.logger.ForClass("rpc", "JsonRpcHandler"),
+	}
+}
+
+// JsonRpcHandler dispatches JSON-RPC 2.0 requests, and batches of
+// requests, to the handlers of the requested methods. The requests
+// of a batch are handled concurrently, by at most
+// jsonRpcBatchConcurrency goroutines
+type JsonRpcHandler struct {
+	methods map[string]jsonRpcMethod
+	logger  log.Logger
+}
+
+// jsonRpcPayload is the payload of a JSON-RPC request as it is
+// received, so that a payload that is not valid JSON is answered
+// with a parse error instead of being rejected by the http handler
+type jsonRpcPayload []byte
+
+// DecodeBody is the implementation of HttpBodyDecoder for jsonRpcPayload
+func (p *jsonRpcPayload) DecodeBody(b []byte) error {
+	*p = append((*p)[:0], b...)
+	return nil
+}
+
+// NewJsonRpcEntity creates the entity the payload of a JSON-RPC
+// request is read into before it is passed to the JsonRpcHandler
+func NewJsonRpcEntity() interface{} {
+	return &jsonRpcPayload{}
+}
+
+// Handle is the implementation of Handler for JsonRpcHandler. The
+// request is expected to be an entity created by NewJsonRpcEntity
+// with either a single request object or a batch of request objects
+func (h *JsonRpcHandler) Handle(ctx context.Context, v interface{}) (interface{}, error) {
+	payload := bytes.TrimSpace(*v.(*jsonRpcPayload))
+	if !json.Valid(payload) {
+		return makeJsonRpcError(nil, JsonRpcParseError, "Parse error"), nil
+	}
+
+	if payload[0] != '[' {
+		res, notify := h.handleRequest(ctx, payload)
+		if notify {
+			return nil, nil
+		}
+
+		return res, nil
+	}
+
+	var reqs []json.RawMessage
+	if err := json.Unmarshal(payload, &reqs); err != nil {
+		return makeJsonRpcError(nil, JsonRpcParseError, "Parse error"), nil
+	}
+
+	if len(reqs) == 0 {
+		return makeJsonRpcError(nil, JsonRpcInvalidRequest, "Invalid Request"), nil
+	}
+
+	if len(reqs) > jsonRpcMaxBatchSize {
+		h.logger.Debug(ctx, "received batch with invalid size", log.MapFields{
+			"call_type": "JsonRpcRequestHandleFailure",
+			"size":      len(reqs),
+		})
+		return makeJsonRpcError(nil, errors.ErrBatchSize.Code(), errors.ErrBatchSize.Desc()), nil
+	}
+
+	type result struct {
+		res    interface{}
+		notify bool
+	}
+
+	// the idempotency key of the http request cannot identify each of
+	// the requests of a batch, so it is not passed on to the handlers
+	ctx = context.WithValue(ctx, IdempotencyKey{}, "")
+
+	workers := jsonRpcBatchConcurrency
+	if len(reqs) < workers {
+		workers = len(reqs)
+	}
+
+	indexes := make(chan int, len(reqs))
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	results := make([]result, len(reqs))
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				res, notify := h.handleRequest(ctx, reqs[i])
+				results[i] = result{res: res, notify: notify}
+			}
+		}()
+	}
+	wg.Wait()
+
+	responses := make([]interface{}, 0, len(results))
+	for _, result := range results {
+		if !result.notify {
+			responses = append(responses, result.res)
+		}
+	}
+
+	// a batch that only contains notifications does not
+	// have a response
+	if len(responses) == 0 {
+		return nil, nil
+	}
+
+	return responses, nil
+}
+
+// handleRequest handles a single JSON-RPC request object. The payload
+// is expected to be valid JSON. It returns the response to the request
+// and whether the request was a notification, in which case the
+// response must not be returned
+func (h *JsonRpcHandler) handleRequest(ctx context.Context, payload json.RawMessage) (interface{}, bool) {
+	var req jsonRpcRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return makeJsonRpcError(nil, JsonRpcInvalidRequest, "Invalid Request"), false
+	}
+
+	if req.Version != JsonRpcVersion || len(req.Method) == 0 {
+		return makeJsonRpcError(req.ID, JsonRpcInvalidRequest, "Invalid Request"), false
+	}
+
+	notify := len(req.ID) == 0
+
+	method, ok := h.methods[req.Method]
+	if !ok {
+		h.logger.Debug(ctx, "", log.MapFields{
+			"call_type": "JsonRpcRequestHandleFailure",
+			"method":    req.Method,
+			"err":       "method not found",
+		})
+		return makeJsonRpcError(req.ID, JsonRpcMethodNotFound, "Method not found"), notify
+	}
+
+	body := method.factory.Create()
+	if hasJsonRpcParams(req.Params) {
+		if body == nil || req.Params[0] != '{' {
+			return makeJsonRpcError(req.ID, JsonRpcInvalidParams, "Invalid params"), notify
+		}
+
+		if err := json.Unmarshal(req.Params, body); err != nil {
+			h.logger.Debug(ctx, "failed to decode params", log.MapFields{
+				"call_type": "JsonRpcRequestHandleFailure",
+				"method":    req.Method,
+				"err":       err.Error(),
+			})
+			return makeJsonRpcError(req.ID, JsonRpcInvalidParams, "Invalid params"), notify
+		}
+	}
+
+	res, err := h.handle(ctx, req.Method, method.handler, body)
+	if err != nil {
+		h.logger.Debug(ctx, "", log.MapFields{
+			"call_type": "JsonRpcRequestHandleFailure",
+			"method":    req.Method,
+			"err":       err.Error(),
+		})
+		code, message := mapJsonRpcError(err)
+		return makeJsonRpcError(req.ID, code, message), notify
+	}
+
+	if _, ok := res.(HttpStream); ok {
+		err := errors.New(errors.ErrAPINotImplemented, nil)
+		return makeJsonRpcError(req.ID, err.ErrorCode().Code(), err.ErrorCode().Desc()), notify
+	}
+
+	h.logger.Debug(ctx, "", log.MapFields{
+		"call_type": "JsonRpcRequestHandleSuccess",
+		"method":    req.Method,
+	})
+
+	return jsonRpcResult{Version: JsonRpcVersion, Result: res, ID: req.ID}, notify
+}
+
+// handle runs the handler of the method. The requests of a batch are
+// handled in their own goroutine, so a panic needs to be recovered
+// here for it not to bring down the server
+func (h *JsonRpcHandler) handle(
+	ctx context.Context,
+	method string,
+	handler Handler,
+	body interface{},
+) (v interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			h.logger.Warn(ctx, "unexpected panic caught", log.MapFields{
+				"call_type":  "JsonRpcRequestHandleFailure",
+				"method":     method,
+				"err":        fmt.Sprintf("%+v", r),
+				"stacktrace": string(debug.Stack()),
+			})
+
+			v, err = nil, errors.New(errors.ErrInternalError, nil)
+		}
+	}()
+
+	return handler.Handle(ctx, body)
+}
+
+// hasJsonRpcParams returns true if the params of a request are set
+func hasJsonRpcParams(params json.RawMessage) bool {
+	params = bytes.TrimSpace(params)
+	return len(params) > 0 && string(params) != "null"
+}
+
+// makeJsonRpcError creates the response to a request that failed. If
+// the ID of the request could not be determined it is set to null
+func makeJsonRpcError(id json.RawMessage, code int, message string) jsonRpcErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return jsonRpcErrorResponse{
+		Version: JsonRpcVersion,
+		Error:   JsonRpcError{Code: code, Message: message},
+		ID:      id,
+	}
+}
+
+// mapJsonRpcError maps the error returned by a handler to the code
+// and message of a JSON-RPC error object
+func mapJsonRpcError(err error) (int, string) {
+	switch err := err.(type) {
+	case errors.Err:
+		return err.ErrorCode().Code(), err.ErrorCode().Desc()
+	case *HttpError:
+		if err.Cause != nil {
+			return err.Cause.ErrorCode().Code(), err.Cause.ErrorCode().Desc()
+		}
+	case HttpError:
+		if err.Cause != nil {
+			return err.Cause.ErrorCode().Code(), err.Cause.ErrorCode().Desc()
+		}
+	}
+
+	return errors.ErrInternalError.Code(), errors.ErrInternalError.Desc()
+}
@@ -0,0 +1,215 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oasislabs/oasis-gateway/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newJsonRpcHandler() *JsonRpcHandler {
+	binder := NewJsonRpcBinder(JsonRpcBinderProperties{Logger: logger})
+	binder.Bind("POST", "/v0/api/service/echo", HandlerEcho{}, mapEntityFactory())
+	binder.Bind("GET", "/v0/api/service/echo", HandlerEcho{}, mapEntityFactory())
+	binder.Bind("POST", "/v0/api/service/fail", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.New(errors.ErrInvalidAddress, nil)
+	}), EntityFactoryFunc(func() interface{} { return nil }))
+	binder.Bind("POST", "/v0/api/service/panic", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		panic("error")
+	}), EntityFactoryFunc(func() interface{} { return nil }))
+	binder.Bind("POST", "/v0/api/service/poll/stream", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		return HttpStreamText{text: "text"}, nil
+	}), EntityFactoryFunc(func() interface{} { return nil }))
+	binder.Bind("POST", "/v0/api/service/error", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		return nil, stderr.New("error")
+	}), EntityFactoryFunc(func() interface{} { return nil }))
+	return binder.Build()
+}
+
+func handleJsonRpc(t *testing.T, handler *JsonRpcHandler, payload string) string {
+	v := NewJsonRpcEntity()
+	assert.Nil(t, v.(HttpBodyDecoder).DecodeBody([]byte(payload)))
+
+	res, err := handler.Handle(context.Background(), v)
+	assert.Nil(t, err)
+	if res == nil {
+		return ""
+	}
+
+	p, err := json.Marshal(res)
+	assert.Nil(t, err)
+	return string(p)
+}
+
+func TestJsonRpcMethodName(t *testing.T) {
+	assert.Equal(t, "service_execute", JsonRpcMethodName("/v0/api/service/execute"))
+	assert.Equal(t, "event_poll_stream", JsonRpcMethodName("/v0/api/event/poll/stream"))
+}
+
+func TestJsonRpcHandlerOK(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(),
+		`{"jsonrpc":"2.0","method":"service_echo","params":{"key":"value"},"id":1}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","result":{"key":"value"},"id":1}`, res)
+}
+
+func TestJsonRpcHandlerNotification(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(),
+		`{"jsonrpc":"2.0","method":"service_echo","params":{"key":"value"}}`)
+
+	assert.Equal(t, "", res)
+}
+
+func TestJsonRpcHandlerInvalidRequest(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"method":"service_echo","id":1}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":1}`, res)
+}
+
+func TestJsonRpcHandlerParseError(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"jsonrpc":"2.0","method":"service_echo","id":1`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32700,"message":"Parse error"},"id":null}`, res)
+}
+
+func TestJsonRpcHandlerBatchParseError(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `[{"jsonrpc":"2.0","method":"service_echo","id":1},`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32700,"message":"Parse error"},"id":null}`, res)
+}
+
+func TestJsonRpcHandlerNotRequestObject(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `"service_echo"`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":null}`, res)
+}
+
+func TestJsonRpcHandlerMethodNotFound(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"jsonrpc":"2.0","method":"unknown","id":"a"}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32601,"message":"Method not found"},"id":"a"}`, res)
+}
+
+func TestJsonRpcHandlerInvalidParams(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"jsonrpc":"2.0","method":"service_echo","params":[1],"id":1}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"Invalid params"},"id":1}`, res)
+}
+
+func TestJsonRpcHandlerErrorCode(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"jsonrpc":"2.0","method":"service_fail","id":1}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":2006,"message":"Provided invalid address."},"id":1}`, res)
+}
+
+func TestJsonRpcHandlerUnknownError(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"jsonrpc":"2.0","method":"service_error","id":1}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":1000,`+
+		`"message":"Internal Error. Please check the status of the service."},"id":1}`, res)
+}
+
+func TestJsonRpcHandlerPanic(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `[{"jsonrpc":"2.0","method":"service_panic","id":1}]`)
+
+	assert.Equal(t, `[{"jsonrpc":"2.0","error":{"code":1000,`+
+		`"message":"Internal Error. Please check the status of the service."},"id":1}]`, res)
+}
+
+func TestJsonRpcHandlerStream(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `{"jsonrpc":"2.0","method":"service_poll_stream","id":1}`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":5001,"message":"API not Implemented."},"id":1}`, res)
+}
+
+func TestJsonRpcHandlerBatch(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `[
+		{"jsonrpc":"2.0","method":"service_echo","params":{"key":"1"},"id":1},
+		{"jsonrpc":"2.0","method":"service_echo","params":{"key":"2"}},
+		{"jsonrpc":"2.0","method":"service_fail","id":3},
+		1
+	]`)
+
+	assert.Equal(t, `[{"jsonrpc":"2.0","result":{"key":"1"},"id":1},`+
+		`{"jsonrpc":"2.0","error":{"code":2006,"message":"Provided invalid address."},"id":3},`+
+		`{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":null}]`, res)
+}
+
+func TestJsonRpcHandlerBatchIdempotencyKey(t *testing.T) {
+	binder := NewJsonRpcBinder(JsonRpcBinderProperties{Logger: logger})
+	binder.Bind("POST", "/v0/api/service/key", HandlerFunc(func(ctx context.Context, v interface{}) (interface{}, error) {
+		return ctx.Value(IdempotencyKey{}), nil
+	}), EntityFactoryFunc(func() interface{} { return nil }))
+	handler := binder.Build()
+
+	v := NewJsonRpcEntity()
+	assert.Nil(t, v.(HttpBodyDecoder).DecodeBody([]byte(`[{"jsonrpc":"2.0","method":"service_key","id":1}]`)))
+	res, err := handler.Handle(context.WithValue(context.Background(), IdempotencyKey{}, "key"), v)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{jsonRpcResult{Version: JsonRpcVersion, Result: "", ID: json.RawMessage("1")}}, res)
+}
+
+func TestJsonRpcHandlerEmptyBatch(t *testing.T) {
+	res := handleJsonRpc(t, newJsonRpcHandler(), `[]`)
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":null}`, res)
+}
+
+func TestJsonRpcHandlerBatchTooLarge(t *testing.T) {
+	reqs := make([]string, jsonRpcMaxBatchSize+1)
+	for i := range reqs {
+		reqs[i] = `{"jsonrpc":"2.0","method":"service_echo","id":1}`
+	}
+
+	res := handleJsonRpc(t, newJsonRpcHandler(), "["+strings.Join(reqs, ",")+"]")
+
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":2016,`+
+		`"message":"Batch must contain between 1 and 256 calls."},"id":null}`, res)
+}
+
+func TestJsonRpcHandlerBatchConcurrency(t *testing.T) {
+	var lock sync.Mutex
+	var running, maxRunning int
+	binder := NewJsonRpcBinder(JsonRpcBinderProperties{Logger: logger})
+	binder.Bind("POST", "/v0/api/service/count", HandlerFunc(func(context.Context, interface{}) (interface{}, error) {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		lock.Lock()
+		running--
+		lock.Unlock()
+		return nil, nil
+	}), EntityFactoryFunc(func() interface{} { return nil }))
+	handler := binder.Build()
+
+	reqs := make([]string, jsonRpcMaxBatchSize)
+	for i := range reqs {
+		reqs[i] = `{"jsonrpc":"2.0","method":"service_count","id":1}`
+	}
+
+	res := handleJsonRpc(t, handler, "["+strings.Join(reqs, ",")+"]")
+
+	var responses []interface{}
+	assert.Nil(t, json.Unmarshal([]byte(res), &responses))
+	assert.Equal(t, jsonRpcMaxBatchSize, len(responses))
+	assert.True(t, maxRunning <= jsonRpcBatchConcurrency)
+}
+
+func TestNewJsonRpcBinderNoLogger(t *testing.T) {
+	assert.Panics(t, func() {
+		NewJsonRpcBinder(JsonRpcBinderProperties{})
+	})
+}
@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// HttpHeaderLastEventID is the header set by a client reconnecting
+	// to an event stream with the ID of the last event it received
+	HttpHeaderLastEventID = "Last-Event-ID"
+
+	// ContentTypeEventStream is the content type of a server-sent
+	// events stream
+	ContentTypeEventStream = "text/event-stream"
+)
+
+// HttpEventStreamWriter writes server-sent events to an http
+// response. Each event is flushed to the client as soon as it
+// is written
+type HttpEventStreamWriter struct {
+	res     http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewHttpEventStreamWriter creates a new writer for the response and
+// writes the headers of the event stream. It fails if the response
+// writer does not support flushing
+func NewHttpEventStreamWriter(res http.ResponseWriter) (*HttpEventStreamWriter, error) {
+	flusher, ok := res.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("response writer does not support flushing")
+	}
+
+	res.Header().Set("Content-Type", ContentTypeEventStream)
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &HttpEventStreamWriter{res: res, flusher: flusher}, nil
+}
+
+// WriteEvent writes an event with the provided ID and the JSON
+// encoding of v as data
+func (w *HttpEventStreamWriter) WriteEvent(id uint64, v interface{}) error {
+	p, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("id: ")
+	buffer.WriteString(strconv.FormatUint(id, 10))
+	buffer.WriteString("\ndata: ")
+	buffer.Write(p)
+	buffer.WriteString("\n\n")
+
+	return w.write(buffer.Bytes())
+}
+
+// WriteError writes an error event with the JSON encoding of v
+// as data. Error events do not have an ID, so that a reconnecting
+// client resumes after the last event it received
+func (w *HttpEventStreamWriter) WriteError(v interface{}) error {
+	p, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("event: error\ndata: ")
+	buffer.Write(p)
+	buffer.WriteString("\n\n")
+
+	return w.write(buffer.Bytes())
+}
+
+// WriteRetry sets the time in milliseconds the client should wait
+// before reconnecting after the stream is closed
+func (w *HttpEventStreamWriter) WriteRetry(ms uint64) error {
+	return w.write([]byte("retry: " + strconv.FormatUint(ms, 10) + "\n\n"))
+}
+
+func (w *HttpEventStreamWriter) write(p []byte) error {
+	if _, err := w.res.Write(p); err != nil {
+		return err
+	}
+
+	w.flusher.Flush()
+	return nil
+}
+
+// ParseLastEventID returns the ID of the last message received by a
+// client reconnecting to an event stream. It returns false if the
+// client did not provide a valid ID
+func ParseLastEventID(req *http.Request) (uint64, bool) {
+	header := req.Header.Get(HttpHeaderLastEventID)
+	if len(header) == 0 {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(header, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
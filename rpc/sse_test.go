@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type nonFlusher struct {
+	http.ResponseWriter
+}
+
+func TestHttpEventStreamWriterNoFlusher(t *testing.T) {
+	_, err := NewHttpEventStreamWriter(nonFlusher{httptest.NewRecorder()})
+	assert.Error(t, err)
+}
+
+func TestHttpEventStreamWriterWriteEvent(t *testing.T) {
+	res := httptest.NewRecorder()
+	w, err := NewHttpEventStreamWriter(res)
+	assert.Nil(t, err)
+
+	assert.Nil(t, w.WriteEvent(1, map[string]string{"data": "0x00"}))
+	assert.Nil(t, w.WriteError(Error{ErrorCode: 1000, Description: "error"}))
+
+	assert.True(t, res.Flushed)
+	assert.Equal(t, "no-cache", res.Header().Get("Cache-Control"))
+	assert.Equal(t, "id: 1\ndata: {\"data\":\"0x00\"}\n\n"+
+		"event: error\ndata: {\"errorCode\":1000,\"description\":\"error\"}\n\n", res.Body.String())
+}
+
+func TestParseLastEventID(t *testing.T) {
+	req := httptest.NewRequest("POST", "/", nil)
+	_, ok := ParseLastEventID(req)
+	assert.False(t, ok)
+
+	req.Header.Set(HttpHeaderLastEventID, "abc")
+	_, ok = ParseLastEventID(req)
+	assert.False(t, ok)
+
+	req.Header.Set(HttpHeaderLastEventID, "12")
+	id, ok := ParseLastEventID(req)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(12), id)
+}
@@ -2,10 +2,14 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"reflect"
+	"runtime"
 	"testing"
 
+	"github.com/oasislabs/oasis-gateway/api/v0/openapi"
+	"github.com/oasislabs/oasis-gateway/api/v0/version"
 	auth "github.com/oasislabs/oasis-gateway/auth/core"
 	"github.com/oasislabs/oasis-gateway/auth/insecure"
 	"github.com/oasislabs/oasis-gateway/eth"
@@ -121,6 +125,77 @@ func (s *ApiTestSuite) TestPathNoContentType() {
 	assert.Equal(s.T(), "{\"errorCode\":2004,\"description\":\"Content-type should be application/json.\"}\n", string(res.Body))
 }
 
+func (s *ApiTestSuite) TestJsonRpcBatch() {
+	body := []byte(`[{"jsonrpc":"2.0","method":"service_getCode",` +
+		`"params":{"address":"0x0000000000000000000000000000000000000000"},"id":1},` +
+		`{"jsonrpc":"2.0","method":"service_unknown","id":2}]`)
+	res, err := s.client.Request(apitest.Request{
+		Route: apitest.Route{
+			Method: "POST",
+			Path:   "/v0/api/jsonrpc",
+		},
+		Body: body,
+		Headers: map[string]string{
+			insecure.HeaderKey:           "mykey",
+			auth.RequestHeaderSessionKey: "mysession",
+			"Content-type":               "application/json",
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	assert.Equal(s.T(), http.StatusOK, res.Code)
+	assert.Equal(s.T(), "[{\"jsonrpc\":\"2.0\",\"result\":{\"address\":\"0x0000000000000000000000000000000000000000\","+
+		"\"code\":\"0x0000000000000000000000000000000000000000\"},\"id\":1},"+
+		"{\"jsonrpc\":\"2.0\",\"error\":{\"code\":-32601,\"message\":\"Method not found\"},\"id\":2}]\n", string(res.Body))
+}
+
+func (s *ApiTestSuite) TestOpenAPI() {
+	res, err := s.client.Request(apitest.Request{
+		Route: apitest.Route{
+			Method: "GET",
+			Path:   "/v0/api/openapi.json",
+		},
+		Headers: map[string]string{
+			insecure.HeaderKey:           "mykey",
+			auth.RequestHeaderSessionKey: "mysession",
+		},
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), http.StatusOK, res.Code)
+
+	var doc openapi.Document
+	assert.Nil(s.T(), json.Unmarshal(res.Body, &doc))
+	assert.Equal(s.T(), "post_service_getCode", doc.Paths["/v0/api/service/getCode"]["post"].OperationID)
+	assert.Equal(s.T(), "#/components/schemas/service.GetCodeResponse",
+		doc.Paths["/v0/api/service/getCode"]["post"].Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(s.T(), []string{"address", "code"},
+		doc.Components.Schemas["service.GetCodeResponse"].Required)
+	assert.Len(s.T(), doc.Components.Schemas["service.PollServiceResponse"].Properties["events"].Items.OneOf, 4)
+}
+
+func (s *ApiTestSuite) TestVersion() {
+	res, err := s.client.Request(apitest.Request{
+		Route: apitest.Route{
+			Method: "GET",
+			Path:   "/v0/api/version",
+		},
+		Headers: map[string]string{
+			insecure.HeaderKey:           "mykey",
+			auth.RequestHeaderSessionKey: "mysession",
+		},
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), http.StatusOK, res.Code)
+
+	var v version.GetVersionResponse
+	assert.Nil(s.T(), json.Unmarshal(res.Body, &v))
+	assert.Equal(s.T(), version.Version, v.Version)
+	assert.Equal(s.T(), runtime.Version(), v.GoVersion)
+	assert.Equal(s.T(), Config.BackendConfig.Provider.String(), v.BackendProvider)
+	assert.Equal(s.T(), Config.MailboxConfig.Provider.String(), v.MailboxProvider)
+	assert.Equal(s.T(), []string{"auth.insecure.InsecureAuth"}, v.AuthProviders)
+}
+
 func TestApiTestSuite(t *testing.T) {
 	suite.Run(t, new(ApiTestSuite))
 }
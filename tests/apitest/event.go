@@ -59,6 +59,24 @@ func (c *EventClient) Unsubscribe(
 	})
 }
 
+// ListSubscriptions lists the subscriptions of the session
+func (c *EventClient) ListSubscriptions(
+	ctx context.Context,
+	req event.ListSubscriptionsRequest,
+) (event.ListSubscriptionsResponse, error) {
+	var res event.ListSubscriptionsResponse
+	if err := c.client.RequestAPI(&rpc.SimpleJsonDeserializer{
+		O: &res,
+	}, &req, c.session, Route{
+		Method: "POST",
+		Path:   "/v0/api/event/list",
+	}); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
 // PollEvent polls for subscription events
 func (c *EventClient) PollEvent(
 	ctx context.Context,
@@ -45,13 +45,13 @@ func (s *EventsTestSuite) SetupTest() {
 func (s *EventsTestSuite) TestSubscribeErrEvent() {
 	_, err := s.eventclient.Subscribe(context.TODO(), event.SubscribeRequest{
 		Events: []string{"invalid"},
-		Filter: "address=address",
+		Filter: "address=0x0000000000000000000000000000000000000001",
 	})
 
 	assert.Equal(s.T(),
 		&rpc.Error{
 			ErrorCode:   2012,
-			Description: "Only logs topic supported for subscriptions.",
+			Description: "Event type not supported for subscriptions.",
 		}, err)
 }
 
@@ -66,8 +66,12 @@ func (s *EventsTestSuite) TestSubscribeOK() {
 				Run: func(args mock.Arguments) {
 					c := args.Get(2).(chan<- types.Log)
 					c <- types.Log{
-						Address:     common.HexToAddress("0x0000000000000000000000000000000000000000"),
+						Address:     common.HexToAddress("0x0000000000000000000000000000000000000001"),
 						BlockNumber: 1,
+						BlockHash:   common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						TxHash:      common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						TxIndex:     4,
+						Index:       5,
 						Topics: []common.Hash{
 							common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
 							common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
@@ -79,7 +83,7 @@ func (s *EventsTestSuite) TestSubscribeOK() {
 
 	res, err := s.eventclient.Subscribe(context.TODO(), event.SubscribeRequest{
 		Events: []string{"logs"},
-		Filter: "address=address&topic=0x0000000000000000000000000000000000000000000000000000000000000000&topic=0x0000000000000000000000000000000000000000000000000000000000000001",
+		Filter: "address=0x0000000000000000000000000000000000000001&topic=0x0000000000000000000000000000000000000000000000000000000000000000&topic=0x0000000000000000000000000000000000000000000000000000000000000001",
 	})
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), event.SubscribeResponse{
@@ -88,7 +92,7 @@ func (s *EventsTestSuite) TestSubscribeOK() {
 
 	s.ethclient.AssertCalled(s.T(), "SubscribeFilterLogs",
 		mock.Anything, ethereum.FilterQuery{
-			Addresses: []common.Address{common.HexToAddress("address")},
+			Addresses: []common.Address{common.HexToAddress("0x0000000000000000000000000000000000000001")},
 			Topics: [][]common.Hash{
 				{common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000")},
 				{common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001")},
@@ -106,15 +110,59 @@ func (s *EventsTestSuite) TestSubscribeOK() {
 		Events: []event.Event{
 			event.DataEvent{
 				ID:   0x0,
+				Type: "logs",
 				Data: "0x",
 				Topics: []string{
 					"0x0000000000000000000000000000000000000000000000000000000000000000",
 					"0x0000000000000000000000000000000000000000000000000000000000000001",
 				},
+				BlockNumber:      1,
+				BlockHash:        "0x0000000000000000000000000000000000000000000000000000000000000002",
+				TransactionHash:  "0x0000000000000000000000000000000000000000000000000000000000000003",
+				TransactionIndex: 4,
+				LogIndex:         5,
+				Address:          "0x0000000000000000000000000000000000000001",
 			},
 		}}, evs)
 }
 
+func (s *EventsTestSuite) TestSubscribeLogFilterOK() {
+	ethtest.ImplementMock(s.ethclient)
+
+	_, err := s.eventclient.Subscribe(context.TODO(), event.SubscribeRequest{
+		Events: []string{"logs"},
+		LogFilter: &event.LogFilter{
+			Addresses: []string{
+				"0x0000000000000000000000000000000000000001",
+				"0x0000000000000000000000000000000000000002",
+			},
+			Topics: [][]string{
+				nil,
+				{
+					"0x0000000000000000000000000000000000000000000000000000000000000001",
+					"0x0000000000000000000000000000000000000000000000000000000000000002",
+				},
+			},
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	s.ethclient.AssertCalled(s.T(), "SubscribeFilterLogs",
+		mock.Anything, ethereum.FilterQuery{
+			Addresses: []common.Address{
+				common.HexToAddress("0x0000000000000000000000000000000000000001"),
+				common.HexToAddress("0x0000000000000000000000000000000000000002"),
+			},
+			Topics: [][]common.Hash{
+				nil,
+				{
+					common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+					common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+				},
+			},
+		}, mock.Anything)
+}
+
 func (s *EventsTestSuite) TestUnsubscribeErrNoExists() {
 	ethtest.ImplementMock(s.ethclient)
 
@@ -148,7 +196,7 @@ func (s *EventsTestSuite) TestUnsubscribeOK() {
 
 	res, err := s.eventclient.Subscribe(context.TODO(), event.SubscribeRequest{
 		Events: []string{"logs"},
-		Filter: "address=address",
+		Filter: "address=0x0000000000000000000000000000000000000001",
 	})
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), event.SubscribeResponse{
@@ -181,6 +229,39 @@ func (s *EventsTestSuite) TestUnsubscribeOK() {
 	}
 }
 
+func (s *EventsTestSuite) TestListSubscriptionsOK() {
+	ethtest.ImplementMock(s.ethclient)
+
+	_, err := s.eventclient.Subscribe(context.TODO(), event.SubscribeRequest{
+		Events: []string{"logs"},
+		Filter: "address=0x0000000000000000000000000000000000000001",
+	})
+	assert.Nil(s.T(), err)
+
+	res, err := s.eventclient.ListSubscriptions(context.TODO(), event.ListSubscriptionsRequest{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(res.Subscriptions))
+	assert.NotZero(s.T(), res.Subscriptions[0].CreatedAt)
+
+	res.Subscriptions[0].CreatedAt = 0
+	assert.Equal(s.T(), event.Subscription{
+		ID:     0,
+		Events: []string{"logs"},
+		LogFilter: event.LogFilter{
+			Addresses: []string{"0x0000000000000000000000000000000000000001"},
+		},
+	}, res.Subscriptions[0])
+
+	err = s.eventclient.Unsubscribe(context.TODO(), event.UnsubscribeRequest{
+		ID: 0,
+	})
+	assert.Nil(s.T(), err)
+
+	res, err = s.eventclient.ListSubscriptions(context.TODO(), event.ListSubscriptionsRequest{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), event.ListSubscriptionsResponse{Subscriptions: []event.Subscription{}}, res)
+}
+
 func TestEventsTestSuite(t *testing.T) {
 	suite.Run(t, new(EventsTestSuite))
 }
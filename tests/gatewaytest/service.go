@@ -20,6 +20,7 @@ import (
 	"github.com/oasislabs/oasis-gateway/rpc"
 	"github.com/oasislabs/oasis-gateway/tx"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 )
 
 func NewPublicRouter(config *gateway.Config, provider *Provider) *rpc.HttpRouter {
@@ -32,6 +33,16 @@ func NewPublicRouter(config *gateway.Config, provider *Provider) *rpc.HttpRouter
 	})
 }
 
+func NewPublicGrpcServer(config *gateway.Config, provider *Provider) *grpc.Server {
+	request := provider.MustGet(reflect.TypeOf(&backendcore.RequestManager{})).(*backendcore.RequestManager)
+	authenticator := provider.MustGet(reflect.TypeOf((*authcore.Auth)(nil)).Elem()).(authcore.Auth)
+
+	return gateway.NewPublicGrpcServer(config, &gateway.ServiceGroup{
+		Request:       request,
+		Authenticator: authenticator,
+	})
+}
+
 func NewServices(ctx context.Context, config *gateway.Config) (*Provider, error) {
 	provider := Provider{}
 
@@ -85,9 +96,10 @@ func NewServices(ctx context.Context, config *gateway.Config) (*Provider, error)
 	provider.MustAdd(backendclient)
 
 	request, err := backend.NewRequestManagerWithDeps(ctx, &backend.Deps{
-		Logger: gateway.RootLogger,
-		MQueue: mqueue,
-		Client: backendclient,
+		Logger:         gateway.RootLogger,
+		MQueue:         mqueue,
+		Client:         backendclient,
+		RequestTimeout: config.BackendConfig.RequestTimeout,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	servicegrpc "github.com/oasislabs/oasis-gateway/api/v0/service/grpc"
+	auth "github.com/oasislabs/oasis-gateway/auth/core"
+	"github.com/oasislabs/oasis-gateway/auth/insecure"
+	"github.com/oasislabs/oasis-gateway/eth"
+	"github.com/oasislabs/oasis-gateway/eth/ethtest"
+	"github.com/oasislabs/oasis-gateway/rpc"
+	"github.com/oasislabs/oasis-gateway/tests/gatewaytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type GrpcTestSuite struct {
+	suite.Suite
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	client servicegrpc.ServiceClient
+}
+
+func (s *GrpcTestSuite) SetupTest() {
+	provider, err := gatewaytest.NewServices(context.TODO(), Config)
+	if err != nil {
+		panic(err)
+	}
+
+	ethclient := provider.MustGet(reflect.TypeOf((*eth.Client)(nil)).Elem()).(*ethtest.MockClient)
+	ethtest.ImplementMock(ethclient)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	s.server = gatewaytest.NewPublicGrpcServer(Config, provider)
+	go func() {
+		_ = s.server.Serve(lis)
+	}()
+
+	s.conn, err = grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+
+	s.client = servicegrpc.NewServiceClient(s.conn)
+}
+
+func (s *GrpcTestSuite) TearDownTest() {
+	_ = s.conn.Close()
+	s.server.Stop()
+}
+
+func (s *GrpcTestSuite) TestGetCode() {
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		insecure.HeaderKey, "mykey",
+		auth.RequestHeaderSessionKey, "mysession")
+
+	res, err := s.client.GetCode(ctx, &servicegrpc.GetCodeRequest{
+		Address: "0x0000000000000000000000000000000000000000",
+	})
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "0x0000000000000000000000000000000000000000", res.Address)
+	assert.Equal(s.T(), "0x0000000000000000000000000000000000000000", res.Code)
+}
+
+func (s *GrpcTestSuite) TestNotAuth() {
+	var trailer metadata.MD
+	_, err := s.client.GetCode(context.Background(), &servicegrpc.GetCodeRequest{
+		Address: "0x0000000000000000000000000000000000000000",
+	}, grpc.Trailer(&trailer))
+
+	assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	assert.Equal(s.T(), "Failed to authenticate request.", status.Convert(err).Message())
+	assert.Equal(s.T(), []string{"7003"}, trailer.Get(rpc.GrpcMetadataErrorCode))
+}
+
+func (s *GrpcTestSuite) TestNoSession() {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), insecure.HeaderKey, "mykey")
+
+	_, err := s.client.GetCode(ctx, &servicegrpc.GetCodeRequest{
+		Address: "0x0000000000000000000000000000000000000000",
+	})
+
+	assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+}
+
+func TestGrpcTestSuite(t *testing.T) {
+	suite.Run(t, new(GrpcTestSuite))
+}
@@ -76,8 +76,12 @@ func (s *ServicesTestSuite) TestDeployServiceOK() {
 
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), service.DeployServiceEvent{
-		ID:      0,
-		Address: "0x0000000000000000000000000000000000000000",
+		ID:              0,
+		Address:         "0x0000000000000000000000000000000000000000",
+		TransactionHash: "0x00000000000000000000000000000000000000000000000000000000000000000",
+		BlockNumber:     1,
+		GasUsed:         21000,
+		CodeHash:        "0x5380c7b7ae81a58eb98d9c78de4a1fd7fd9535fc953ed2be602daaa41767312a",
 	}, ev)
 }
 
@@ -114,9 +118,12 @@ func (s *ServicesTestSuite) TestExecuteServiceOK() {
 	})
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), service.ExecuteServiceEvent{
-		ID:      0,
-		Address: "0x0000000000000000000000000000000000000000",
-		Output:  "0x73756363657373",
+		ID:              0,
+		Address:         "0x0000000000000000000000000000000000000000",
+		Output:          "0x73756363657373",
+		TransactionHash: "0x00000000000000000000000000000000000000000000000000000000000000000",
+		BlockNumber:     1,
+		GasUsed:         21000,
 	}, ev)
 }
 
@@ -1,5 +1,28 @@
 package tx
 
+import (
+	"context"
+	"math/big"
+)
+
+// ExecuteListener is notified of the progress of the execution
+// of a transaction
+type ExecuteListener interface {
+	// EstimatingGas is called before the gas of the transaction
+	// is estimated
+	EstimatingGas(ctx context.Context)
+
+	// Submitting is called once before the transaction is signed and
+	// sent. If the context is done once Submitting returns, the
+	// transaction is not sent and its nonce is not consumed
+	Submitting(ctx context.Context)
+
+	// Submitted is called with the hash of the transaction once it
+	// has been sent. If the transaction needs to be signed again to
+	// be retried, it is only called with the hash of the one sent
+	Submitted(ctx context.Context, hash string)
+}
+
 // ExecuteRequest is the request to execute an Ethereum transaction
 type ExecuteRequest struct {
 	// AAD is the identifier of the original issuer for the transaction data
@@ -13,10 +36,51 @@ type ExecuteRequest struct {
 
 	// Transaction data
 	Data []byte
+
+	// Listener is notified of the progress of the execution if set
+	Listener ExecuteListener
 }
 
 type ExecuteResponse struct {
 	Address string
 	Output  string
 	Hash    string
+
+	// BlockNumber is the number of the block that includes the transaction
+	BlockNumber uint64
+
+	// GasUsed is the amount of gas used by the transaction
+	GasUsed uint64
+
+	// CodeHash is the hash of the code of the service if the
+	// transaction deployed one
+	CodeHash string
+}
+
+// EstimateGasRequest is the request to estimate the gas of
+// an Ethereum transaction without sending it
+type EstimateGasRequest struct {
+	// Address to which the transaction would be sent. If empty the
+	// transaction would deploy a service
+	Address string
+
+	// Transaction data
+	Data []byte
+}
+
+// EstimateGasResponse is the gas the gateway would use to send
+// a transaction
+type EstimateGasResponse struct {
+	// GasLimit is the gas limit of the transaction
+	GasLimit uint64
+
+	// GasPrice is the price the gateway pays for each unit of gas
+	GasPrice *big.Int
+
+	// Fee is the maximum cost of the transaction
+	Fee *big.Int
+
+	// ConfidentialFallback is true if the gas could not be estimated
+	// and the estimate used for confidential services is returned
+	ConfidentialFallback bool
 }
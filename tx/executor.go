@@ -136,6 +136,21 @@ func (s *Executor) destroy(ctx context.Context, ev concurrent.DestroyWorkerEvent
 	return nil
 }
 
+// EstimateGas estimates the gas that would be used to send a transaction
+// from any of the wallets
+func (s *Executor) EstimateGas(ctx context.Context, req EstimateGasRequest) (EstimateGasResponse, errors.Err) {
+	res, err := s.master.Execute(ctx, req)
+	if err != nil {
+		if e, ok := err.(errors.Err); ok {
+			return EstimateGasResponse{}, e
+		}
+
+		return EstimateGasResponse{}, errors.New(errors.ErrEstimateGas, err)
+	}
+
+	return res.(EstimateGasResponse), nil
+}
+
 // Executes the desired transaction.
 func (s *Executor) Execute(ctx context.Context, req ExecuteRequest) (ExecuteResponse, errors.Err) {
 	res, err := s.master.Execute(ctx, req)
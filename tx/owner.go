@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	callback "github.com/oasislabs/oasis-gateway/callback/client"
 	"github.com/oasislabs/oasis-gateway/concurrent"
@@ -43,6 +44,10 @@ const StatusOK = 1
 
 const gasPrice int64 = 1000000000
 
+// confidentialGasEstimate is the gas provided to transactions for which
+// the gas cannot be estimated, as it happens for confidential services
+const confidentialGasEstimate uint64 = 15177522
+
 var retryConfig = concurrent.RetryConfig{
 	Random:            false,
 	UnlimitedAttempts: false,
@@ -164,6 +169,8 @@ func (e *WalletOwner) handleRequestEvent(ctx context.Context, ev concurrent.Requ
 		return e.getStats(ctx), nil
 	case ExecuteRequest:
 		return e.executeTransaction(ctx, req)
+	case EstimateGasRequest:
+		return e.estimateGas(ctx, 0, req.Address, req.Data)
 	default:
 		panic("invalid request received for worker")
 	}
@@ -216,15 +223,30 @@ func (e *WalletOwner) signTransaction(tx *types.Transaction) (*types.Transaction
 	return e.wallet.SignTransaction(tx)
 }
 
-func (e *WalletOwner) estimateGas(ctx context.Context, id uint64, address string, data []byte) (uint64, errors.Err) {
+func (e *WalletOwner) estimateGas(ctx context.Context, id uint64, address string, data []byte) (EstimateGasResponse, errors.Err) {
 	if len(address) == 0 {
-		return e.estimateGasNonConfidential(ctx, id, address, data)
+		gas, err := e.estimateGasNonConfidential(ctx, id, address, data)
+		if err != nil {
+			return EstimateGasResponse{}, err
+		}
+
+		return newEstimateGasResponse(gas, false), nil
 	}
 
 	// TODO(stan): parse the data to identify whether the service is confidential.
 	// estimateGas does not work for confidential services so in that case we provide a reasonable
 	// amount of gas that may work
-	return 15177522, nil
+	return newEstimateGasResponse(confidentialGasEstimate, true), nil
+}
+
+func newEstimateGasResponse(gas uint64, confidentialFallback bool) EstimateGasResponse {
+	price := big.NewInt(gasPrice)
+	return EstimateGasResponse{
+		GasLimit:             gas,
+		GasPrice:             price,
+		Fee:                  new(big.Int).Mul(price, new(big.Int).SetUint64(gas)),
+		ConfidentialFallback: confidentialFallback,
+	}
 }
 
 func (e *WalletOwner) estimateGasNonConfidential(ctx context.Context, id uint64, address string, data []byte) (uint64, errors.Err) {
@@ -300,18 +322,30 @@ func (e *WalletOwner) generateAndSignTransaction(ctx context.Context, req sendTr
 }
 
 type sendTransactionRequest struct {
-	AAD     string
-	ID      uint64
-	Address string
-	Gas     uint64
-	Data    []byte
+	AAD      string
+	ID       uint64
+	Address  string
+	Gas      uint64
+	Data     []byte
+	Listener ExecuteListener
 }
 
 func (e *WalletOwner) sendTransaction(
 	ctx context.Context,
 	req sendTransactionRequest,
 ) (eth.SendTransactionResponse, errors.Err) {
+	if req.Listener != nil {
+		req.Listener.Submitting(ctx)
+	}
+
 	v, err := concurrent.RetryWithConfig(ctx, concurrent.SupplierFunc(func() (interface{}, error) {
+		// the request may be cancelled up until the transaction is
+		// submitted. In that case the nonce is not consumed
+		if err := ctx.Err(); err != nil {
+			return eth.SendTransactionResponse{},
+				concurrent.ErrCannotRecover{Cause: errors.New(errors.ErrSendTransaction, err)}
+		}
+
 		tx, err := e.generateAndSignTransaction(ctx, req, req.Gas)
 		if err != nil {
 			return ExecuteResponse{}, errors.New(errors.ErrSignedTx, err)
@@ -347,6 +381,10 @@ func (e *WalletOwner) sendTransaction(
 			}
 		}
 
+		if req.Listener != nil {
+			req.Listener.Submitted(ctx, tx.Hash().Hex())
+		}
+
 		return res, nil
 	}), retryConfig)
 
@@ -369,8 +407,18 @@ func (e *WalletOwner) sendTransaction(
 }
 
 func (e *WalletOwner) executeTransaction(ctx context.Context, req ExecuteRequest) (ExecuteResponse, errors.Err) {
+	// the request may have been cancelled while it was waiting
+	// for the wallet to be available
+	if err := ctx.Err(); err != nil {
+		return ExecuteResponse{}, errors.New(errors.ErrSendTransaction, err)
+	}
+
 	serviceAddress := req.Address
-	gas, err := e.estimateGas(ctx, req.ID, req.Address, req.Data)
+	if req.Listener != nil {
+		req.Listener.EstimatingGas(ctx)
+	}
+
+	estimate, err := e.estimateGas(ctx, req.ID, req.Address, req.Data)
 	if err != nil {
 		e.logger.Debug(ctx, "failed to estimate gas", log.MapFields{
 			"call_type": "ExecuteTransactionFailure",
@@ -382,11 +430,12 @@ func (e *WalletOwner) executeTransaction(ctx context.Context, req ExecuteRequest
 	}
 
 	res, err := e.sendTransaction(ctx, sendTransactionRequest{
-		AAD:     req.AAD,
-		ID:      req.ID,
-		Address: req.Address,
-		Data:    req.Data,
-		Gas:     gas,
+		AAD:      req.AAD,
+		ID:       req.ID,
+		Address:  req.Address,
+		Data:     req.Data,
+		Gas:      estimate.GasLimit,
+		Listener: req.Listener,
 	})
 	if err != nil {
 		return ExecuteResponse{}, err
@@ -430,6 +479,7 @@ func (e *WalletOwner) executeTransaction(ctx context.Context, req ExecuteRequest
 		return ExecuteResponse{}, err
 	}
 
+	var codeHash string
 	if len(serviceAddress) == 0 {
 		// retrieve the code for the service to make sure that it has been deployed
 		// successfully
@@ -457,6 +507,7 @@ func (e *WalletOwner) executeTransaction(ctx context.Context, req ExecuteRequest
 		}
 
 		serviceAddress = receipt.ContractAddress.Hex()
+		codeHash = crypto.Keccak256Hash(common.FromHex(code)).Hex()
 	}
 
 	// update the consumed gas
@@ -465,9 +516,12 @@ func (e *WalletOwner) executeTransaction(ctx context.Context, req ExecuteRequest
 	e.consumedBalance = e.consumedBalance.Add(e.consumedBalance, &gasUsed)
 
 	return ExecuteResponse{
-		Address: serviceAddress,
-		Output:  res.Output,
-		Hash:    res.Hash,
+		Address:     serviceAddress,
+		Output:      res.Output,
+		Hash:        res.Hash,
+		BlockNumber: receipt.BlockNumber,
+		GasUsed:     receipt.GasUsed,
+		CodeHash:    codeHash,
 	}, nil
 }
 
@@ -480,7 +534,7 @@ func (e *WalletOwner) getCode(ctx context.Context, addr common.Address) (string,
 	return code, nil
 }
 
-func (e *WalletOwner) transactionReceipt(ctx context.Context, hash string) (*types.Receipt, errors.Err) {
+func (e *WalletOwner) transactionReceipt(ctx context.Context, hash string) (*eth.Receipt, errors.Err) {
 	receipt, err := e.client.TransactionReceipt(ctx, common.HexToHash(hash))
 	if err != nil {
 		return nil, errors.New(errors.ErrTransactionReceipt, err)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/oasislabs/oasis-gateway/callback/callbacktest"
 	callback "github.com/oasislabs/oasis-gateway/callback/client"
+	"github.com/oasislabs/oasis-gateway/errors"
 	"github.com/oasislabs/oasis-gateway/eth"
 	"github.com/oasislabs/oasis-gateway/eth/ethtest"
 	"github.com/stretchr/testify/assert"
@@ -40,8 +41,10 @@ func mockClientForNonce(client *ethtest.MockClient) {
 	client.On("TransactionReceipt",
 		mock.AnythingOfType("*context.emptyCtx"),
 		mock.AnythingOfType("common.Hash")).
-		Return(&types.Receipt{
-			ContractAddress: common.HexToAddress(strings.Repeat("0", 20)),
+		Return(&eth.Receipt{
+			Receipt: &types.Receipt{
+				ContractAddress: common.HexToAddress(strings.Repeat("0", 20)),
+			},
 		}, nil)
 	client.On("SendTransaction",
 		mock.AnythingOfType("*context.emptyCtx"),
@@ -78,8 +81,10 @@ func mockClientForWalletOutOfFundsBodyCallback(client *ethtest.MockClient) {
 	client.On("TransactionReceipt",
 		mock.AnythingOfType("*context.emptyCtx"),
 		mock.AnythingOfType("common.Hash")).
-		Return(&types.Receipt{
-			ContractAddress: common.HexToAddress(strings.Repeat("0", 20)),
+		Return(&eth.Receipt{
+			Receipt: &types.Receipt{
+				ContractAddress: common.HexToAddress(strings.Repeat("0", 20)),
+			},
 		}, nil)
 	client.On("SendTransaction",
 		mock.AnythingOfType("*context.emptyCtx"),
@@ -178,6 +183,103 @@ func TestExecuteTransactionAddressBadNonce(t *testing.T) {
 	mockclient.AssertNumberOfCalls(t, "SendTransaction", 2)
 }
 
+type mockListener struct {
+	mock.Mock
+}
+
+func (l *mockListener) EstimatingGas(ctx context.Context) {
+	l.Called(ctx)
+}
+
+func (l *mockListener) Submitting(ctx context.Context) {
+	l.Called(ctx)
+}
+
+func (l *mockListener) Submitted(ctx context.Context, hash string) {
+	l.Called(ctx, hash)
+}
+
+func TestExecuteTransactionListenerBadNonce(t *testing.T) {
+	mockclient := &ethtest.MockClient{}
+	mockClientForNonce(mockclient)
+	owner, err := newOwner(mockclient)
+	assert.Nil(t, err)
+
+	listener := &mockListener{}
+	listener.On("EstimatingGas", mock.Anything).Return()
+	listener.On("Submitting", mock.Anything).Return()
+	listener.On("Submitted", mock.Anything, mock.Anything).Return()
+
+	owner.nonce = 0
+	_, err = owner.executeTransaction(context.TODO(), ExecuteRequest{
+		ID:       0,
+		Address:  strings.Repeat("0", 20),
+		Data:     []byte(""),
+		Listener: listener,
+	})
+
+	assert.Nil(t, err)
+	listener.AssertNumberOfCalls(t, "EstimatingGas", 1)
+	listener.AssertNumberOfCalls(t, "Submitting", 1)
+	listener.AssertNumberOfCalls(t, "Submitted", 1)
+	mockclient.AssertNumberOfCalls(t, "SendTransaction", 2)
+}
+
+func TestExecuteTransactionCancelled(t *testing.T) {
+	mockclient := &ethtest.MockClient{}
+	mockClientForNonce(mockclient)
+	owner, err := newOwner(mockclient)
+	assert.Nil(t, err)
+
+	listener := &mockListener{}
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	owner.nonce = 1
+	_, err = owner.executeTransaction(ctx, ExecuteRequest{
+		ID:       0,
+		Address:  strings.Repeat("0", 20),
+		Data:     []byte(""),
+		Listener: listener,
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.ErrSendTransaction, err.(errors.Err).ErrorCode())
+	assert.Equal(t, uint64(1), owner.nonce)
+	listener.AssertNotCalled(t, "EstimatingGas", mock.Anything)
+	mockclient.AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+}
+
+func TestExecuteTransactionCancelledOnSubmit(t *testing.T) {
+	mockclient := &ethtest.MockClient{}
+	mockClientForNonce(mockclient)
+	owner, err := newOwner(mockclient)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	listener := &mockListener{}
+	listener.On("EstimatingGas", mock.Anything).Return()
+	listener.On("Submitting", mock.Anything).
+		Run(func(mock.Arguments) { cancel() }).
+		Return()
+
+	owner.nonce = 1
+	_, err = owner.sendTransaction(ctx, sendTransactionRequest{
+		ID:       0,
+		Address:  strings.Repeat("0", 20),
+		Data:     []byte(""),
+		Listener: listener,
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, uint64(1), owner.nonce)
+	listener.AssertNumberOfCalls(t, "Submitting", 1)
+	listener.AssertNotCalled(t, "Submitted", mock.Anything, mock.Anything)
+	mockclient.AssertNotCalled(t, "SendTransaction", mock.Anything, mock.Anything)
+}
+
 func TestExecuteTransactionExceedsBalance(t *testing.T) {
 	mockclient := &ethtest.MockClient{}
 	mockClientForWalletOutOfFundsBodyCallback(mockclient)
@@ -199,6 +301,28 @@ func TestExecuteTransactionExceedsBalance(t *testing.T) {
 		}))
 }
 
+func TestExecuteTransactionNotSentNotSubmitted(t *testing.T) {
+	mockclient := &ethtest.MockClient{}
+	mockClientForWalletOutOfFundsBodyCallback(mockclient)
+	owner, err := newOwner(mockclient)
+	assert.Nil(t, err)
+
+	listener := &mockListener{}
+	listener.On("EstimatingGas", mock.Anything).Return()
+	listener.On("Submitting", mock.Anything).Return()
+
+	_, err = owner.executeTransaction(context.TODO(), ExecuteRequest{
+		ID:       0,
+		Address:  strings.Repeat("0", 20),
+		Data:     []byte(""),
+		Listener: listener,
+	})
+
+	assert.Error(t, err)
+	listener.AssertNumberOfCalls(t, "Submitting", 1)
+	listener.AssertNotCalled(t, "Submitted", mock.Anything, mock.Anything)
+}
+
 func TestOwnerWalletReachedFundsThresholdOnNewOK(t *testing.T) {
 	mockclient := &ethtest.MockClient{}
 	ethtest.ImplementMock(mockclient)